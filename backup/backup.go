@@ -0,0 +1,311 @@
+// Package backup bundles a consistent snapshot of the database, the audio files and the
+// config into a gzipped tarball with a manifest, and extracts it again.
+package backup
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/mopemope/quicknews/clock"
+	"github.com/mopemope/quicknews/database"
+)
+
+// Names of the entries of a backup.
+const (
+	ManifestName = "manifest.json"
+	DatabaseName = "quicknews.db"
+	ConfigName   = "config.toml"
+	AudioDir     = "audio"
+)
+
+// Format is the version of the layout of the backups written by this version.
+const Format = 1
+
+// sqliteHeader starts every SQLite database file.
+var sqliteHeader = []byte("SQLite format 3\x00")
+
+// Manifest describes the contents of a backup.
+type Manifest struct {
+	Format     int       `json:"format"`
+	CreatedAt  time.Time `json:"created_at"`
+	Database   string    `json:"database"`
+	Config     string    `json:"config,omitempty"`
+	AudioFiles int       `json:"audio_files"`
+}
+
+// Options selects the contents of a backup.
+type Options struct {
+	DB        string // Database file to snapshot
+	AudioPath string // Audio directory to bundle, empty to leave it out
+	Config    string // Config file to bundle, empty to leave it out
+}
+
+// Write writes a backup to w: a snapshot of the database taken while other processes keep
+// using it, and the audio files and config when the options include them.
+func Write(ctx context.Context, w io.Writer, opts Options) (*Manifest, error) {
+	dir, err := os.MkdirTemp("", "quicknews-backup-*")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create temporary directory")
+	}
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+	snapshot := filepath.Join(dir, DatabaseName)
+	if err := database.Snapshot(ctx, opts.DB, snapshot); err != nil {
+		return nil, err
+	}
+
+	var audio []string
+	if opts.AudioPath != "" {
+		err := filepath.WalkDir(opts.AudioPath, func(p string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if d.Type().IsRegular() {
+				audio = append(audio, p)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to list audio files")
+		}
+	}
+
+	manifest := &Manifest{
+		Format:     Format,
+		CreatedAt:  clock.Now(),
+		Database:   DatabaseName,
+		AudioFiles: len(audio),
+	}
+	if opts.Config != "" {
+		manifest.Config = ConfigName
+	}
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to encode manifest")
+	}
+
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+	if err := writeEntry(tw, ManifestName, int64(len(data)), bytes.NewReader(data)); err != nil {
+		return nil, err
+	}
+	if err := addFile(tw, DatabaseName, snapshot); err != nil {
+		return nil, err
+	}
+	if opts.Config != "" {
+		if err := addFile(tw, ConfigName, opts.Config); err != nil {
+			return nil, err
+		}
+	}
+	for _, p := range audio {
+		rel, err := filepath.Rel(opts.AudioPath, p)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to bundle audio file")
+		}
+		if err := addFile(tw, path.Join(AudioDir, filepath.ToSlash(rel)), p); err != nil {
+			return nil, err
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return nil, errors.Wrap(err, "failed to write backup")
+	}
+	if err := gz.Close(); err != nil {
+		return nil, errors.Wrap(err, "failed to write backup")
+	}
+	return manifest, nil
+}
+
+func addFile(tw *tar.Writer, name, file string) error {
+	f, err := os.Open(file)
+	if err != nil {
+		return errors.Wrapf(err, "failed to open %s", file)
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+	info, err := f.Stat()
+	if err != nil {
+		return errors.Wrapf(err, "failed to stat %s", file)
+	}
+	return writeEntry(tw, name, info.Size(), f)
+}
+
+func writeEntry(tw *tar.Writer, name string, size int64, r io.Reader) error {
+	header := &tar.Header{
+		Name:    name,
+		Mode:    0o600,
+		Size:    size,
+		ModTime: clock.Now(),
+	}
+	if err := tw.WriteHeader(header); err != nil {
+		return errors.Wrapf(err, "failed to write %s", name)
+	}
+	if _, err := io.Copy(tw, r); err != nil {
+		return errors.Wrapf(err, "failed to write %s", name)
+	}
+	return nil
+}
+
+// Archive is a backup extracted into a directory.
+type Archive struct {
+	Manifest *Manifest
+	Dir      string
+}
+
+// DatabasePath returns the path of the extracted database.
+func (a *Archive) DatabasePath() string {
+	return filepath.Join(a.Dir, a.Manifest.Database)
+}
+
+// RestoreConfig copies the extracted config to dest, replacing it.
+func (a *Archive) RestoreConfig(dest string) error {
+	if a.Manifest.Config == "" {
+		return errors.New("backup has no config")
+	}
+	return copyFile(filepath.Join(a.Dir, a.Manifest.Config), dest)
+}
+
+// RestoreAudio copies the extracted audio files into dest, replacing files of the same
+// name, and returns how many were copied.
+func (a *Archive) RestoreAudio(dest string) (int, error) {
+	src := filepath.Join(a.Dir, AudioDir)
+	count := 0
+	err := filepath.WalkDir(src, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) && p == src {
+				return filepath.SkipDir
+			}
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		rel, err := filepath.Rel(src, p)
+		if err != nil {
+			return err
+		}
+		if err := copyFile(p, filepath.Join(dest, rel)); err != nil {
+			return err
+		}
+		count++
+		return nil
+	})
+	if err != nil {
+		return count, errors.Wrap(err, "failed to restore audio files")
+	}
+	return count, nil
+}
+
+// Extract extracts the backup file into dir. A plain SQLite database file, such as a copy
+// of quicknews.db, is accepted as a backup holding only the database.
+func Extract(file, dir string) (*Archive, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open backup")
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+
+	header := make([]byte, len(sqliteHeader))
+	if _, err := io.ReadFull(f, header); err == nil && bytes.Equal(header, sqliteHeader) {
+		if err := copyFile(file, filepath.Join(dir, DatabaseName)); err != nil {
+			return nil, err
+		}
+		return &Archive{Manifest: &Manifest{Format: Format, Database: DatabaseName}, Dir: dir}, nil
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return nil, errors.Wrap(err, "failed to read backup")
+	}
+
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		return nil, errors.Wrap(err, "not a backup file")
+	}
+	tr := tar.NewReader(gz)
+	var manifest *Manifest
+	for {
+		h, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read backup")
+		}
+		if h.Typeflag != tar.TypeReg {
+			continue
+		}
+		name := filepath.FromSlash(path.Clean(h.Name))
+		if !filepath.IsLocal(name) {
+			return nil, errors.Newf("invalid entry %q in backup", h.Name)
+		}
+		if name == ManifestName {
+			manifest = &Manifest{}
+			if err := json.NewDecoder(tr).Decode(manifest); err != nil {
+				return nil, errors.Wrap(err, "failed to decode manifest")
+			}
+			continue
+		}
+		if err := writeFile(filepath.Join(dir, name), tr); err != nil {
+			return nil, err
+		}
+	}
+
+	if manifest == nil {
+		return nil, errors.New("not a backup file: no manifest")
+	}
+	if manifest.Format > Format {
+		return nil, errors.Newf("backup format %d is newer than this version supports", manifest.Format)
+	}
+	if manifest.Database == "" || !filepath.IsLocal(manifest.Database) {
+		return nil, errors.New("backup has no database")
+	}
+	if manifest.Config != "" && !filepath.IsLocal(manifest.Config) {
+		return nil, errors.Newf("invalid config %q in backup", manifest.Config)
+	}
+	archive := &Archive{Manifest: manifest, Dir: dir}
+	if _, err := os.Stat(archive.DatabasePath()); err != nil {
+		return nil, errors.Wrap(err, "backup has no database")
+	}
+	return archive, nil
+}
+
+func copyFile(src, dest string) error {
+	f, err := os.Open(src)
+	if err != nil {
+		return errors.Wrapf(err, "failed to open %s", src)
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+	return writeFile(dest, f)
+}
+
+func writeFile(dest string, r io.Reader) error {
+	if err := os.MkdirAll(filepath.Dir(dest), 0o755); err != nil {
+		return errors.Wrapf(err, "failed to create directory for %s", dest)
+	}
+	f, err := os.Create(dest)
+	if err != nil {
+		return errors.Wrapf(err, "failed to create %s", dest)
+	}
+	if _, err := io.Copy(f, r); err != nil {
+		_ = f.Close()
+		return errors.Wrapf(err, "failed to write %s", dest)
+	}
+	if err := f.Close(); err != nil {
+		return errors.Wrapf(err, "failed to write %s", dest)
+	}
+	return nil
+}
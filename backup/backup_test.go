@@ -0,0 +1,122 @@
+package backup
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/mopemope/quicknews/ent/enttest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteAndExtract(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	db := filepath.Join(dir, "quicknews.db")
+	client := enttest.Open(t, dialect.SQLite, "file:"+db+"?cache=shared&_fk=1")
+	defer func() { _ = client.Close() }()
+	_, err := client.Feed.Create().
+		SetURL("https://example.com/feed").
+		SetTitle("Example").
+		SetUpdatedAt(time.Now()).
+		Save(ctx)
+	require.NoError(t, err)
+
+	audio := filepath.Join(dir, "audio")
+	require.NoError(t, os.MkdirAll(filepath.Join(audio, "episodes"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(audio, "a.mp3"), []byte("a"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(audio, "episodes", "b.mp3"), []byte("b"), 0o644))
+	config := filepath.Join(dir, "config.toml")
+	require.NoError(t, os.WriteFile(config, []byte(`db = "quicknews.db"`), 0o644))
+
+	var buf bytes.Buffer
+	manifest, err := Write(ctx, &buf, Options{DB: db, AudioPath: audio, Config: config})
+	require.NoError(t, err)
+	assert.Equal(t, 2, manifest.AudioFiles)
+	assert.Equal(t, ConfigName, manifest.Config)
+
+	file := filepath.Join(dir, "backup.tar.gz")
+	require.NoError(t, os.WriteFile(file, buf.Bytes(), 0o644))
+	archive, err := Extract(file, t.TempDir())
+	require.NoError(t, err)
+	assert.Equal(t, Format, archive.Manifest.Format)
+	assert.Equal(t, 2, archive.Manifest.AudioFiles)
+	assert.FileExists(t, archive.DatabasePath())
+
+	restored := filepath.Join(dir, "restored")
+	n, err := archive.RestoreAudio(restored)
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+	assert.FileExists(t, filepath.Join(restored, "episodes", "b.mp3"))
+	restoredConfig := filepath.Join(dir, "restored.toml")
+	require.NoError(t, archive.RestoreConfig(restoredConfig))
+	data, err := os.ReadFile(restoredConfig)
+	require.NoError(t, err)
+	assert.Equal(t, `db = "quicknews.db"`, string(data))
+
+	// Without the audio files and config
+	buf.Reset()
+	manifest, err = Write(ctx, &buf, Options{DB: db})
+	require.NoError(t, err)
+	assert.Zero(t, manifest.AudioFiles)
+	assert.Empty(t, manifest.Config)
+
+	// A copy of the database file is a backup too
+	archive, err = Extract(db, t.TempDir())
+	require.NoError(t, err)
+	assert.FileExists(t, archive.DatabasePath())
+	assert.Error(t, archive.RestoreConfig(restoredConfig))
+	n, err = archive.RestoreAudio(restored)
+	require.NoError(t, err)
+	assert.Zero(t, n)
+}
+
+func TestExtract_Invalid(t *testing.T) {
+	dir := t.TempDir()
+	archive := func(name string, entries map[string]string) string {
+		var buf bytes.Buffer
+		gz := gzip.NewWriter(&buf)
+		tw := tar.NewWriter(gz)
+		for entry, content := range entries {
+			require.NoError(t, tw.WriteHeader(&tar.Header{Name: entry, Mode: 0o600, Size: int64(len(content))}))
+			_, err := tw.Write([]byte(content))
+			require.NoError(t, err)
+		}
+		require.NoError(t, tw.Close())
+		require.NoError(t, gz.Close())
+		file := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(file, buf.Bytes(), 0o644))
+		return file
+	}
+
+	_, err := Extract(archive("escape.tar.gz", map[string]string{"../escape": "x"}), t.TempDir())
+	assert.ErrorContains(t, err, "invalid entry")
+
+	_, err = Extract(archive("nomanifest.tar.gz", map[string]string{DatabaseName: "x"}), t.TempDir())
+	assert.ErrorContains(t, err, "no manifest")
+
+	_, err = Extract(archive("newer.tar.gz", map[string]string{
+		ManifestName: `{"format": 99, "database": "quicknews.db"}`,
+		DatabaseName: "x",
+	}), t.TempDir())
+	assert.ErrorContains(t, err, "newer")
+
+	_, err = Extract(archive("config.tar.gz", map[string]string{
+		ManifestName: `{"format": 1, "database": "quicknews.db", "config": "../../.ssh/id_rsa"}`,
+		DatabaseName: "x",
+	}), t.TempDir())
+	assert.ErrorContains(t, err, "invalid config")
+
+	text := filepath.Join(dir, "text")
+	require.NoError(t, os.WriteFile(text, []byte("not a backup"), 0o644))
+	_, err = Extract(text, t.TempDir())
+	assert.Error(t, err)
+}
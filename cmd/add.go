@@ -1,61 +1,177 @@
 package cmd
 
 import (
+	"bufio"
 	"context"
+	"fmt"
+	"io"
 	"log/slog"
+	"os"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/cockroachdb/errors"
 	"github.com/mmcdole/gofeed"
+	"github.com/mopemope/quicknews/config"
 	"github.com/mopemope/quicknews/ent"
+	"github.com/mopemope/quicknews/httpclient"
 	"github.com/mopemope/quicknews/models/feed"
+	"github.com/mopemope/quicknews/scraper"
 )
 
 // AddCmd represents the add command.
 type AddCmd struct {
-	URLs []string `arg:"" name:"url" help:"URLs of the RSS feeds to add." required:""`
+	URLs []string `arg:"" name:"url" help:"URLs of the RSS feeds to add, or of web pages to discover their feeds from." required:""`
+	All  bool     `help:"Add every feed discovered on a page instead of asking which ones."`
 }
 
 // Run executes the add command.
-func (a *AddCmd) Run(client *ent.Client) error {
+func (a *AddCmd) Run(client *ent.Client, config *config.Config) error {
 
 	ctx := context.Background()
-	fp := gofeed.NewParser()
 	repo := feed.NewRepository(client)
 
 	for _, url := range a.URLs {
-		// Check if the feed already exists
-		exists, err := repo.Exist(ctx, url)
+		feedURLs, err := a.feedURLs(ctx, config, repo, url)
 		if err != nil {
-			slog.Error("Error checking url", "url", url, "error", err)
+			slog.Error("Error finding feeds", "url", url, "error", err)
 			continue // Skip this URL on error
 		}
-		if exists {
-			slog.Info("Feed already exists", "url", url)
-			continue
+		for _, feedURL := range feedURLs {
+			addFeed(ctx, config, repo, feedURL)
 		}
+	}
 
-		// Fetch feed information
-		parsedFeed, err := fp.ParseURL(url)
-		if err != nil {
-			slog.Error("Error parsing", "url", url, "error", err)
-			continue // Skip this URL on error
+	slog.Info("Add command finished.")
+	return nil
+}
+
+// feedURLs returns the URL itself when it is a feed, or the feeds discovered on the page
+// it points to.
+func (a *AddCmd) feedURLs(ctx context.Context, config *config.Config, repo feed.FeedRepository, url string) ([]string, error) {
+	if exists, err := repo.Exist(ctx, url); err != nil || exists {
+		// Reported by addFeed
+		return []string{url}, nil
+	}
+	settings, err := httpclient.New(config, url)
+	if err != nil {
+		return nil, err
+	}
+	_, parseErr := newFeedParser(settings).ParseURLWithContext(url, ctx)
+	if parseErr == nil {
+		return []string{url}, nil
+	}
+
+	feeds, err := scraper.DiscoverFeeds(ctx, url, scraper.WithHTTP(settings))
+	if err != nil {
+		return nil, errors.CombineErrors(parseErr, err)
+	}
+	if len(feeds) == 0 {
+		return nil, errors.Wrap(parseErr, "no feed found")
+	}
+
+	chosen := feeds
+	if len(feeds) > 1 && !a.All {
+		if IsTTY() {
+			chosen, err = chooseFeeds(os.Stdin, os.Stdout, url, feeds)
+			if err != nil {
+				return nil, err
+			}
+		} else {
+			slog.Info("Several feeds found, adding the first one; use --all to add every feed", "url", url, "count", len(feeds))
+			chosen = feeds[:1]
 		}
+	}
 
-		// Create feed in the database
-		input := &feed.FeedInput{
-			URL:         url,
-			Title:       parsedFeed.Title,
-			Description: parsedFeed.Description,
-			Link:        parsedFeed.Link,
+	urls := make([]string, len(chosen))
+	for i, f := range chosen {
+		slog.Info("Discovered feed", "page", url, "url", f.URL, "title", f.Title)
+		urls[i] = f.URL
+	}
+	return urls, nil
+}
+
+// chooseFeeds asks which of the discovered feeds to add: numbers separated by commas or
+// spaces, "a" for all of them, or nothing for the first one.
+func chooseFeeds(r io.Reader, w io.Writer, page string, feeds []*scraper.Feed) ([]*scraper.Feed, error) {
+	_, _ = fmt.Fprintf(w, "Found %d feeds on %s:\n", len(feeds), page)
+	for i, f := range feeds {
+		title := f.Title
+		if title == "" {
+			title = "(untitled)"
 		}
-		err = repo.Save(ctx, input, false)
-		if err != nil {
-			slog.Error("Error saving feed", "url", url, "error", err)
-			continue // Skip this URL on error
+		_, _ = fmt.Fprintf(w, "  %d) %s <%s>\n", i+1, title, f.URL)
+	}
+	_, _ = fmt.Fprint(w, "Feeds to add [1]: ")
+
+	line, err := bufio.NewReader(r).ReadString('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, errors.Wrap(err, "failed to read answer")
+	}
+	line = strings.TrimSpace(line)
+	switch strings.ToLower(line) {
+	case "":
+		return feeds[:1], nil
+	case "a", "all":
+		return feeds, nil
+	}
+
+	var chosen []*scraper.Feed
+	for _, field := range strings.FieldsFunc(line, func(r rune) bool { return r == ',' || r == ' ' }) {
+		n, err := strconv.Atoi(field)
+		if err != nil || n < 1 || n > len(feeds) {
+			return nil, errors.Newf("invalid choice %q", field)
 		}
+		chosen = append(chosen, feeds[n-1])
+	}
+	return chosen, nil
+}
+
+// newFeedParser returns a feed parser sending its requests with the settings.
+func newFeedParser(settings *httpclient.Settings) *gofeed.Parser {
+	fp := gofeed.NewParser()
+	fp.Client = settings.Client(time.Minute)
+	return fp
+}
 
-		slog.Info("Successfully added feed", "title", input.Title, "url", input.URL)
+func addFeed(ctx context.Context, config *config.Config, repo feed.FeedRepository, url string) {
+	// Check if the feed already exists
+	exists, err := repo.Exist(ctx, url)
+	if err != nil {
+		slog.Error("Error checking url", "url", url, "error", err)
+		return // Skip this URL on error
+	}
+	if exists {
+		slog.Info("Feed already exists", "url", url)
+		return
 	}
 
-	slog.Info("Add command finished.")
-	return nil
+	settings, err := httpclient.New(config, url)
+	if err != nil {
+		slog.Error("Error reading feed settings", "url", url, "error", err)
+		return
+	}
+
+	// Fetch feed information
+	parsedFeed, err := newFeedParser(settings).ParseURLWithContext(url, ctx)
+	if err != nil {
+		slog.Error("Error parsing", "url", url, "error", err)
+		return // Skip this URL on error
+	}
+
+	// Create feed in the database
+	input := &feed.FeedInput{
+		URL:         url,
+		Title:       parsedFeed.Title,
+		Description: parsedFeed.Description,
+		Link:        parsedFeed.Link,
+	}
+	err = repo.Save(ctx, input, false)
+	if err != nil {
+		slog.Error("Error saving feed", "url", url, "error", err)
+		return // Skip this URL on error
+	}
+
+	slog.Info("Successfully added feed", "title", input.Title, "url", input.URL)
 }
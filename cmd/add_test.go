@@ -0,0 +1,36 @@
+package cmd
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/mopemope/quicknews/scraper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChooseFeeds(t *testing.T) {
+	feeds := []*scraper.Feed{
+		{URL: "https://example.com/rss.xml", Title: "RSS"},
+		{URL: "https://example.com/atom.xml"},
+		{URL: "https://example.com/comments.xml", Title: "Comments"},
+	}
+
+	var out bytes.Buffer
+	chosen, err := chooseFeeds(strings.NewReader("\n"), &out, "https://example.com", feeds)
+	require.NoError(t, err)
+	assert.Equal(t, feeds[:1], chosen)
+	assert.Contains(t, out.String(), "2) (untitled) <https://example.com/atom.xml>")
+
+	chosen, err = chooseFeeds(strings.NewReader("3, 1\n"), &out, "https://example.com", feeds)
+	require.NoError(t, err)
+	assert.Equal(t, []*scraper.Feed{feeds[2], feeds[0]}, chosen)
+
+	chosen, err = chooseFeeds(strings.NewReader("a"), &out, "https://example.com", feeds)
+	require.NoError(t, err)
+	assert.Equal(t, feeds, chosen)
+
+	_, err = chooseFeeds(strings.NewReader("4\n"), &out, "https://example.com", feeds)
+	assert.Error(t, err)
+}
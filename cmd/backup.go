@@ -0,0 +1,172 @@
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/mopemope/quicknews/backup"
+	"github.com/mopemope/quicknews/clock"
+	"github.com/mopemope/quicknews/config"
+	"github.com/mopemope/quicknews/database"
+	"github.com/mopemope/quicknews/ent"
+)
+
+// BackupCmd writes a backup of the database, optionally with the audio files and config.
+type BackupCmd struct {
+	Output     string `short:"o" help:"Output file (defaults to quicknews-backup-YYYYMMDD-HHMMSS.tar.gz)."`
+	WithAudio  bool   `help:"Bundle the audio files."`
+	WithConfig bool   `help:"Bundle the config file. It may hold API keys and passwords."`
+}
+
+func (c *BackupCmd) Run(config *config.Config) error {
+	opts := backup.Options{DB: config.DB}
+	if c.WithAudio {
+		if config.AudioPath == nil {
+			return errors.New("no audio directory. Please set audio in config")
+		}
+		opts.AudioPath = *config.AudioPath
+	}
+	if c.WithConfig {
+		opts.Config = config.SourcePath
+	}
+	output := c.Output
+	if output == "" {
+		output = fmt.Sprintf("quicknews-backup-%s.tar.gz", clock.Now().Format("20060102-150405"))
+	}
+
+	f, err := os.Create(output)
+	if err != nil {
+		return errors.Wrap(err, "failed to create backup file")
+	}
+	manifest, err := backup.Write(context.Background(), f, opts)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		_ = os.Remove(output)
+		return err
+	}
+	fmt.Printf("Backed up %s to %s\n", backupContents(manifest), output)
+	return nil
+}
+
+// RestoreCmd restores a backup written by the backup command.
+type RestoreCmd struct {
+	File       string `arg:"" type:"existingfile" help:"Backup file, or a copy of the database file."`
+	WithAudio  bool   `help:"Restore the audio files into the audio directory."`
+	WithConfig bool   `help:"Restore the config file, replacing the current one."`
+	Yes        bool   `short:"y" help:"Do not ask for confirmation."`
+}
+
+func (c *RestoreCmd) Run(client *ent.Client, config *config.Config) error {
+	ctx := context.Background()
+	dir, err := os.MkdirTemp("", "quicknews-restore-*")
+	if err != nil {
+		return errors.Wrap(err, "failed to create temporary directory")
+	}
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	archive, err := backup.Extract(c.File, dir)
+	if err != nil {
+		return err
+	}
+	// Check the backup before touching anything
+	missing, err := database.CheckSchema(ctx, archive.DatabasePath())
+	if err != nil {
+		return errors.Wrap(err, "incompatible backup")
+	}
+	if c.WithAudio && archive.Manifest.AudioFiles > 0 && config.AudioPath == nil {
+		return errors.New("no audio directory. Please set audio in config")
+	}
+	if c.WithConfig && archive.Manifest.Config == "" {
+		return errors.New("backup has no config")
+	}
+
+	manifest := archive.Manifest
+	if !manifest.CreatedAt.IsZero() {
+		fmt.Printf("Backup of %s with %s\n", manifest.CreatedAt.Local().Format(time.DateTime), backupContents(manifest))
+	}
+	if len(missing) > 0 {
+		fmt.Printf("Added by the migration: %s\n", strings.Join(missing, ", "))
+	}
+	if !c.Yes {
+		if !IsTTY() {
+			return errors.New("restore replaces the database. Use --yes to confirm")
+		}
+		ok, err := confirm(os.Stdin, os.Stdout, fmt.Sprintf("Replace %s with the backup?", config.DB))
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+	}
+
+	// Keep the current database in case the backup was the wrong one
+	previous := config.DB + ".bak"
+	if err := os.Remove(previous); err != nil && !os.IsNotExist(err) {
+		return errors.Wrap(err, "failed to remove previous copy")
+	}
+	if err := database.Snapshot(ctx, config.DB, previous); err != nil {
+		return err
+	}
+	if err := database.Restore(ctx, archive.DatabasePath(), config.DB); err != nil {
+		return err
+	}
+	if err := client.Schema.Create(ctx); err != nil {
+		return errors.Wrap(err, "failed to migrate restored database")
+	}
+	fmt.Printf("Restored the database; the previous one is kept in %s\n", previous)
+
+	if c.WithAudio && manifest.AudioFiles > 0 {
+		n, err := archive.RestoreAudio(*config.AudioPath)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Restored %d audio files\n", n)
+	}
+	if c.WithConfig {
+		if err := archive.RestoreConfig(config.SourcePath); err != nil {
+			return err
+		}
+		fmt.Printf("Restored the config to %s\n", config.SourcePath)
+	}
+	return nil
+}
+
+// backupContents describes the contents of a backup, e.g. "the database and 3 audio files".
+func backupContents(manifest *backup.Manifest) string {
+	contents := []string{"the database"}
+	if manifest.AudioFiles > 0 {
+		contents = append(contents, fmt.Sprintf("%d audio files", manifest.AudioFiles))
+	}
+	if manifest.Config != "" {
+		contents = append(contents, "the config")
+	}
+	if len(contents) == 1 {
+		return contents[0]
+	}
+	return strings.Join(contents[:len(contents)-1], ", ") + " and " + contents[len(contents)-1]
+}
+
+// confirm asks a yes/no question, no being the default.
+func confirm(r io.Reader, w io.Writer, question string) (bool, error) {
+	_, _ = fmt.Fprintf(w, "%s [y/N]: ", question)
+	line, err := bufio.NewReader(r).ReadString('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		return false, errors.Wrap(err, "failed to read answer")
+	}
+	switch strings.ToLower(strings.TrimSpace(line)) {
+	case "y", "yes":
+		return true, nil
+	}
+	return false, nil
+}
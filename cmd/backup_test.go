@@ -0,0 +1,64 @@
+package cmd
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"github.com/mopemope/quicknews/config"
+	"github.com/mopemope/quicknews/ent/enttest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBackupAndRestoreCmd(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	db := filepath.Join(dir, "quicknews.db")
+	client := enttest.Open(t, dialect.SQLite, "file:"+db+"?cache=shared&_fk=1")
+	defer func() { _ = client.Close() }()
+
+	audioPath := filepath.Join(dir, "audio")
+	require.NoError(t, os.Mkdir(audioPath, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(audioPath, "summary.mp3"), []byte("audio"), 0o644))
+	cfg := &config.Config{DB: db, AudioPath: &audioPath}
+
+	_, err := client.Feed.Create().
+		SetURL("https://example.com/feed").
+		SetTitle("Example").
+		SetUpdatedAt(time.Now()).
+		Save(ctx)
+	require.NoError(t, err)
+
+	file := filepath.Join(dir, "backup.tar.gz")
+	require.NoError(t, (&BackupCmd{Output: file, WithAudio: true}).Run(cfg))
+
+	_, err = client.Feed.Delete().Exec(ctx)
+	require.NoError(t, err)
+	require.NoError(t, os.Remove(filepath.Join(audioPath, "summary.mp3")))
+
+	assert.Error(t, (&RestoreCmd{File: file, WithConfig: true, Yes: true}).Run(client, cfg), "the backup has no config")
+	require.NoError(t, (&RestoreCmd{File: file, WithAudio: true, Yes: true}).Run(client, cfg))
+
+	count, err := client.Feed.Query().Count(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+	assert.FileExists(t, filepath.Join(audioPath, "summary.mp3"))
+	assert.FileExists(t, db+".bak", "the replaced database is kept")
+}
+
+func TestConfirm(t *testing.T) {
+	var out strings.Builder
+	ok, err := confirm(strings.NewReader("y\n"), &out, "Replace?")
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "Replace? [y/N]: ", out.String())
+
+	ok, err = confirm(strings.NewReader("\n"), &out, "Replace?")
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
@@ -51,12 +51,16 @@ func sanitizeConfigEntries(cfg *config.Config, showSecrets bool) []configEntry {
 	add("config.path", cfg.SourcePath)
 	add("db", cfg.DB)
 	add("export_org", cfg.ExportOrg)
+	add("export_org_template", cfg.ExportOrgTemplate)
+	add("export_org_daily", cfg.ExportOrgDaily)
+	add("export_markdown", cfg.ExportMarkdown)
 	add("audio", derefString(cfg.AudioPath))
 	add("use_gemini_tts", cfg.UseGeminiTTS)
 	add("enable_env_override", cfg.EnableEnvOverride)
 	add("speaking_rate", cfg.SpeakingRate)
 	add("require_confirm", cfg.RequireConfirm)
 	add("save_audio_data", cfg.SaveAudioData)
+	add("feed_max_failures", cfg.FeedMaxFailures)
 	add("gemini_api_key", maskIfNeeded("gemini_api_key", cfg.GeminiApiKey, showSecrets))
 	add("google_application_credentials", maskIfNeeded("google_application_credentials", cfg.GoogleApplicationCredentials, showSecrets))
 
@@ -88,10 +92,145 @@ func sanitizeConfigEntries(cfg *config.Config, showSecrets bool) []configEntry {
 		add("podcast.channel_desc", cfg.Podcast.ChannelDesc)
 		add("podcast.author", cfg.Podcast.Author)
 		add("podcast.publish_url", cfg.Podcast.PublishURL)
+		add("podcast.combined", cfg.Podcast.Combined)
+		add("podcast.image_url", cfg.Podcast.ImageURL)
+		add("podcast.owner_email", cfg.Podcast.OwnerEmail)
+		add("podcast.language", cfg.Podcast.Language)
+		add("podcast.category", cfg.Podcast.Category)
+		add("podcast.explicit", cfg.Podcast.Explicit)
+		add("podcast.retention_days", cfg.Podcast.RetentionDays)
+		add("podcast.retention_count", cfg.Podcast.RetentionCount)
+		add("podcast.intro_file", cfg.Podcast.IntroFile)
+		add("podcast.outro_file", cfg.Podcast.OutroFile)
+		add("podcast.spoken_header", cfg.Podcast.SpokenHeader)
+		add("podcast.header_template", cfg.Podcast.HeaderTemplate)
+		add("podcast.separator", cfg.Podcast.Separator)
+		add("podcast.separator_sec", cfg.Podcast.SeparatorSec)
 	} else {
 		add("podcast", nil)
 	}
 
+	if cfg.Loudness != nil {
+		add("loudness.target_lufs", cfg.Loudness.TargetLUFS)
+		add("loudness.true_peak", cfg.Loudness.TruePeak)
+		add("loudness.lra", cfg.Loudness.LRA)
+	} else {
+		add("loudness", nil)
+	}
+
+	if cfg.Mail != nil {
+		add("mail.host", cfg.Mail.Host)
+		add("mail.port", cfg.Mail.Port)
+		add("mail.username", cfg.Mail.Username)
+		add("mail.password", maskIfNeeded("mail_password", cfg.Mail.Password, showSecrets))
+		add("mail.starttls", cfg.Mail.StartTLS)
+		add("mail.from", cfg.Mail.From)
+		add("mail.to", strings.Join(cfg.Mail.To, ", "))
+		add("mail.subject", cfg.Mail.Subject)
+		add("mail.attach_audio", cfg.Mail.AttachAudio)
+		add("mail.audio_base_url", cfg.Mail.AudioBaseURL)
+	} else {
+		add("mail", nil)
+	}
+
+	if cfg.Polling != nil {
+		add("polling.min_interval", cfg.Polling.MinInterval.String())
+		add("polling.max_interval", cfg.Polling.MaxInterval.String())
+	} else {
+		add("polling", nil)
+	}
+
+	if cfg.Dedup != nil {
+		add("dedup.resolve_redirects", cfg.Dedup.ResolveRedirects)
+		add("dedup.title_threshold", cfg.Dedup.TitleThreshold)
+		add("dedup.window_hours", cfg.Dedup.WindowHours)
+	} else {
+		add("dedup", nil)
+	}
+
+	if cfg.HTTP != nil {
+		add("http.user_agent", cfg.HTTP.UserAgent)
+		add("http.proxy", cfg.HTTP.Proxy)
+	} else {
+		add("http", nil)
+	}
+
+	if cfg.Crawl != nil {
+		add("crawl.requests_per_second", cfg.Crawl.RequestsPerSecond)
+		add("crawl.burst", cfg.Crawl.Burst)
+		for host, rps := range cfg.Crawl.Hosts {
+			add("crawl.hosts."+host, rps)
+		}
+		add("crawl.ignore_robots_txt", cfg.Crawl.IgnoreRobotsTxt)
+	} else {
+		add("crawl", nil)
+	}
+
+	if cfg.Transcript != nil {
+		add("transcript.command", cfg.Transcript.Command)
+		add("transcript.timeout", cfg.Transcript.Timeout.String())
+		add("transcript.max_size_mb", cfg.Transcript.MaxSizeMB)
+	} else {
+		add("transcript", nil)
+	}
+
+	if cfg.WebSub != nil {
+		add("websub.listen", cfg.WebSub.Listen)
+		add("websub.callback_url", cfg.WebSub.CallbackURL)
+		add("websub.lease", cfg.WebSub.Lease.String())
+	} else {
+		add("websub", nil)
+	}
+
+	if cfg.Retention != nil {
+		add("retention.max_age_days", cfg.Retention.MaxAgeDays)
+		add("retention.max_per_feed", cfg.Retention.MaxPerFeed)
+		add("retention.keep_unread", cfg.Retention.KeepUnread)
+		add("retention.auto", cfg.Retention.Auto)
+	} else {
+		add("retention", nil)
+	}
+
+	for _, f := range cfg.Feeds {
+		prefix := "feeds." + f.URL + "."
+		add(prefix+"username", f.Username)
+		add(prefix+"password", maskIfNeeded("feed_password", f.Password, showSecrets))
+		add(prefix+"token", maskIfNeeded("feed_secret_token", f.Token, showSecrets))
+		add(prefix+"cookie", maskIfNeeded("feed_secret_cookie", f.Cookie, showSecrets))
+		headers := make([]string, 0, len(f.Headers))
+		for k := range f.Headers {
+			headers = append(headers, k)
+		}
+		sort.Strings(headers)
+		add(prefix+"headers", strings.Join(headers, ", "))
+		add(prefix+"user_agent", f.UserAgent)
+		add(prefix+"proxy", f.Proxy)
+	}
+
+	for _, w := range cfg.Webhooks {
+		prefix := "webhooks." + w.Name + "."
+		add(prefix+"url", w.URL)
+		add(prefix+"events", strings.Join(w.Events, ", "))
+		add(prefix+"secret", maskIfNeeded("webhook_secret", w.Secret, showSecrets))
+		add(prefix+"template", w.Template)
+		add(prefix+"content_type", w.ContentType)
+		add(prefix+"audio_base_url", w.AudioBaseURL)
+		add(prefix+"max_retries", w.MaxRetries)
+	}
+
+	for _, r := range cfg.Rules {
+		prefix := "rules." + r.Name + "."
+		add(prefix+"feed", r.Feed)
+		add(prefix+"title", r.Title)
+		add(prefix+"description", r.Description)
+		add(prefix+"author", r.Author)
+		add(prefix+"keywords", strings.Join(r.Keywords, ", "))
+		add(prefix+"actions", strings.Join(r.Actions, ", "))
+		add(prefix+"tags", strings.Join(r.Tags, ", "))
+		add(prefix+"priority", r.Priority)
+		add(prefix+"prompt", r.Prompt)
+	}
+
 	sort.Slice(entries, func(i, j int) bool {
 		return entries[i].key < entries[j].key
 	})
@@ -133,7 +272,7 @@ func maskIfNeeded(field string, value string, showSecrets bool) any {
 		return value
 	}
 	lower := strings.ToLower(field)
-	if !strings.Contains(lower, "key") && !strings.Contains(lower, "secret") && !strings.Contains(lower, "credential") {
+	if !strings.Contains(lower, "key") && !strings.Contains(lower, "secret") && !strings.Contains(lower, "credential") && !strings.Contains(lower, "password") {
 		return value
 	}
 	if len(value) <= 4 {
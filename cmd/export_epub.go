@@ -0,0 +1,148 @@
+package cmd
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/google/uuid"
+	"github.com/mopemope/quicknews/config"
+	"github.com/mopemope/quicknews/ent"
+	"github.com/mopemope/quicknews/epub"
+	"github.com/mopemope/quicknews/httpclient"
+	"github.com/mopemope/quicknews/models/summary"
+	"github.com/mopemope/quicknews/scraper"
+)
+
+type ExportEpubCmd struct {
+	Since    string `short:"s" default:"1d" help:"Include summaries created since this date (YYYY-MM-DD) or for this period (e.g. 1d, 7d, 12h)."`
+	Output   string `short:"o" type:"path" help:"Output file path. Defaults to quicknews-YYYY-MM-DD.epub."`
+	Full     bool   `help:"Include the full article content, extracted from the page, after each summary. Falls back to the content of the feed."`
+	Title    string `help:"Title of the book."`
+	Language string `default:"ja" help:"Language of the book."`
+}
+
+func (c *ExportEpubCmd) Run(client *ent.Client, config *config.Config) error {
+	now := time.Now()
+	since, err := parseSince(c.Since, now)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	sums, err := summary.NewRepository(client).GetAll(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get summaries")
+	}
+
+	book := &epub.Book{
+		ID:       "urn:uuid:" + uuid.NewString(),
+		Title:    cmp.Or(c.Title, fmt.Sprintf("quicknews %s - %s", since.Format("2006-01-02"), now.Format("2006-01-02"))),
+		Language: c.Language,
+		Modified: now,
+	}
+
+	sections := map[uuid.UUID]*epub.Section{}
+	var feeds []*ent.Feed
+	count := 0
+	for _, sum := range sums {
+		if sum.CreatedAt.Before(since) || sum.Edges.Feed == nil {
+			continue
+		}
+		f := sum.Edges.Feed
+		section, ok := sections[f.ID]
+		if !ok {
+			section = &epub.Section{Title: f.Title}
+			sections[f.ID] = section
+			feeds = append(feeds, f)
+		}
+		a := &epub.Article{
+			ID:        sum.ID.String(),
+			Title:     sum.Title,
+			URL:       sum.URL,
+			Published: sum.CreatedAt,
+			Summary:   sum.Summary,
+		}
+		if art := sum.Edges.Article; art != nil {
+			if !art.PublishedAt.IsZero() {
+				a.Published = art.PublishedAt
+			}
+			if c.Full {
+				a.Content = fullContent(ctx, config, art)
+			}
+		}
+		section.Articles = append(section.Articles, a)
+		count++
+	}
+	if count == 0 {
+		fmt.Printf("No summaries found since %s.\n", since.Format(time.DateTime))
+		return nil
+	}
+
+	// Feeds in feed order, articles oldest first.
+	slices.SortStableFunc(feeds, func(a, b *ent.Feed) int {
+		return cmp.Or(cmp.Compare(a.Order, b.Order), cmp.Compare(a.Title, b.Title))
+	})
+	for _, f := range feeds {
+		section := sections[f.ID]
+		slices.SortStableFunc(section.Articles, func(a, b *epub.Article) int {
+			return a.Published.Compare(b.Published)
+		})
+		book.Sections = append(book.Sections, section)
+	}
+
+	output := cmp.Or(c.Output, fmt.Sprintf("quicknews-%s.epub", now.Format("2006-01-02")))
+	out, err := os.Create(output)
+	if err != nil {
+		return errors.Wrapf(err, "failed to create %s", output)
+	}
+	defer func() {
+		if err := out.Close(); err != nil {
+			slog.Warn("Failed to close EPUB file", "path", output, "error", err)
+		}
+	}()
+	if err := book.Write(out); err != nil {
+		return err
+	}
+	fmt.Printf("Exported %d summaries from %d feeds to %s\n", count, len(book.Sections), output)
+	return nil
+}
+
+// fullContent returns the paragraphs of the main content of the article page, or of the
+// content from the feed when the page cannot be extracted.
+func fullContent(ctx context.Context, config *config.Config, art *ent.Article) []string {
+	settings, err := httpclient.New(config, art.URL)
+	if err == nil {
+		var content *scraper.Content
+		content, err = scraper.ExtractContent(ctx, art.URL, scraper.WithHTTP(settings))
+		if err == nil {
+			return strings.Split(content.Text, "\n")
+		}
+	}
+	slog.Warn("Failed to extract article content, using the feed content", "url", art.URL, "error", err)
+	return epub.Paragraphs(cmp.Or(art.Content, art.Description))
+}
+
+// parseSince parses a date (YYYY-MM-DD), a number of days (7d) or a duration (12h).
+func parseSince(s string, now time.Time) (time.Time, error) {
+	if t, err := time.ParseInLocation("2006-01-02", s, time.Local); err == nil {
+		return t, nil
+	}
+	if days, ok := strings.CutSuffix(s, "d"); ok {
+		if n, err := strconv.Atoi(days); err == nil {
+			return now.AddDate(0, 0, -n), nil
+		}
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return time.Time{}, errors.Newf("invalid --since value %q", s)
+	}
+	return now.Add(-d), nil
+}
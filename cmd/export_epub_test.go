@@ -0,0 +1,53 @@
+package cmd
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/mopemope/quicknews/config"
+	"github.com/mopemope/quicknews/ent"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSince(t *testing.T) {
+	now := time.Date(2024, 3, 10, 12, 0, 0, 0, time.Local)
+
+	since, err := parseSince("2024-03-01", now)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 3, 1, 0, 0, 0, 0, time.Local), since)
+
+	since, err = parseSince("7d", now)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 3, 3, 12, 0, 0, 0, time.Local), since)
+
+	since, err = parseSince("12h", now)
+	require.NoError(t, err)
+	assert.Equal(t, now.Add(-12*time.Hour), since)
+
+	_, err = parseSince("yesterday", now)
+	assert.Error(t, err)
+}
+
+func TestFullContent(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/article" {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write([]byte(`<html><head><title>Article</title></head><body>
+<nav>Menu</nav><article><p>First paragraph.</p><p>Second paragraph.</p></article></body></html>`))
+	}))
+	defer server.Close()
+	ctx := context.Background()
+	cfg := &config.Config{}
+
+	art := &ent.Article{URL: server.URL + "/article", Content: "<p>Teaser</p>"}
+	assert.Equal(t, []string{"First paragraph.", "Second paragraph."}, fullContent(ctx, cfg, art))
+
+	art = &ent.Article{URL: server.URL + "/missing", Content: "<p>Teaser</p>"}
+	assert.Equal(t, []string{"Teaser"}, fullContent(ctx, cfg, art), "falls back to the feed content")
+}
@@ -0,0 +1,106 @@
+package cmd
+
+import (
+	"cmp"
+	"context"
+	"io"
+	"log/slog"
+	"os"
+	"slices"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/mopemope/quicknews/config"
+	"github.com/mopemope/quicknews/ent"
+	"github.com/mopemope/quicknews/feedgen"
+	"github.com/mopemope/quicknews/models/summary"
+)
+
+type ExportFeedCmd struct {
+	Format   string `short:"f" enum:"atom,json" default:"atom" help:"Output format: atom (Atom 1.0) or json (JSON Feed 1.1)."`
+	Output   string `short:"o" type:"path" help:"Output file path. Defaults to stdout."`
+	Feed     string `help:"Only include summaries of the feed with this URL or title."`
+	Bookmark bool   `help:"Only include bookmarked pages."`
+	Tag      string `help:"Only include summaries with this tag, added by the rules."`
+	Days     int    `help:"Only include summaries of the last N days. 0 includes every summary." default:"7"`
+	Limit    int    `help:"Maximum number of entries. 0 means no limit." default:"50"`
+	Title    string `help:"Title of the feed. Defaults to the podcast channel title."`
+	Link     string `help:"Home page URL of the feed."`
+	FeedURL  string `name:"feed-url" help:"URL the document will be published at."`
+}
+
+func (c *ExportFeedCmd) Run(client *ent.Client, config *config.Config) error {
+	ctx := context.Background()
+	sums, err := summary.NewRepository(client).GetAll(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get summaries")
+	}
+
+	var since time.Time
+	if c.Days > 0 {
+		since = time.Now().AddDate(0, 0, -c.Days)
+	}
+	entries := make([]*feedgen.Entry, 0, len(sums))
+	for _, sum := range sums {
+		if !c.match(sum, since) {
+			continue
+		}
+		entries = append(entries, feedgen.EntryFromSummary(sum))
+		if c.Limit > 0 && len(entries) >= c.Limit {
+			break
+		}
+	}
+
+	meta := &feedgen.Meta{
+		Title:   c.Title,
+		Link:    c.Link,
+		FeedURL: c.FeedURL,
+		Author:  "quicknews",
+		Updated: time.Now(),
+	}
+	if config.Podcast != nil {
+		meta.Title = cmp.Or(meta.Title, config.Podcast.ChannelTitle)
+		meta.Author = cmp.Or(config.Podcast.Author, meta.Author)
+	}
+	meta.Title = cmp.Or(meta.Title, "quicknews")
+	if len(entries) > 0 {
+		// Summaries are ordered newest first.
+		meta.Updated = entries[0].Updated
+	}
+
+	var w io.Writer = os.Stdout
+	if c.Output != "" {
+		f, err := os.Create(c.Output)
+		if err != nil {
+			return errors.Wrapf(err, "failed to create %s", c.Output)
+		}
+		defer func() {
+			if err := f.Close(); err != nil {
+				slog.Warn("Failed to close output file", "path", c.Output, "error", err)
+			}
+		}()
+		w = f
+	}
+
+	if c.Format == "json" {
+		return feedgen.WriteJSONFeed(w, meta, entries)
+	}
+	return feedgen.WriteAtom(w, meta, entries)
+}
+
+func (c *ExportFeedCmd) match(sum *ent.Summary, since time.Time) bool {
+	if !since.IsZero() && sum.CreatedAt.Before(since) {
+		return false
+	}
+	f := sum.Edges.Feed
+	if c.Bookmark && (f == nil || !f.IsBookmark) {
+		return false
+	}
+	if c.Feed != "" && (f == nil || (f.URL != c.Feed && f.Title != c.Feed)) {
+		return false
+	}
+	if c.Tag != "" && !slices.Contains(sum.Tags, c.Tag) {
+		return false
+	}
+	return true
+}
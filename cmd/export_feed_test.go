@@ -0,0 +1,21 @@
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mopemope/quicknews/ent"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExportFeedCmd_Match(t *testing.T) {
+	sum := &ent.Summary{CreatedAt: time.Now(), Tags: []string{"go", "release"}}
+	sum.Edges.Feed = &ent.Feed{URL: "https://example.com/feed", Title: "Example"}
+
+	assert.True(t, (&ExportFeedCmd{}).match(sum, time.Time{}))
+	assert.True(t, (&ExportFeedCmd{Tag: "go"}).match(sum, time.Time{}))
+	assert.False(t, (&ExportFeedCmd{Tag: "rust"}).match(sum, time.Time{}))
+	assert.False(t, (&ExportFeedCmd{Tag: "go"}).match(&ent.Summary{CreatedAt: time.Now()}, time.Time{}))
+	assert.True(t, (&ExportFeedCmd{Feed: "Example", Tag: "release"}).match(sum, time.Time{}))
+	assert.False(t, (&ExportFeedCmd{Bookmark: true, Tag: "go"}).match(sum, time.Time{}))
+}
@@ -0,0 +1,198 @@
+package cmd
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/mopemope/quicknews/ent"
+	"github.com/mopemope/quicknews/models/feed"
+)
+
+// FeedsCmd groups the feed management subcommands.
+type FeedsCmd struct {
+	List     FeedsListCmd     `cmd:"" default:"1" help:"List feeds in publish order."`
+	Order    FeedsOrderCmd    `cmd:"" help:"Set the order (priority) of a feed. Lower values come first."`
+	Health   FeedsHealthCmd   `cmd:"" help:"Report failing and disabled feeds."`
+	Enable   FeedsEnableCmd   `cmd:"" help:"Enable fetching a feed again."`
+	Disable  FeedsDisableCmd  `cmd:"" help:"Stop fetching a feed."`
+	Interval FeedsIntervalCmd `cmd:"" help:"Set the minimum and maximum polling interval of a feed."`
+}
+
+// FeedsListCmd lists the registered feeds.
+type FeedsListCmd struct{}
+
+func (c *FeedsListCmd) Run(client *ent.Client) error {
+	ctx := context.Background()
+	feeds, err := feed.NewRepository(client).All(ctx)
+	if err != nil {
+		return err
+	}
+
+	tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(tw, "ORDER\tTITLE\tURL\tNEXT POLL\tPUSH")
+	for _, f := range feeds {
+		next := "due"
+		switch {
+		case f.IsBookmark:
+			next = "-"
+		case f.Disabled:
+			next = "disabled"
+		case f.NextPollAt != nil && f.NextPollAt.After(time.Now()):
+			next = f.NextPollAt.Local().Format(time.DateTime)
+		}
+		push := "-"
+		if f.HubURL != "" {
+			// A hub is advertised; the state is empty until the subscriber runs.
+			push = cmp.Or(f.WebsubState, "hub")
+		}
+		_, _ = fmt.Fprintf(tw, "%d\t%s\t%s\t%s\t%s\n", f.Order, f.Title, f.URL, next, push)
+	}
+	return tw.Flush()
+}
+
+// FeedsOrderCmd changes the order of a feed.
+type FeedsOrderCmd struct {
+	URL   string `arg:"" name:"url" help:"URL of the feed."`
+	Order int    `arg:"" name:"order" help:"New order value."`
+}
+
+func (c *FeedsOrderCmd) Run(client *ent.Client) error {
+	ctx := context.Background()
+	repo := feed.NewRepository(client)
+	f, err := repo.GetByURL(ctx, c.URL)
+	if err != nil {
+		return err
+	}
+	if err := repo.UpdateOrder(ctx, f.ID, c.Order); err != nil {
+		return err
+	}
+	fmt.Printf("Set order of %s to %d.\n", f.Title, c.Order)
+	return nil
+}
+
+// FeedsHealthCmd reports the outcome of the last fetches of each feed.
+type FeedsHealthCmd struct {
+	Failing bool `short:"f" help:"Only show failing and disabled feeds."`
+}
+
+func (c *FeedsHealthCmd) Run(client *ent.Client) error {
+	ctx := context.Background()
+	feeds, err := feed.NewRepository(client).All(ctx)
+	if err != nil {
+		return err
+	}
+	return printFeedHealth(os.Stdout, feeds, c.Failing)
+}
+
+func printFeedHealth(w io.Writer, feeds []*ent.Feed, failing bool) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(tw, "STATE\tFAILURES\tSTATUS\tLAST SUCCESS\tTITLE\tLAST ERROR")
+	for _, f := range feeds {
+		if f.IsBookmark {
+			continue
+		}
+		state := feedState(f)
+		if failing && state == "ok" {
+			continue
+		}
+		status, success := "-", "never"
+		if f.LastStatus > 0 {
+			status = fmt.Sprint(f.LastStatus)
+		}
+		if f.LastSuccessAt != nil {
+			success = f.LastSuccessAt.Local().Format(time.DateTime)
+		}
+		lastError := strings.ReplaceAll(f.LastError, "\n", " ")
+		_, _ = fmt.Fprintf(tw, "%s\t%d\t%s\t%s\t%s\t%s\n", state, f.ConsecutiveFailures, status, success, f.Title, lastError)
+	}
+	return tw.Flush()
+}
+
+// feedState summarizes the health of a feed as ok, failing or disabled.
+func feedState(f *ent.Feed) string {
+	switch {
+	case f.Disabled:
+		return "disabled"
+	case f.ConsecutiveFailures > 0:
+		return "failing"
+	default:
+		return "ok"
+	}
+}
+
+// FeedsEnableCmd re-enables a disabled feed.
+type FeedsEnableCmd struct {
+	URL string `arg:"" name:"url" help:"URL of the feed."`
+}
+
+func (c *FeedsEnableCmd) Run(client *ent.Client) error {
+	return setFeedDisabled(client, c.URL, false)
+}
+
+// FeedsDisableCmd disables a feed without deleting its articles.
+type FeedsDisableCmd struct {
+	URL string `arg:"" name:"url" help:"URL of the feed."`
+}
+
+func (c *FeedsDisableCmd) Run(client *ent.Client) error {
+	return setFeedDisabled(client, c.URL, true)
+}
+
+func setFeedDisabled(client *ent.Client, url string, disabled bool) error {
+	ctx := context.Background()
+	repo := feed.NewRepository(client)
+	f, err := repo.GetByURL(ctx, url)
+	if err != nil {
+		return err
+	}
+	if err := repo.SetDisabled(ctx, f.ID, disabled); err != nil {
+		return err
+	}
+	if disabled {
+		fmt.Printf("Disabled %s.\n", f.Title)
+	} else {
+		fmt.Printf("Enabled %s.\n", f.Title)
+	}
+	return nil
+}
+
+// FeedsIntervalCmd overrides the configured polling bounds of a feed.
+type FeedsIntervalCmd struct {
+	URL string        `arg:"" name:"url" help:"URL of the feed."`
+	Min time.Duration `help:"Minimum interval between two polls (e.g., 1h). 0 uses the configured one."`
+	Max time.Duration `help:"Maximum interval between two polls (e.g., 72h). 0 uses the configured one."`
+}
+
+func (c *FeedsIntervalCmd) Run(client *ent.Client) error {
+	if c.Min < 0 || c.Max < 0 || (c.Max > 0 && c.Max < c.Min) {
+		return errors.New("invalid polling interval")
+	}
+	if (c.Min > 0 && c.Min < time.Minute) || (c.Max > 0 && c.Max < time.Minute) {
+		return errors.New("polling intervals must be at least a minute")
+	}
+	ctx := context.Background()
+	repo := feed.NewRepository(client)
+	f, err := repo.GetByURL(ctx, c.URL)
+	if err != nil {
+		return err
+	}
+	if err := repo.SetIntervals(ctx, f.ID, int(c.Min.Minutes()), int(c.Max.Minutes())); err != nil {
+		return err
+	}
+	fmt.Printf("Set polling interval of %s to min %s, max %s.\n", f.Title, intervalString(c.Min), intervalString(c.Max))
+	return nil
+}
+
+func intervalString(d time.Duration) string {
+	if d == 0 {
+		return "default"
+	}
+	return d.String()
+}
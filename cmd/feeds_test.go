@@ -0,0 +1,34 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/mopemope/quicknews/ent"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrintFeedHealth(t *testing.T) {
+	success := time.Date(2025, 6, 1, 12, 0, 0, 0, time.Local)
+	feeds := []*ent.Feed{
+		{Title: "Healthy", LastStatus: 200, LastSuccessAt: &success},
+		{Title: "Broken", LastStatus: 404, ConsecutiveFailures: 3, LastError: "http error: 404 Not Found"},
+		{Title: "Dead", ConsecutiveFailures: 10, Disabled: true, LastError: "dial tcp: no such host"},
+		{Title: "Bookmarks", IsBookmark: true},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, printFeedHealth(&buf, feeds, false))
+	lines := bytes.Split(bytes.TrimSpace(buf.Bytes()), []byte("\n"))
+	require.Len(t, lines, 4)
+	assert.Regexp(t, `^ok\s+0\s+200\s+2025-06-01 12:00:00\s+Healthy$`, string(bytes.TrimSpace(lines[1])))
+	assert.Regexp(t, `^failing\s+3\s+404\s+never\s+Broken\s+http error: 404 Not Found$`, string(lines[2]))
+	assert.Regexp(t, `^disabled\s+10\s+-\s+never\s+Dead\s+dial tcp: no such host$`, string(lines[3]))
+
+	buf.Reset()
+	require.NoError(t, printFeedHealth(&buf, feeds, true))
+	assert.NotContains(t, buf.String(), "Healthy")
+	assert.Contains(t, buf.String(), "Broken")
+}
@@ -21,7 +21,7 @@ import (
 
 // FetchCmd represents the fetch command.
 type FetchCmd struct {
-	Interval time.Duration `short:"i" help:"Fetch feeds updated within the specified interval (e.g., 24h). Default is 0 (fetch all)."`
+	Interval time.Duration `short:"i" help:"Fetch repeatedly at the specified interval (e.g., 30m), polling only the feeds that are due. Default is 0 (fetch all feeds once)."`
 }
 
 func (cmd *FetchCmd) Run(client *ent.Client, config *config.Config) error {
@@ -32,9 +32,17 @@ func (cmd *FetchCmd) Run(client *ent.Client, config *config.Config) error {
 	summaryRepos := summary.NewRepository(client)
 
 	feedProcessor := fetch.NewFeedProcessor(feedRepos, articleRepos, summaryRepos, config)
+	if cmd.Interval > 0 {
+		startWebSub(client, config)
+	}
 
 	for {
-		items, err := feedProcessor.GetItems(ctx)
+		getItems := feedProcessor.GetItems
+		if cmd.Interval > 0 {
+			// Repeated fetches only poll the feeds that are due
+			getItems = feedProcessor.GetDueItems
+		}
+		items, err := getItems(ctx)
 		if err != nil {
 			return err
 		}
@@ -69,6 +77,7 @@ func (cmd *FetchCmd) Run(client *ent.Client, config *config.Config) error {
 		} else {
 			fmt.Println("No new items to process.")
 		}
+		autoPrune(ctx, client, config)
 
 		if cmd.Interval > 0 {
 			time.Sleep(cmd.Interval)
@@ -3,34 +3,54 @@ package fetch
 import (
 	"context"
 	"log/slog"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/cockroachdb/errors"
 	"github.com/mmcdole/gofeed"
 	"github.com/mopemope/quicknews/config"
+	"github.com/mopemope/quicknews/dedup"
 	"github.com/mopemope/quicknews/ent"
+	"github.com/mopemope/quicknews/ent/schema"
+	"github.com/mopemope/quicknews/exporter"
 	"github.com/mopemope/quicknews/gemini"
+	"github.com/mopemope/quicknews/httpclient"
 	"github.com/mopemope/quicknews/models/article"
+	"github.com/mopemope/quicknews/models/feed"
 	"github.com/mopemope/quicknews/models/summary"
-	"github.com/mopemope/quicknews/org"
+	"github.com/mopemope/quicknews/rules"
+	"github.com/mopemope/quicknews/transcript"
+	"github.com/mopemope/quicknews/webhook"
 )
 
 // ArticleProcessor handles the processing of individual articles
 type ArticleProcessor struct {
 	feed         *ent.Feed
 	feedItem     *gofeed.Item
+	feedRepos    feed.FeedRepository
 	articleRepos article.ArticleRepository
 	summaryRepos summary.SummaryRepository
+	exporters    exporter.Exporters
+	webhooks     *webhook.Dispatcher
+	dedup        *dedup.Detector
+	rules        *rules.Engine
 	config       *config.Config
+	url          string // Canonical URL of the item, or its link when the canonical URL is taken
 }
 
 // NewArticleProcessor creates a new ArticleProcessor
-func NewArticleProcessor(feed *ent.Feed, item *gofeed.Item, articleRepos article.ArticleRepository, summaryRepos summary.SummaryRepository, config *config.Config) *ArticleProcessor {
+func NewArticleProcessor(feed *ent.Feed, item *gofeed.Item, feedRepos feed.FeedRepository, articleRepos article.ArticleRepository, summaryRepos summary.SummaryRepository, engine *rules.Engine, config *config.Config) *ArticleProcessor {
 	return &ArticleProcessor{
 		feed:         feed,
 		feedItem:     item,
+		feedRepos:    feedRepos,
 		articleRepos: articleRepos,
 		summaryRepos: summaryRepos,
+		exporters:    exporter.New(config),
+		webhooks:     webhook.New(config),
+		dedup:        dedup.NewDetector(articleRepos, config),
+		rules:        engine,
 		config:       config,
 	}
 }
@@ -42,15 +62,53 @@ func (ap *ArticleProcessor) Process(ctx context.Context) error {
 		return errors.Wrap(err, "error checking if article exists")
 	}
 
+	if processed(article) {
+		return nil
+	}
+
+	decision, err := ap.rules.Evaluate(rules.NewItem(ap.feed, ap.feedItem))
+	if err != nil {
+		return errors.Wrap(err, "error evaluating rules")
+	}
+	if decision.Skip {
+		slog.Info("Skip item by rules", "title", ap.feedItem.Title, "link", ap.feedItem.Link, "rules", decision.Matched)
+		return nil
+	}
+
+	var original *ent.Article
+	if article == nil {
+		article, original, err = ap.findDuplicate(ctx)
+		if errors.Is(err, errPruned) {
+			slog.Debug("Skip pruned item", "title", ap.feedItem.Title, "link", ap.feedItem.Link)
+			return nil
+		}
+		if err != nil {
+			return errors.Wrap(err, "error checking duplicates")
+		}
+		if processed(article) {
+			// Saved under its canonical URL by a previous fetch
+			return nil
+		}
+	}
+
 	if article == nil {
 		slog.Info("Processing item", "title", ap.feedItem.Title, "link", ap.feedItem.Link)
+		articleFeed := ap.feed
+		if decision.Bookmark && original == nil {
+			articleFeed, err = ap.feedRepos.EnsureBookmarkFeed(ctx)
+			if err != nil {
+				return err
+			}
+		}
 		newArticle := &ent.Article{
 			Title:       ap.feedItem.Title,
-			URL:         ap.feedItem.Link,
+			URL:         ap.url,
 			Description: ap.feedItem.Description,
 			Content:     ap.feedItem.Content,
 		}
-		newArticle.Edges.Feed = ap.feed
+		setMedia(newArticle, ap.feedItem)
+		newArticle.Edges.Feed = articleFeed
+		newArticle.Edges.DuplicateOf = original
 
 		// Set PublishedAt if available
 		if ap.feedItem.PublishedParsed != nil {
@@ -63,30 +121,147 @@ func (ap *ArticleProcessor) Process(ctx context.Context) error {
 		if err != nil {
 			return errors.Wrap(err, "error saving article")
 		}
-		article.Edges.Feed = ap.feed
-		slog.Debug("Saved article", "link", ap.feedItem.Link, "id", newArticle.ID)
+		article.Edges.Feed = articleFeed
+		slog.Debug("Saved article", "link", ap.url, "id", newArticle.ID)
+	}
+
+	if original != nil {
+		slog.Info("Linked duplicate article", "title", ap.feedItem.Title, "link", ap.feedItem.Link, "original", original.URL)
+		return nil
+	}
+
+	if err := ap.processSummary(ctx, article, decision); err != nil {
+		return errors.Wrap(err, "error processing summary")
 	}
 
-	if article.Edges.Summary == nil {
-		if err := ap.processSummary(ctx, article); err != nil {
-			return errors.Wrap(err, "error processing summary")
+	return nil
+}
+
+// processed reports whether the article of an item was summarized or linked to its
+// original already.
+func processed(a *ent.Article) bool {
+	return a != nil && (a.Edges.Summary != nil || a.Edges.DuplicateOf != nil)
+}
+
+// setMedia copies the author, categories, image and enclosures of the item to the article.
+func setMedia(a *ent.Article, item *gofeed.Item) {
+	var authors []string
+	for _, p := range item.Authors {
+		if p != nil && p.Name != "" {
+			authors = append(authors, p.Name)
 		}
 	}
+	if len(authors) == 0 && item.Author != nil {
+		authors = append(authors, item.Author.Name)
+	}
+	a.Author = strings.Join(authors, ", ")
+	a.Categories = item.Categories
 
+	if item.Image != nil {
+		a.ImageURL = item.Image.URL
+	}
+	for _, enc := range item.Enclosures {
+		if enc == nil || enc.URL == "" {
+			continue
+		}
+		// The length is often missing or invalid in feeds; keep it unknown then.
+		length, _ := strconv.ParseInt(strings.TrimSpace(enc.Length), 10, 64)
+		a.Enclosures = append(a.Enclosures, schema.Enclosure{URL: enc.URL, Type: enc.Type, Length: max(length, 0)})
+		if a.ImageURL == "" && strings.HasPrefix(enc.Type, "image/") {
+			a.ImageURL = enc.URL
+		}
+	}
+}
+
+// errPruned is returned by findDuplicate for an item whose article was pruned.
+var errPruned = errors.New("article was pruned")
+
+// findDuplicate looks for the article of the item under its canonical URL and for the
+// article it duplicates. It returns the stored article when the item was saved before, and
+// the original article when the item is a copy of an article of another feed. The URL the
+// new article is saved under is left in ap.url.
+func (ap *ArticleProcessor) findDuplicate(ctx context.Context) (*ent.Article, *ent.Article, error) {
+	if err := ap.checkPruned(ctx, ap.feedItem.Link); err != nil {
+		return nil, nil, err
+	}
+	ap.url = ap.dedup.CanonicalURL(ctx, ap.feedItem.Link)
+	if ap.url != ap.feedItem.Link {
+		if err := ap.checkPruned(ctx, ap.url); err != nil {
+			return nil, nil, err
+		}
+		found, err := ap.articleRepos.GetFromURL(ctx, ap.url)
+		if err != nil {
+			return nil, nil, err
+		}
+		switch {
+		case found == nil:
+		case ap.ownArticle(found):
+			return found, nil, nil
+		case found.Edges.DuplicateOf != nil:
+			// The canonical URL is taken; keep the link of the item.
+			ap.url = ap.feedItem.Link
+			return nil, found.Edges.DuplicateOf, nil
+		case found.Edges.Summary != nil:
+			ap.url = ap.feedItem.Link
+			return nil, found, nil
+		default:
+			return found, nil, nil
+		}
+	}
+
+	original, err := ap.dedup.FindByTitle(ctx, ap.feed, ap.feedItem.Title)
+	if err != nil {
+		return nil, nil, err
+	}
+	return nil, original, nil
+}
+
+// checkPruned returns errPruned when the article with the URL was pruned.
+func (ap *ArticleProcessor) checkPruned(ctx context.Context, url string) error {
+	pruned, err := ap.articleRepos.IsPruned(ctx, url)
+	if err != nil {
+		return err
+	}
+	if pruned {
+		return errPruned
+	}
 	return nil
 }
 
-// processSummary handles the summarization of an article
-func (ap *ArticleProcessor) processSummary(ctx context.Context, article *ent.Article) error {
+// ownArticle reports whether the article was saved from the feed of the item, or moved to
+// the bookmark feed by a rule, rather than from another feed.
+func (ap *ArticleProcessor) ownArticle(a *ent.Article) bool {
+	f := a.Edges.Feed
+	return f != nil && (f.ID == ap.feed.ID || f.IsBookmark)
+}
+
+// processSummary handles the summarization of an article, applying the decision of the rules
+func (ap *ArticleProcessor) processSummary(ctx context.Context, article *ent.Article, decision *rules.Decision) error {
 	geminiClient, err := gemini.NewClient(ctx, ap.config)
 	if err != nil {
 		return errors.Wrap(err, "error creating gemini client")
 	}
 
+	// Articles are requested with the settings of the feed they come from
+	settings, err := httpclient.New(ap.config, ap.feed.URL)
+	if err != nil {
+		return err
+	}
+
+	// Podcast and video episodes are summarized from their transcript when there is one
+	text, err := transcript.New(ap.config.Transcript, settings).Get(ctx, ap.feedItem, article)
+	if err != nil {
+		slog.Warn("Failed to get transcript, summarizing the page", "link", article.URL, "error", err)
+	}
+
 	url := article.URL
 	var pageSummary *gemini.PageSummary
 	for i := 0; i < 3; i++ {
-		pageSummary, err = geminiClient.Summarize(ctx, url)
+		if text != "" {
+			pageSummary, err = geminiClient.SummarizeTranscript(ctx, url, decision.Prompt, article.Title, text)
+		} else {
+			pageSummary, err = geminiClient.SummarizePage(ctx, url, decision.Prompt, settings)
+		}
 		if err != nil || pageSummary == nil {
 			// retry if error
 			slog.Info("retrying to summarize page", "link", url, "error", err)
@@ -104,8 +279,10 @@ func (ap *ArticleProcessor) processSummary(ctx context.Context, article *ent.Art
 		URL:      url,
 		Title:    pageSummary.Title,
 		Summary:  pageSummary.Summary,
-		Readed:   false,
+		Readed:   decision.MarkRead,
 		Listened: false,
+		Tags:     decision.Tags,
+		Priority: decision.Priority,
 	}
 	sum.Edges.Article = article
 	sum.Edges.Feed = article.Edges.Feed
@@ -135,13 +312,23 @@ func (ap *ArticleProcessor) processSummary(ctx context.Context, article *ent.Art
 				if err := ap.summaryRepos.UpdateAudioFile(ctx, created.ID, *filename); err != nil {
 					return err
 				}
+				created.AudioFile = *filename
 			}
 		}
 	}
 
-	if err := org.ExportOrg(ap.config, created); err != nil {
+	if err := ap.exporters.Export(created); err != nil {
 		return err
 	}
 
+	event := webhook.EventCreated
+	if created.Edges.Feed != nil && created.Edges.Feed.IsBookmark {
+		event = webhook.EventBookmarked
+	}
+	if err := ap.webhooks.Summary(ctx, event, created); err != nil {
+		// The summary is saved; a failed notification must not make it look unprocessed.
+		slog.Error("failed to notify webhooks", slog.Any("summary_id", created.ID), slog.Any("error", err))
+	}
+
 	return nil
 }
@@ -0,0 +1,148 @@
+package fetch
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"github.com/google/uuid"
+	"github.com/mmcdole/gofeed"
+	"github.com/mopemope/quicknews/config"
+	"github.com/mopemope/quicknews/ent"
+	"github.com/mopemope/quicknews/ent/enttest"
+	"github.com/mopemope/quicknews/ent/schema"
+	"github.com/mopemope/quicknews/models/article"
+	"github.com/mopemope/quicknews/models/feed"
+	"github.com/mopemope/quicknews/models/summary"
+	"github.com/mopemope/quicknews/rules"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetMedia(t *testing.T) {
+	item := &gofeed.Item{
+		Authors:    []*gofeed.Person{{Name: "Alice"}, {Name: "Bob"}},
+		Categories: []string{"news", "audio"},
+		Enclosures: []*gofeed.Enclosure{
+			{URL: "https://example.com/cover.png", Type: "image/png"},
+			{URL: "https://example.com/episode.mp3", Type: "audio/mpeg", Length: "12345"},
+			{URL: "https://example.com/video.mp4", Type: "video/mp4", Length: "unknown"},
+		},
+	}
+	a := &ent.Article{}
+	setMedia(a, item)
+
+	assert.Equal(t, "Alice, Bob", a.Author)
+	assert.Equal(t, []string{"news", "audio"}, a.Categories)
+	assert.Equal(t, "https://example.com/cover.png", a.ImageURL, "falls back to an image enclosure")
+	assert.Equal(t, []schema.Enclosure{
+		{URL: "https://example.com/cover.png", Type: "image/png"},
+		{URL: "https://example.com/episode.mp3", Type: "audio/mpeg", Length: 12345},
+		{URL: "https://example.com/video.mp4", Type: "video/mp4"},
+	}, a.Enclosures)
+
+	a = &ent.Article{}
+	setMedia(a, &gofeed.Item{
+		Author: &gofeed.Person{Name: "Carol"},
+		Image:  &gofeed.Image{URL: "https://example.com/thumb.jpg"},
+	})
+	assert.Equal(t, "Carol", a.Author)
+	assert.Equal(t, "https://example.com/thumb.jpg", a.ImageURL)
+	assert.Empty(t, a.Enclosures)
+}
+
+func TestArticleProcessor_ProcessTwice(t *testing.T) {
+	// Summarizing fails without a key, so the item must not be summarized again
+	t.Setenv("GEMINI_API_KEY", "")
+	client := enttest.Open(t, dialect.SQLite, "file:process_twice?mode=memory&cache=shared&_fk=1")
+	defer func() { _ = client.Close() }()
+	ctx := context.Background()
+
+	newFeed := func(url string) *ent.Feed {
+		f, err := client.Feed.Create().
+			SetURL(url).
+			SetTitle(url).
+			SetUpdatedAt(time.Now()).
+			Save(ctx)
+		require.NoError(t, err)
+		return f
+	}
+	own, other := newFeed("https://example.com/feed"), newFeed("https://aggregator.example.com/feed")
+
+	// The first fetch saved the item under its canonical URL and summarized it
+	a, err := client.Article.Create().
+		SetTitle("Post").
+		SetURL("https://example.com/post").
+		SetFeed(own).
+		Save(ctx)
+	require.NoError(t, err)
+	_, err = client.Summary.Create().
+		SetURL(a.URL).
+		SetTitle("Post").
+		SetSummary("summary").
+		SetArticle(a).
+		SetFeed(own).
+		Save(ctx)
+	require.NoError(t, err)
+
+	cfg := &config.Config{Dedup: &config.Dedup{TitleThreshold: 0.85, WindowHours: 48}}
+	item := &gofeed.Item{Title: "Post", Link: "https://example.com/post?utm_source=rss#comments"}
+	process := func(f *ent.Feed) {
+		ap := NewArticleProcessor(f, item, feed.NewRepository(client), article.NewRepository(client),
+			summary.NewRepository(client), rules.New(nil), cfg)
+		require.NoError(t, ap.Process(ctx))
+	}
+
+	process(own)
+	process(own)
+	count, err := client.Article.Query().Count(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count, "the item is not linked to its own article")
+
+	// The same item from another feed is linked once
+	process(other)
+	process(other)
+	count, err = client.Article.Query().Count(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+	dup, err := article.NewRepository(client).GetFromURL(ctx, item.Link)
+	require.NoError(t, err)
+	require.NotNil(t, dup)
+	require.NotNil(t, dup.Edges.DuplicateOf)
+	assert.Equal(t, a.ID, dup.Edges.DuplicateOf.ID)
+}
+
+func TestArticleProcessor_Pruned(t *testing.T) {
+	// Summarizing fails without a key, so a pruned item must not be summarized again
+	t.Setenv("GEMINI_API_KEY", "")
+	client := enttest.Open(t, dialect.SQLite, "file:process_pruned?mode=memory&cache=shared&_fk=1")
+	defer func() { _ = client.Close() }()
+	ctx := context.Background()
+
+	f, err := client.Feed.Create().
+		SetURL("https://example.com/feed").
+		SetTitle("Example").
+		SetUpdatedAt(time.Now()).
+		Save(ctx)
+	require.NoError(t, err)
+	a, err := client.Article.Create().
+		SetTitle("Post").
+		SetURL("https://example.com/post").
+		SetFeed(f).
+		Save(ctx)
+	require.NoError(t, err)
+	articleRepos := article.NewRepository(client)
+	require.NoError(t, articleRepos.DeleteAll(ctx, []uuid.UUID{a.ID}))
+
+	// The item is undated, so the retention policy of the fetch keeps it
+	for _, link := range []string{"https://example.com/post", "https://example.com/post?utm_source=rss"} {
+		item := &gofeed.Item{Title: "Post", Link: link}
+		ap := NewArticleProcessor(f, item, feed.NewRepository(client), articleRepos,
+			summary.NewRepository(client), rules.New(nil), &config.Config{})
+		require.NoError(t, ap.Process(ctx))
+	}
+	count, err := client.Article.Query().Count(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, count)
+}
@@ -2,17 +2,26 @@ package fetch
 
 import (
 	"context"
+	"log/slog"
+	"net/http"
+	"slices"
 	"sync"
+	"time"
 
 	pond "github.com/alitto/pond/v2"
 	"github.com/cockroachdb/errors"
 	"github.com/mmcdole/gofeed"
+	"github.com/mopemope/quicknews/clock"
 	"github.com/mopemope/quicknews/config"
 	"github.com/mopemope/quicknews/ent"
+	"github.com/mopemope/quicknews/httpclient"
 	"github.com/mopemope/quicknews/models/article"
 	"github.com/mopemope/quicknews/models/feed"
 	"github.com/mopemope/quicknews/models/summary"
+	"github.com/mopemope/quicknews/rules"
+	"github.com/mopemope/quicknews/schedule"
 	"github.com/mopemope/quicknews/tui/progress"
+	"github.com/mopemope/quicknews/websub"
 )
 
 // FeedProcessor handles the processing of feeds
@@ -20,6 +29,7 @@ type FeedProcessor struct {
 	feedRepos    feed.FeedRepository
 	articleRepos article.ArticleRepository
 	summaryRepos summary.SummaryRepository
+	rules        *rules.Engine
 	config       *config.Config
 }
 
@@ -29,14 +39,28 @@ func NewFeedProcessor(feedRepos feed.FeedRepository, articleRepos article.Articl
 		feedRepos:    feedRepos,
 		articleRepos: articleRepos,
 		summaryRepos: summaryRepos,
+		rules:        rules.New(config.Rules),
 		config:       config,
 	}
 }
 
 // GetItems retrieves all items that need to be processed from all feeds
 func (fp *FeedProcessor) GetItems(ctx context.Context) ([]progress.QueueItem, error) {
+	return fp.getItems(ctx, false)
+}
+
+// GetDueItems retrieves the items to process from the feeds that are due to be polled
+func (fp *FeedProcessor) GetDueItems(ctx context.Context) ([]progress.QueueItem, error) {
+	return fp.getItems(ctx, true)
+}
+
+func (fp *FeedProcessor) getItems(ctx context.Context, dueOnly bool) ([]progress.QueueItem, error) {
 	items := make([]progress.QueueItem, 0)
 
+	if err := fp.rules.Err(); err != nil {
+		return nil, errors.Wrap(err, "invalid rules")
+	}
+
 	feeds, err := fp.feedRepos.All(ctx)
 	if err != nil {
 		return nil, err
@@ -51,15 +75,20 @@ func (fp *FeedProcessor) GetItems(ctx context.Context) ([]progress.QueueItem, er
 	// Use a worker pool to limit concurrency
 	pool := pond.NewPool(5)
 
+	now := clock.Now()
 	for _, feed := range feeds {
-		if feed.IsBookmark {
-			// skip bookmark feeds
+		if feed.IsBookmark || feed.Disabled {
+			// skip bookmark and disabled feeds
+			continue
+		}
+		if dueOnly && !schedule.Due(feed, now) {
 			continue
 		}
 		feedData := feed // capture the current feed
 		pool.Submit(func() {
 			res, err := fp.processFeed(ctx, feedData)
 			if err != nil {
+				slog.Error("Failed to process feed", "title", feedData.Title, "url", feedData.URL, "error", err)
 				return
 			}
 			itemsMutex.Lock()
@@ -72,13 +101,35 @@ func (fp *FeedProcessor) GetItems(ctx context.Context) ([]progress.QueueItem, er
 	return items, nil
 }
 
+// NextPoll returns the earliest time a feed is due to be polled, or the zero time when
+// there is no feed to poll.
+func (fp *FeedProcessor) NextPoll(ctx context.Context) (time.Time, error) {
+	feeds, err := fp.feedRepos.All(ctx)
+	if err != nil {
+		return time.Time{}, err
+	}
+	var next time.Time
+	for _, feed := range feeds {
+		if feed.IsBookmark || feed.Disabled {
+			continue
+		}
+		at := clock.Now()
+		if feed.NextPollAt != nil {
+			at = *feed.NextPollAt
+		}
+		if next.IsZero() || at.Before(next) {
+			next = at
+		}
+	}
+	return next, nil
+}
+
 // processFeed handles fetching and processing a single feed
 func (fp *FeedProcessor) processFeed(ctx context.Context, feed *ent.Feed) ([]progress.QueueItem, error) {
-	items := make([]progress.QueueItem, 0)
-
-	parser := gofeed.NewParser()
-
-	parsedFeed, err := parser.ParseURLWithContext(feed.URL, ctx)
+	parsedFeed, status, header, err := fp.fetchFeed(ctx, feed)
+	if err := fp.recordFetch(ctx, feed, parsedFeed, status, header, err); err != nil {
+		return nil, err
+	}
 	if err != nil {
 		return nil, errors.Wrap(err, "fetch error")
 	}
@@ -87,16 +138,138 @@ func (fp *FeedProcessor) processFeed(ctx context.Context, feed *ent.Feed) ([]pro
 	if err != nil {
 		return nil, errors.Wrap(err, "error updating feed")
 	}
-	feed = updatedFeed
+	return fp.feedItems(updatedFeed, parsedFeed), nil
+}
+
+// PushedItems returns the items to process from the content pushed by the WebSub hub of
+// the feed. Pushed content may only hold the new items, so the feed itself is not updated.
+func (fp *FeedProcessor) PushedItems(ctx context.Context, feed *ent.Feed, parsedFeed *gofeed.Feed) ([]progress.QueueItem, error) {
+	if err := fp.rules.Err(); err != nil {
+		return nil, errors.Wrap(err, "invalid rules")
+	}
+	return fp.feedItems(feed, parsedFeed), nil
+}
 
-	for _, item := range parsedFeed.Items {
-		articleProcessor := NewArticleProcessor(feed, item, fp.articleRepos, fp.summaryRepos, fp.config)
+func (fp *FeedProcessor) feedItems(feed *ent.Feed, parsedFeed *gofeed.Feed) []progress.QueueItem {
+	items := make([]progress.QueueItem, 0, len(parsedFeed.Items))
+	for _, item := range retained(parsedFeed.Items, fp.config.Retention, clock.Now()) {
+		articleProcessor := NewArticleProcessor(feed, item, fp.feedRepos, fp.articleRepos, fp.summaryRepos, fp.rules, fp.config)
 		items = append(items, &QueueItemWrapper{processor: articleProcessor, name: item.Title})
 	}
+	return items
+}
 
-	return items, nil
+// retained returns the items kept by an automatic retention policy, so that a feed seen for
+// the first time does not bring in items the next automatic prune would delete: items
+// published more than max_age_days ago are dropped, and only the newest max_per_feed items
+// are kept. A policy for the prune command alone, or one keeping the unread articles, which
+// new items are, keeps every item.
+func retained(items []*gofeed.Item, policy *config.Retention, now time.Time) []*gofeed.Item {
+	if policy == nil || !policy.Auto || policy.KeepUnread {
+		return items
+	}
+	if policy.MaxAgeDays > 0 {
+		before := now.AddDate(0, 0, -policy.MaxAgeDays)
+		items = slices.DeleteFunc(slices.Clone(items), func(item *gofeed.Item) bool {
+			published := publishedAt(item)
+			return published != nil && published.Before(before)
+		})
+	}
+	if policy.MaxPerFeed > 0 && len(items) > policy.MaxPerFeed {
+		items = slices.Clone(items)
+		// Newest first, the items without a date last
+		slices.SortStableFunc(items, func(a, b *gofeed.Item) int {
+			pa, pb := publishedAt(a), publishedAt(b)
+			switch {
+			case pa == nil && pb == nil:
+				return 0
+			case pa == nil:
+				return 1
+			case pb == nil:
+				return -1
+			}
+			return pb.Compare(*pa)
+		})
+		items = items[:policy.MaxPerFeed]
+	}
+	return items
+}
+
+func publishedAt(item *gofeed.Item) *time.Time {
+	if item.PublishedParsed != nil {
+		return item.PublishedParsed
+	}
+	return item.UpdatedParsed
+}
+
+// fetchFeed downloads and parses the feed. It also returns the status and headers of the
+// response, 0 and nil when no response was received.
+func (fp *FeedProcessor) fetchFeed(ctx context.Context, feed *ent.Feed) (*gofeed.Feed, int, http.Header, error) {
+	settings, err := httpclient.New(fp.config, feed.URL)
+	if err != nil {
+		return nil, 0, nil, err
+	}
+	parser := schedule.NewParser()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, feed.URL, nil)
+	if err != nil {
+		return nil, 0, nil, errors.Wrap(err, "failed to create request")
+	}
+	req.Header.Set("User-Agent", parser.UserAgent) // Replaced by the configured one
+
+	resp, err := settings.Client(time.Minute).Do(req)
+	if err != nil {
+		return nil, 0, nil, err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, resp.StatusCode, resp.Header, gofeed.HTTPError{StatusCode: resp.StatusCode, Status: resp.Status}
+	}
+	parsedFeed, err := parser.Parse(resp.Body)
+	return parsedFeed, resp.StatusCode, resp.Header, err
+}
+
+// recordFetch stores the outcome of fetching the feed in its health, disabling it after
+// too many failures in a row, records the WebSub hub it advertises and schedules its
+// next poll.
+func (fp *FeedProcessor) recordFetch(ctx context.Context, feed *ent.Feed, parsedFeed *gofeed.Feed, status int, header http.Header, fetchErr error) error {
+	updated, err := fp.feedRepos.RecordFetch(ctx, feed.ID, status, fetchErr, fp.config.FeedMaxFailures)
+	if err != nil {
+		return errors.Wrap(err, "error recording feed health")
+	}
+	if updated.Disabled && !feed.Disabled {
+		slog.Warn("Disabled feed after repeated failures", "title", feed.Title, "url", feed.URL, "failures", updated.ConsecutiveFailures)
+	}
+
+	if fetchErr == nil {
+		hub, topic := websub.Discover(parsedFeed, header)
+		if hub != updated.HubURL || topic != updated.TopicURL {
+			if err := fp.feedRepos.SetHub(ctx, feed.ID, hub, topic); err != nil {
+				return err
+			}
+			slog.Debug("Recorded WebSub hub", "title", feed.Title, "hub", hub, "topic", topic)
+		}
+	}
+
+	now := clock.Now()
+	hints := schedule.NewHints(parsedFeed, header, updated.ConsecutiveFailures, now)
+	policy := schedule.NewPolicy(fp.config.Polling, updated)
+	next := policy.Next(hints, now)
+	if fp.config.WebSub != nil && websub.Subscribed(updated, now) {
+		// The hub pushes new items; polls only catch what it missed.
+		next = now.Add(policy.Max)
+	}
+	if err := fp.feedRepos.SetNextPoll(ctx, feed.ID, next); err != nil {
+		return err
+	}
+	slog.Debug("Scheduled next poll", "title", feed.Title, "next", next)
+	return nil
 }
 
+// inFlight holds the links of the items being processed.
+var inFlight sync.Map
+
 // QueueItemWrapper wraps the ArticleProcessor to implement the progress.QueueItem interface
 type QueueItemWrapper struct {
 	processor *ArticleProcessor
@@ -112,9 +285,17 @@ func (q *QueueItemWrapper) URL() string {
 }
 
 func (q *QueueItemWrapper) Process() {
+	// A pushed item may arrive while a poll processes it
+	if _, busy := inFlight.LoadOrStore(q.URL(), struct{}{}); busy {
+		slog.Debug("Skip item being processed", "title", q.name, "link", q.URL())
+		return
+	}
+	defer inFlight.Delete(q.URL())
+
 	ctx := context.Background()
 	// Process might need to return errors differently, but for now let's just log them
 	if err := q.processor.Process(ctx); err != nil {
 		// Log the error, as the UI layer might not handle errors from this method directly
+		slog.Error("Failed to process article", "title", q.name, "link", q.URL(), "error", err)
 	}
 }
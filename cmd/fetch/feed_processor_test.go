@@ -0,0 +1,55 @@
+package fetch
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mmcdole/gofeed"
+	"github.com/mopemope/quicknews/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetained(t *testing.T) {
+	now := time.Date(2025, 6, 30, 12, 0, 0, 0, time.UTC)
+	at := func(days int) *time.Time {
+		t := now.AddDate(0, 0, -days)
+		return &t
+	}
+	items := []*gofeed.Item{
+		{Title: "undated"},
+		{Title: "old", PublishedParsed: at(60)},
+		{Title: "week", PublishedParsed: at(7)},
+		{Title: "updated", UpdatedParsed: at(2)},
+		{Title: "today", PublishedParsed: at(0)},
+	}
+	titles := func(items []*gofeed.Item) []string {
+		var titles []string
+		for _, item := range items {
+			titles = append(titles, item.Title)
+		}
+		return titles
+	}
+
+	assert.Equal(t, titles(items), titles(retained(items, nil, now)))
+	assert.Equal(t, titles(items), titles(retained(items, &config.Retention{MaxAgeDays: 30}, now)),
+		"a policy for the prune command keeps every item")
+	assert.Equal(t, titles(items), titles(retained(items, &config.Retention{MaxAgeDays: 30, Auto: true, KeepUnread: true}, now)),
+		"new items are unread")
+	assert.Equal(t, []string{"undated", "week", "updated", "today"},
+		titles(retained(items, &config.Retention{MaxAgeDays: 30, Auto: true}, now)))
+	assert.Equal(t, []string{"today", "updated"},
+		titles(retained(items, &config.Retention{MaxPerFeed: 2, Auto: true}, now)))
+	assert.Equal(t, []string{"undated", "week", "updated", "today"},
+		titles(retained(items, &config.Retention{MaxAgeDays: 30, MaxPerFeed: 10, Auto: true}, now)))
+	assert.Equal(t, "undated", items[0].Title, "the items of the feed are not reordered")
+}
+
+func TestQueueItemWrapper_InFlight(t *testing.T) {
+	item := &gofeed.Item{Title: "Post", Link: "https://example.com/in-flight"}
+	// The processor has no repositories, so processing the item would panic
+	q := &QueueItemWrapper{processor: &ArticleProcessor{feedItem: item}, name: item.Title}
+
+	inFlight.Store(item.Link, struct{}{})
+	defer inFlight.Delete(item.Link)
+	assert.NotPanics(t, q.Process, "an item being processed is skipped")
+}
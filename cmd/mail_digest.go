@@ -0,0 +1,114 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/google/uuid"
+	"github.com/mopemope/quicknews/config"
+	"github.com/mopemope/quicknews/ent"
+	"github.com/mopemope/quicknews/mailer"
+	"github.com/mopemope/quicknews/models/summary"
+)
+
+type MailDigestCmd struct {
+	Hours       int  `default:"24" help:"Include unread summaries created in the last N hours."`
+	AttachAudio bool `help:"Attach the audio files of the summaries, overriding mail.attach_audio."`
+	DryRun      bool `help:"Print the message instead of sending it."`
+}
+
+func (c *MailDigestCmd) Run(client *ent.Client, config *config.Config) error {
+	if config.Mail == nil {
+		return errors.New("mail is not configured")
+	}
+	mailConfig := config.Mail
+
+	ctx := context.Background()
+	repo := summary.NewRepository(client)
+	now := time.Now()
+	sums, err := repo.GetUnmailed(ctx, now.Add(-time.Duration(c.Hours)*time.Hour))
+	if err != nil {
+		return err
+	}
+	if len(sums) == 0 {
+		fmt.Println("No new summaries to mail.")
+		return nil
+	}
+
+	subject := strings.NewReplacer(
+		"{date}", now.Format("2006-01-02"),
+		"{count}", strconv.Itoa(len(sums)),
+	).Replace(mailConfig.Subject)
+	digest := mailer.NewDigest(subject, now, sums, mailConfig.AudioBaseURL)
+	text, html, err := digest.Render()
+	if err != nil {
+		return err
+	}
+	msg := &mailer.Message{
+		From:    mailConfig.From,
+		To:      mailConfig.To,
+		Subject: subject,
+		Date:    now,
+		Text:    text,
+		HTML:    html,
+	}
+	if c.AttachAudio || mailConfig.AttachAudio {
+		msg.Attachments = audioAttachments(config, sums)
+	}
+
+	if c.DryRun {
+		data, err := msg.Bytes()
+		if err != nil {
+			return err
+		}
+		if _, err := os.Stdout.Write(data); err != nil {
+			return errors.Wrap(err, "failed to write message")
+		}
+		return nil
+	}
+
+	if err := mailer.NewSender(mailConfig).Send(msg); err != nil {
+		return err
+	}
+	ids := make([]uuid.UUID, len(sums))
+	for i, sum := range sums {
+		ids[i] = sum.ID
+	}
+	if err := repo.UpdateMailed(ctx, ids, now); err != nil {
+		return err
+	}
+	fmt.Printf("Mailed %d summaries to %s\n", len(sums), strings.Join(mailConfig.To, ", "))
+	return nil
+}
+
+// audioAttachments reads the stored audio files of the summaries.
+func audioAttachments(config *config.Config, sums []*ent.Summary) []*mailer.Attachment {
+	if config.AudioPath == nil {
+		return nil
+	}
+	var attachments []*mailer.Attachment
+	for _, sum := range sums {
+		if sum.AudioFile == "" {
+			continue
+		}
+		path := filepath.Join(*config.AudioPath, sum.AudioFile)
+		data, err := os.ReadFile(path)
+		if err != nil {
+			slog.Warn("Skip missing audio file", "path", path, "error", err)
+			continue
+		}
+		attachments = append(attachments, &mailer.Attachment{
+			Name:        sum.AudioFile,
+			ContentType: "audio/mpeg",
+			Data:        data,
+		})
+	}
+	return attachments
+}
@@ -0,0 +1,73 @@
+package cmd
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"github.com/mopemope/quicknews/config"
+	"github.com/mopemope/quicknews/ent/enttest"
+	"github.com/mopemope/quicknews/mailer/mailtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMailDigestCmd(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, dialect.SQLite, "file:mail_digest?mode=memory&cache=shared&_fk=1")
+	defer func() { _ = client.Close() }()
+
+	f, err := client.Feed.Create().
+		SetURL("https://example.com/feed").
+		SetTitle("Example").
+		SetDescription("Example feed").
+		SetLink("https://example.com").
+		SetUpdatedAt(time.Now()).
+		Save(ctx)
+	require.NoError(t, err)
+
+	for _, s := range []struct {
+		title  string
+		readed bool
+	}{{"Unread", false}, {"Read", true}} {
+		_, err := client.Summary.Create().
+			SetURL("https://example.com/" + s.title).
+			SetTitle(s.title).
+			SetSummary(s.title + " summary").
+			SetReaded(s.readed).
+			SetFeed(f).
+			Save(ctx)
+		require.NoError(t, err)
+	}
+
+	server := mailtest.NewServer(t)
+	cfg := &config.Config{Mail: &config.Mail{
+		Host:    server.Host,
+		Port:    server.Port,
+		From:    "quicknews@example.com",
+		To:      []string{"reader@example.com"},
+		Subject: "quicknews {date} ({count})",
+	}}
+
+	cmd := &MailDigestCmd{Hours: 24}
+	require.NoError(t, cmd.Run(client, cfg))
+
+	messages := server.Messages()
+	require.Len(t, messages, 1)
+	assert.Equal(t, []string{"reader@example.com"}, messages[0].To)
+	assert.Contains(t, messages[0].Data, "(1)")
+	assert.Contains(t, messages[0].Data, "Unread summary")
+	assert.False(t, strings.Contains(messages[0].Data, "Read summary"))
+
+	sums, err := client.Summary.Query().All(ctx)
+	require.NoError(t, err)
+	for _, sum := range sums {
+		assert.Equal(t, sum.Title == "Unread", sum.MailedAt != nil, sum.Title)
+	}
+
+	// Mailed summaries are not sent again.
+	require.NoError(t, cmd.Run(client, cfg))
+	assert.Len(t, server.Messages(), 1)
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/cockroachdb/errors"
 	"github.com/mopemope/quicknews/config"
 	"github.com/mopemope/quicknews/ent"
+	"github.com/mopemope/quicknews/exporter"
 	"github.com/mopemope/quicknews/models/summary"
 	"github.com/mopemope/quicknews/tts"
 
@@ -74,13 +75,12 @@ func (a *PlayCmd) Run(client *ent.Client, config *config.Config) error {
 	if !a.NoFetch {
 		go func() {
 			for {
-				fetchArticles(client, config)
-				time.Sleep(time.Hour)
+				time.Sleep(fetchArticles(client, config))
 			}
 		}()
 	}
 
-	repo := summary.NewRepository(client)
+	repo := exporter.WithExport(summary.NewRepository(client), exporter.New(config))
 
 	res, err := repo.GetUnlistened(ctx, a.Date)
 	if err != nil {
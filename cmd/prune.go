@@ -0,0 +1,121 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/google/uuid"
+	"github.com/mopemope/quicknews/clock"
+	"github.com/mopemope/quicknews/config"
+	"github.com/mopemope/quicknews/database"
+	"github.com/mopemope/quicknews/ent"
+	"github.com/mopemope/quicknews/models/article"
+)
+
+// PruneCmd deletes old articles with their summaries and audio files.
+type PruneCmd struct {
+	MaxAgeDays *int `help:"Prune articles added more than this many days ago. Overrides retention.max_age_days."`
+	MaxPerFeed *int `help:"Keep only the newest articles of each feed. Overrides retention.max_per_feed."`
+	KeepUnread bool `help:"Keep the articles with an unread summary."`
+	DryRun     bool `short:"n" help:"Show what would be pruned without deleting anything."`
+	NoVacuum   bool `help:"Do not vacuum the database afterwards."`
+}
+
+func (c *PruneCmd) Run(client *ent.Client, config *config.Config) error {
+	policy := c.policy(config)
+	if policy.MaxAgeDays <= 0 && policy.MaxPerFeed <= 0 {
+		return errors.New("no retention policy. Please set [retention] in config or use --max-age-days or --max-per-feed")
+	}
+
+	ctx := context.Background()
+	result, err := prune(ctx, client, config, policy, c.DryRun)
+	if err != nil {
+		return err
+	}
+	if c.DryRun {
+		for _, a := range result.Pruned {
+			fmt.Printf("%s  %s\n", a.CreatedAt.Local().Format(time.DateOnly), a.Title)
+		}
+		fmt.Printf("Would prune %d articles, %d summaries and %d audio files.\n", len(result.Pruned), result.Summaries, len(result.AudioFiles))
+		return nil
+	}
+	fmt.Printf("Pruned %d articles, %d summaries and %d audio files.\n", len(result.Pruned), result.Summaries, len(result.AudioFiles))
+
+	if c.NoVacuum || len(result.Pruned) == 0 {
+		return nil
+	}
+	return database.Vacuum(ctx, config.DB)
+}
+
+// policy returns the retention policy of the config overridden by the flags.
+func (c *PruneCmd) policy(cfg *config.Config) *config.Retention {
+	var policy config.Retention
+	if cfg.Retention != nil {
+		policy = *cfg.Retention
+	}
+	if c.MaxAgeDays != nil {
+		policy.MaxAgeDays = *c.MaxAgeDays
+	}
+	if c.MaxPerFeed != nil {
+		policy.MaxPerFeed = *c.MaxPerFeed
+	}
+	policy.KeepUnread = policy.KeepUnread || c.KeepUnread
+	return &policy
+}
+
+// pruneResult holds what prune deleted, or would delete on a dry run.
+type pruneResult struct {
+	Pruned     ent.Articles
+	Summaries  int
+	AudioFiles []string
+}
+
+// prune deletes the articles selected by the retention policy with their summaries, then
+// removes the audio files of the summaries. Articles of the bookmark feed are kept.
+func prune(ctx context.Context, client *ent.Client, config *config.Config, policy *config.Retention, dryRun bool) (*pruneResult, error) {
+	articleRepos := article.NewRepository(client)
+
+	opts := article.PruneOptions{
+		MaxPerFeed: policy.MaxPerFeed,
+		KeepUnread: policy.KeepUnread,
+	}
+	if policy.MaxAgeDays > 0 {
+		opts.Before = clock.Now().AddDate(0, 0, -policy.MaxAgeDays)
+	}
+	articles, err := articleRepos.GetPrunable(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &pruneResult{Pruned: articles}
+	ids := make([]uuid.UUID, 0, len(articles))
+	for _, a := range articles {
+		ids = append(ids, a.ID)
+		sum := a.Edges.Summary
+		if sum == nil {
+			continue
+		}
+		result.Summaries++
+		if sum.AudioFile != "" && config.AudioPath != nil {
+			result.AudioFiles = append(result.AudioFiles, filepath.Join(*config.AudioPath, sum.AudioFile))
+		}
+	}
+	if dryRun || len(ids) == 0 {
+		return result, nil
+	}
+
+	if err := articleRepos.DeleteAll(ctx, ids); err != nil {
+		return nil, err
+	}
+	for _, file := range result.AudioFiles {
+		if err := os.Remove(file); err != nil && !os.IsNotExist(err) {
+			slog.Warn("Failed to remove audio file", "path", file, "error", err)
+		}
+	}
+	return result, nil
+}
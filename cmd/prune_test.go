@@ -0,0 +1,113 @@
+package cmd
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"github.com/mopemope/quicknews/config"
+	"github.com/mopemope/quicknews/ent"
+	"github.com/mopemope/quicknews/ent/article"
+	"github.com/mopemope/quicknews/ent/enttest"
+	"github.com/mopemope/quicknews/ent/tombstone"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPruneCmd(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	db := filepath.Join(dir, "quicknews.db")
+	client := enttest.Open(t, dialect.SQLite, "file:"+db+"?cache=shared&_fk=1")
+	defer func() { _ = client.Close() }()
+
+	audioPath := filepath.Join(dir, "audio")
+	require.NoError(t, os.Mkdir(audioPath, 0o755))
+	cfg := &config.Config{DB: db, AudioPath: &audioPath}
+
+	newFeed := func(url string, bookmark bool) *ent.Feed {
+		f, err := client.Feed.Create().
+			SetURL(url).
+			SetTitle(url).
+			SetLink(url).
+			SetUpdatedAt(time.Now()).
+			SetIsBookmark(bookmark).
+			Save(ctx)
+		require.NoError(t, err)
+		return f
+	}
+	newArticle := func(f *ent.Feed, title string, age time.Duration, readed *bool) *ent.Article {
+		created := time.Now().Add(-age)
+		a, err := client.Article.Create().
+			SetTitle(title).
+			SetURL("https://example.com/" + title).
+			SetPublishedAt(created).
+			SetCreatedAt(created).
+			SetFeed(f).
+			Save(ctx)
+		require.NoError(t, err)
+		if readed != nil {
+			_, err := client.Summary.Create().
+				SetURL(a.URL).
+				SetTitle(title).
+				SetReaded(*readed).
+				SetAudioFile(title + ".mp3").
+				SetArticle(a).
+				SetFeed(f).
+				Save(ctx)
+			require.NoError(t, err)
+			require.NoError(t, os.WriteFile(filepath.Join(audioPath, title+".mp3"), []byte("audio"), 0o644))
+		}
+		return a
+	}
+	read, unread := true, false
+	month := 40 * 24 * time.Hour
+
+	f := newFeed("https://example.com/feed", false)
+	bookmarks := newFeed("https://quicknews.org/bookmark/rss", true)
+	oldRead := newArticle(f, "old-read", month, &read)
+	newArticle(f, "old-unread", month, &unread)
+	newArticle(f, "new", time.Hour, &read)
+	newArticle(bookmarks, "bookmarked", month, &read)
+	_, err := client.Article.Create().
+		SetTitle("duplicate").
+		SetURL("https://example.org/duplicate").
+		SetFeed(f).
+		SetDuplicateOf(oldRead).
+		Save(ctx)
+	require.NoError(t, err)
+
+	remaining := func() []string {
+		titles, err := client.Article.Query().Order(ent.Asc(article.FieldTitle)).Select(article.FieldTitle).Strings(ctx)
+		require.NoError(t, err)
+		return titles
+	}
+	all := []string{"bookmarked", "duplicate", "new", "old-read", "old-unread"}
+
+	assert.Error(t, (&PruneCmd{}).Run(client, cfg), "a policy is required")
+
+	days := 30
+	require.NoError(t, (&PruneCmd{MaxAgeDays: &days, KeepUnread: true, DryRun: true}).Run(client, cfg))
+	assert.Equal(t, all, remaining(), "a dry run deletes nothing")
+
+	require.NoError(t, (&PruneCmd{MaxAgeDays: &days, KeepUnread: true}).Run(client, cfg))
+	assert.Equal(t, []string{"bookmarked", "new", "old-unread"}, remaining(), "duplicates go with their original")
+	assert.NoFileExists(t, filepath.Join(audioPath, "old-read.mp3"))
+	assert.FileExists(t, filepath.Join(audioPath, "old-unread.mp3"))
+	assert.FileExists(t, filepath.Join(audioPath, "bookmarked.mp3"))
+	count, err := client.Summary.Query().Count(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+	pruned, err := client.Tombstone.Query().Order(ent.Asc(tombstone.FieldURL)).Select(tombstone.FieldURL).Strings(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"https://example.com/old-read", "https://example.org/duplicate"}, pruned, "pruned articles are not fetched again")
+
+	// The policy of the config applies when no flags are given
+	cfg.Retention = &config.Retention{MaxPerFeed: 1}
+	require.NoError(t, (&PruneCmd{}).Run(client, cfg))
+	assert.Equal(t, []string{"bookmarked", "new"}, remaining())
+	assert.NoFileExists(t, filepath.Join(audioPath, "old-unread.mp3"))
+}
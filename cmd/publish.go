@@ -1,11 +1,17 @@
 package cmd
 
 import (
+	"cmp"
 	"context"
 	"fmt"
+	"html"
 	"log/slog"
 	"os"
+	"path"
 	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/cockroachdb/errors"
@@ -18,12 +24,17 @@ import (
 	"github.com/mopemope/quicknews/rss"
 	"github.com/mopemope/quicknews/storage"
 	"github.com/mopemope/quicknews/tts"
+	"github.com/mopemope/quicknews/webhook"
 )
 
 type PublishCmd struct {
-	Date  string `arg:"" optional:"" name:"date" help:"Date to publish the articles in YYYY-MM-DD format. Defaults to today."`
-	Range int    `short:"r" long:"range" help:"Range of days to publish articles. Defaults to 1 day." default:"1"`
-	// Output string `short:"o" help:"Output file path for the joined audio."`
+	Date     string `arg:"" optional:"" name:"date" help:"Date to publish the articles in YYYY-MM-DD format. Defaults to today."`
+	Range    int    `short:"r" long:"range" help:"Range of days to publish articles. Defaults to 1 day." default:"1"`
+	Combined bool   `help:"Publish a single daily episode across all feeds instead of one per feed."`
+	DryRun   bool   `help:"Print the publish plan (summaries, files, sizes and RSS changes) without merging or uploading anything."`
+	Out      string `short:"o" type:"path" help:"Write the merged MP3 files and rss.xml into this directory instead of uploading them."`
+	NoPrune  bool   `help:"Keep episode files that are no longer referenced by the RSS feed."`
+	Prune    bool   `help:"Also delete the MP3 files of the --out directory that the RSS feed does not reference."`
 }
 
 type publisher struct {
@@ -31,29 +42,48 @@ type publisher struct {
 	ArticleRepository article.ArticleRepository
 	SummaryRepository summary.SummaryRepository
 	RSSFeed           *rss.RSS
-	R2Client          *storage.R2Storage
+	Storage           storage.Storage // nil in a dry run without a configured storage
 	Config            *config.Config
+	DryRun            bool
+	Prune             bool                // Delete episode files no longer referenced by the RSS feed
+	Webhooks          *webhook.Dispatcher // nil when writing a local preview with --out
+
+	published []*webhook.Payload // Episodes uploaded in this run, notified once the RSS feed is uploaded
 }
 
-func NewPublisher(ctx context.Context, client *ent.Client, config *config.Config) (*publisher, error) {
+func NewPublisher(client *ent.Client, config *config.Config, store storage.Storage) *publisher {
 
 	feedRepos := feed.NewRepository(client)
 	articleRepos := article.NewRepository(client)
 	summaryRepos := summary.NewRepository(client)
 	rssFeed := rss.NewRSS(config.Podcast)
-	r2client, err := storage.NewR2Storage(ctx, config)
-	if err != nil {
-		return nil, err
-	}
 
 	return &publisher{
 		FeedRepository:    feedRepos,
 		ArticleRepository: articleRepos,
 		SummaryRepository: summaryRepos,
 		RSSFeed:           rssFeed,
-		R2Client:          r2client,
+		Storage:           store,
 		Config:            config,
-	}, nil
+		Webhooks:          webhook.New(config),
+	}
+}
+
+// storage returns where the episodes are published: the output directory when --out is given,
+// R2 otherwise. A dry run does not require R2 to be configured.
+func (c *PublishCmd) storage(ctx context.Context, config *config.Config) (storage.Storage, error) {
+	if c.Out != "" {
+		return storage.NewLocalStorage(c.Out)
+	}
+	r2client, err := storage.NewR2Storage(ctx, config)
+	if err != nil {
+		if c.DryRun {
+			slog.Warn("Storage is not configured, comparing with an empty RSS feed", "error", err)
+			return nil, nil
+		}
+		return nil, err
+	}
+	return r2client, nil
 }
 
 func (c *PublishCmd) Run(client *ent.Client, config *config.Config) error {
@@ -72,10 +102,18 @@ func (c *PublishCmd) Run(client *ent.Client, config *config.Config) error {
 		return errors.Wrap(err, "failed to parse target date")
 	}
 	ctx := context.Background()
-	pb, err := NewPublisher(ctx, client, config)
+	store, err := c.storage(ctx, config)
 	if err != nil {
 		return err
 	}
+	pb := NewPublisher(client, config, store)
+	pb.DryRun = c.DryRun
+	// The output directory may hold files of the user, so it is only pruned on request
+	pb.Prune = !c.NoPrune && (c.Out == "" || c.Prune)
+	if c.Out != "" {
+		// A local preview is not a publication
+		pb.Webhooks = nil
+	}
 
 	feedList, err := pb.FeedRepository.All(ctx)
 	if err != nil {
@@ -86,6 +124,12 @@ func (c *PublishCmd) Run(client *ent.Client, config *config.Config) error {
 
 		tmpDate := targetDateTime.AddDate(0, 0, -i)
 		pubDate := tmpDate.Format("2006-01-02")
+		if c.Combined || config.Podcast.Combined {
+			if err := pb.processCombined(ctx, feedList, pubDate); err != nil {
+				return err
+			}
+			continue
+		}
 		for _, f := range feedList {
 			if err := pb.processFeed(ctx, f, pubDate); err != nil {
 				return err
@@ -100,16 +144,192 @@ func (c *PublishCmd) Run(client *ent.Client, config *config.Config) error {
 	return nil
 }
 
+// episodeClip is a summary audio file included in an episode.
+type episodeClip struct {
+	article *ent.Article
+	summary *ent.Summary
+	path    string
+	start   time.Duration
+}
+
+// episodeSection groups the clips of a single feed.
+type episodeSection struct {
+	feed  *ent.Feed
+	clips []episodeClip
+	start time.Duration
+}
+
 func (pb *publisher) processFeed(ctx context.Context, f *ent.Feed, pubDate string) error {
-	feedID := f.ID
 	feedName := f.Title
 
-	articles, err := pb.ArticleRepository.GetByDate(ctx, feedID, pubDate)
+	clips, err := pb.collectClips(ctx, f, pubDate)
+	if err != nil {
+		return err
+	}
+	if len(clips) == 0 {
+		fmt.Printf("No audio files found for feed %s on %s, skipping.\n", feedName, pubDate)
+		return nil
+	}
+
+	outputFilename := org.ConvertPathName(pubDate+"_"+feedName) + ".mp3"
+	title := fmt.Sprintf("%s %s Podcast", pubDate, feedName)
+	lead := fmt.Sprintf("This is %s %s podcast", pubDate, feedName)
+	if pb.DryRun {
+		return pb.planEpisode(outputFilename, pubDate, title, lead, []*episodeSection{{feed: f, clips: clips}})
+	}
+
+	output := filepath.Join(os.TempDir(), outputFilename)
+	defer func() {
+		if err := os.Remove(output); err != nil {
+			slog.Warn("Failed to remove temporary file", "path", output, "error", err)
+		}
+	}()
+
+	layout, err := tts.MergeEpisode(output, clipPaths(clips), pb.episodeOptions(ctx, pubDate, feedName, len(clips)))
+	if err != nil {
+		return errors.Wrap(err, "failed to merge mp3 files")
+	}
+	if err := tts.NormalizeLoudness(output, pb.Config.Loudness); err != nil {
+		return errors.Wrap(err, "failed to normalize merged episode")
+	}
+	for i := range clips {
+		clips[i].start = layout.ClipStarts[i]
+	}
+
+	sections := []*episodeSection{{feed: f, clips: clips}}
+	notes := showNotes(lead, sections)
+	if err := pb.uploadEpisode(ctx, output, outputFilename, pubDate, title, notes, layout.Duration); err != nil {
+		return err
+	}
+	pb.addPublished(f, title, outputFilename, sections)
+	return nil
+}
+
+// processCombined publishes a single episode containing every feed of the day,
+// ordered by feed order, with a chapter per feed.
+func (pb *publisher) processCombined(ctx context.Context, feeds []*ent.Feed, pubDate string) error {
+	sorted := slices.Clone(feeds)
+	slices.SortStableFunc(sorted, func(a, b *ent.Feed) int {
+		return cmp.Compare(a.Order, b.Order)
+	})
+
+	sections := make([]*episodeSection, 0, len(sorted))
+	total := 0
+	for _, f := range sorted {
+		clips, err := pb.collectClips(ctx, f, pubDate)
+		if err != nil {
+			return err
+		}
+		if len(clips) == 0 {
+			continue
+		}
+		sections = append(sections, &episodeSection{feed: f, clips: clips})
+		total += len(clips)
+	}
+
+	if len(sections) == 0 {
+		fmt.Printf("No audio files found on %s, skipping.\n", pubDate)
+		return nil
+	}
+
+	outputFilename := org.ConvertPathName(pubDate+"_daily") + ".mp3"
+	title := pb.Config.Podcast.ChannelTitle
+	lead := fmt.Sprintf("%s %s: %d articles from %d feeds", pubDate, title, total, len(sections))
+	if pb.DryRun {
+		return pb.planEpisode(outputFilename, pubDate, fmt.Sprintf("%s %s", pubDate, title), lead, sections)
+	}
+
+	workDir, err := os.MkdirTemp("", "quicknews-publish-")
 	if err != nil {
-		return errors.Wrap(err, "failed to get articles by date")
+		return errors.Wrap(err, "failed to create work directory")
+	}
+	defer func() {
+		if err := os.RemoveAll(workDir); err != nil {
+			slog.Warn("Failed to remove work directory", "path", workDir, "error", err)
+		}
+	}()
+
+	sectionFiles := make([]string, len(sections))
+	for i, sec := range sections {
+		// Each section carries its own spoken header; intro and outro frame the whole episode.
+		opts := pb.episodeOptions(ctx, pubDate, sec.feed.Title, len(sec.clips))
+		opts.IntroFile, opts.OutroFile = "", ""
+		sectionFiles[i] = filepath.Join(workDir, fmt.Sprintf("section%03d.mp3", i))
+		sectionLayout, err := tts.MergeEpisode(sectionFiles[i], clipPaths(sec.clips), opts)
+		if err != nil {
+			return errors.Wrapf(err, "failed to merge section %s", sec.feed.Title)
+		}
+		// Offsets relative to the section; shifted once the section is placed in the episode.
+		for j := range sec.clips {
+			sec.clips[j].start = sectionLayout.ClipStarts[j]
+		}
 	}
 
-	infiles := make([]string, 0)
+	output := filepath.Join(os.TempDir(), outputFilename)
+	defer func() {
+		if err := os.Remove(output); err != nil {
+			slog.Warn("Failed to remove temporary file", "path", output, "error", err)
+		}
+	}()
+
+	opts := pb.episodeOptions(ctx, pubDate, title, total)
+	if opts.Separator == "" {
+		opts.Separator = tts.SeparatorSilence
+	}
+	layout, err := tts.MergeEpisode(output, sectionFiles, opts)
+	if err != nil {
+		return errors.Wrap(err, "failed to merge mp3 files")
+	}
+	if err := tts.NormalizeLoudness(output, pb.Config.Loudness); err != nil {
+		return errors.Wrap(err, "failed to normalize merged episode")
+	}
+
+	chapters := make([]tts.Chapter, len(sections))
+	for i, sec := range sections {
+		sec.start = layout.ClipStarts[i]
+		for j := range sec.clips {
+			sec.clips[j].start += sec.start
+		}
+		chapters[i] = tts.Chapter{Title: sec.feed.Title, Start: sec.start, End: layout.ClipEnds[i]}
+	}
+	// The first chapter also covers the intro and the episode header.
+	chapters[0].Start = 0
+	sections[0].start = 0
+	if err := tts.WriteChapters(output, chapters); err != nil {
+		return errors.Wrap(err, "failed to write chapters")
+	}
+
+	notes := showNotes(lead, sections)
+	episodeTitle := fmt.Sprintf("%s %s", pubDate, title)
+	if err := pb.uploadEpisode(ctx, output, outputFilename, pubDate, episodeTitle, notes, layout.Duration); err != nil {
+		return err
+	}
+	pb.addPublished(nil, episodeTitle, outputFilename, sections)
+	return nil
+}
+
+// addPublished records an uploaded episode for the published webhook event. feed is nil
+// for a combined episode.
+func (pb *publisher) addPublished(feed *ent.Feed, title, outputFilename string, sections []*episodeSection) {
+	var sums []*ent.Summary
+	for _, sec := range sections {
+		for _, clip := range sec.clips {
+			sums = append(sums, clip.summary)
+		}
+	}
+	url := pb.Config.Podcast.PublishURL + "/" + outputFilename
+	pb.published = append(pb.published, webhook.NewEpisodePayload(feed, title, url, sums))
+}
+
+// collectClips returns the audio files of the summaries of the feed published on pubDate,
+// synthesizing the missing ones. In a dry run missing audio files are left empty instead.
+func (pb *publisher) collectClips(ctx context.Context, f *ent.Feed, pubDate string) ([]episodeClip, error) {
+	articles, err := pb.ArticleRepository.GetByDate(ctx, f.ID, pubDate)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get articles by date")
+	}
+
+	clips := make([]episodeClip, 0, len(articles))
 	for _, article := range articles {
 		sum := article.Edges.Summary
 		if sum == nil {
@@ -124,13 +344,17 @@ func (pb *publisher) processFeed(ctx context.Context, f *ent.Feed, pubDate strin
 				slog.Warn("Skip summary because it is too long", slog.Any("title", article.Edges.Summary.Title))
 				continue
 			}
+			if pb.DryRun {
+				clips = append(clips, episodeClip{article: article, summary: sum})
+				continue
+			}
 			filename, err := summary.SaveAudioData(ctx, article.Edges.Summary, pb.Config)
 			if err != nil {
-				return err
+				return nil, err
 			}
 			if filename != nil {
 				if err := pb.SummaryRepository.UpdateAudioFile(ctx, sum.ID, *filename); err != nil {
-					return err
+					return nil, err
 				}
 				audioFile = *filename
 				slog.Info("Saved audio file for summary", slog.String("file", audioFile), slog.String("title", sum.Title))
@@ -138,28 +362,17 @@ func (pb *publisher) processFeed(ctx context.Context, f *ent.Feed, pubDate strin
 		} else {
 			slog.Info("Get audio file for summary", slog.String("file", audioFile), slog.String("title", sum.Title))
 		}
-		infile := filepath.Join(*pb.Config.AudioPath, audioFile)
-		infiles = append(infiles, infile)
-
-	}
-
-	if len(infiles) == 0 {
-		fmt.Printf("No audio files found for feed %s on %s, skipping.\n", feedName, pubDate)
-		return nil
-	}
-
-	outputFilename := org.ConvertPathName(pubDate+"_"+feedName) + ".mp3"
-	output := filepath.Join(os.TempDir(), outputFilename)
-	defer func() {
-		if err := os.Remove(output); err != nil {
-			slog.Warn("Failed to remove temporary file", "path", output, "error", err)
-		}
-	}()
-
-	if err := tts.MergeMP3(output, infiles); err != nil {
-		return errors.Wrap(err, "failed to merge mp3 files")
+		clips = append(clips, episodeClip{
+			article: article,
+			summary: sum,
+			path:    filepath.Join(*pb.Config.AudioPath, audioFile),
+		})
 	}
+	return clips, nil
+}
 
+// uploadEpisode uploads the merged episode and adds it to the RSS feed with the HTML show notes.
+func (pb *publisher) uploadEpisode(ctx context.Context, output, outputFilename, pubDate, title, notes string, duration time.Duration) error {
 	// アップロード処理
 	meta, err := os.Stat(output)
 	if err != nil {
@@ -177,31 +390,165 @@ func (pb *publisher) processFeed(ctx context.Context, f *ent.Feed, pubDate strin
 		}
 	}()
 
-	if err := pb.R2Client.Upload(ctx, outputFilename, fileReader, "audio/mpeg"); err != nil {
+	if err := pb.Storage.Upload(ctx, outputFilename, fileReader, "audio/mpeg"); err != nil {
 		return errors.Wrap(err, "failed to upload audio file")
 	}
 
-	// Add item to RSS feed
+	return pb.addRSSItem(outputFilename, pubDate, title, notes, fileSize, duration)
+}
+
+// addRSSItem adds an episode to the RSS feed.
+func (pb *publisher) addRSSItem(outputFilename, pubDate, title, notes string, fileSize int64, duration time.Duration) error {
 	pubdate, err := time.Parse("2006-01-02", pubDate)
 	if err != nil {
 		return errors.Wrap(err, "failed to parse date")
 	}
 	podcastConfig := pb.Config.Podcast
 	pb.RSSFeed.AddItem(rss.RSSItem{
-		Title:       fmt.Sprintf("%s %s Podcast", pubDate, feedName),
+		Title:       title,
 		Link:        podcastConfig.PublishURL + "/" + outputFilename,
 		Guid:        podcastConfig.PublishURL + "/" + outputFilename,
 		PubDate:     pubdate.UTC().Format(time.RFC1123),
-		Description: fmt.Sprintf("This is %s %s podcast", pubDate, feedName),
+		Description: notes,
+		Content:     notes,
+		Duration:    formatTimestamp(duration),
 		AudioURL:    podcastConfig.PublishURL + "/" + outputFilename,
 		Length:      fmt.Sprintf("%d", fileSize),
 		MimeType:    "audio/mpeg",
 	})
-
 	return nil
 }
 
+// planEpisode prints the summaries and audio files an episode would be made of, and adds
+// the episode to the RSS feed with its estimated size.
+func (pb *publisher) planEpisode(outputFilename, pubDate, title, lead string, sections []*episodeSection) error {
+	fmt.Printf("Episode %s: %s\n", outputFilename, title)
+	var size int64
+	for _, sec := range sections {
+		fmt.Printf("  %s\n", sec.feed.Title)
+		for _, c := range sec.clips {
+			if c.path == "" {
+				fmt.Printf("    - %s (audio will be synthesized)\n", c.summary.Title)
+				continue
+			}
+			info, err := os.Stat(c.path)
+			if err != nil {
+				fmt.Printf("    - %s (%s: %v)\n", c.summary.Title, c.path, err)
+				continue
+			}
+			size += info.Size()
+			fmt.Printf("    - %s (%s, %s)\n", c.summary.Title, filepath.Base(c.path), formatSize(info.Size()))
+		}
+	}
+	fmt.Printf("  Estimated size: %s\n", formatSize(size))
+	return pb.addRSSItem(outputFilename, pubDate, title, showNotes(lead, sections), size, 0)
+}
+
+// formatSize formats a number of bytes for display.
+func formatSize(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := int64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
+
+func clipPaths(clips []episodeClip) []string {
+	paths := make([]string, len(clips))
+	for i, c := range clips {
+		paths[i] = c.path
+	}
+	return paths
+}
+
+// showNotesExcerptLength is the number of characters of each summary quoted in the show notes.
+const showNotesExcerptLength = 200
+
+// showNotes renders the HTML show notes of an episode: every article with its timestamp,
+// a summary excerpt and a link, grouped by feed section.
+func showNotes(lead string, sections []*episodeSection) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "<p>%s</p>\n", html.EscapeString(lead))
+	for _, sec := range sections {
+		fmt.Fprintf(&b, "<h3>%s</h3>\n<ul>\n", html.EscapeString(sec.feed.Title))
+		for _, c := range sec.clips {
+			fmt.Fprintf(&b, "<li>[%s] <a href=\"%s\">%s</a>",
+				formatTimestamp(c.start), html.EscapeString(c.article.URL), html.EscapeString(c.summary.Title))
+			if excerpt := excerpt(c.summary.Summary, showNotesExcerptLength); excerpt != "" {
+				fmt.Fprintf(&b, "<br/>%s", html.EscapeString(excerpt))
+			}
+			b.WriteString("</li>\n")
+		}
+		b.WriteString("</ul>\n")
+	}
+	return b.String()
+}
+
+// excerpt returns the first n characters of s on a single line.
+func excerpt(s string, n int) string {
+	s = strings.Join(strings.Fields(s), " ")
+	runes := []rune(s)
+	if len(runes) <= n {
+		return s
+	}
+	return string(runes[:n]) + "…"
+}
+
+func formatTimestamp(d time.Duration) string {
+	s := int(d.Seconds())
+	return fmt.Sprintf("%02d:%02d:%02d", s/3600, s/60%60, s%60)
+}
+
+// episodeOptions builds the intro, spoken header, separator and outro settings for an episode.
+func (pb *publisher) episodeOptions(ctx context.Context, pubDate, title string, count int) *tts.EpisodeOptions {
+	podcastConfig := pb.Config.Podcast
+	opts := &tts.EpisodeOptions{
+		IntroFile:         podcastConfig.IntroFile,
+		OutroFile:         podcastConfig.OutroFile,
+		Separator:         podcastConfig.Separator,
+		SeparatorDuration: time.Duration(podcastConfig.SeparatorSec * float64(time.Second)),
+	}
+	if podcastConfig.SpokenHeader {
+		text := strings.NewReplacer(
+			"{date}", pubDate,
+			"{feed}", title,
+			"{count}", strconv.Itoa(count),
+		).Replace(podcastConfig.HeaderTemplate)
+		header, err := tts.NewTTSEngine(pb.Config).SynthesizeText(ctx, text)
+		if err != nil {
+			slog.Warn("Failed to synthesize spoken header", "text", text, "error", err)
+		} else {
+			opts.Header = header
+		}
+	}
+	return opts
+}
+
+// publishRSS regenerates the RSS feed from the episodes published in this run and the ones
+// already published, applies the retention policy, uploads it and prunes the episode files
+// it no longer references.
 func (pb *publisher) publishRSS(ctx context.Context) error {
+	current, err := pb.currentRSS(ctx)
+	if err != nil {
+		return err
+	}
+	pb.RSSFeed.Merge(current)
+	podcastConfig := pb.Config.Podcast
+	expired := pb.RSSFeed.Retain(time.Duration(podcastConfig.RetentionDays)*24*time.Hour, podcastConfig.RetentionCount, time.Now())
+	for _, item := range expired {
+		slog.Info("Episode expired by retention policy", "title", item.Title, "pubDate", item.PubDate)
+	}
+	pb.RSSFeed.NumberEpisodes()
+
+	if pb.DryRun {
+		return pb.planRSS(ctx, current)
+	}
+
 	rssOutput := filepath.Join(os.TempDir(), "rss.xml")
 	defer func() {
 		if err := os.Remove(rssOutput); err != nil {
@@ -223,10 +570,111 @@ func (pb *publisher) publishRSS(ctx context.Context) error {
 		}
 	}()
 
-	if err := pb.R2Client.Upload(ctx, "rss.xml", rssFile, "application/rss+xml"); err != nil {
+	if err := pb.Storage.Upload(ctx, "rss.xml", rssFile, "application/rss+xml"); err != nil {
 		return errors.Wrap(err, "failed to upload RSS file")
 	}
 
 	fmt.Println("Successfully published RSS feed.")
+
+	if pb.Webhooks != nil {
+		for _, payload := range pb.published {
+			if err := pb.Webhooks.Dispatch(ctx, payload); err != nil {
+				slog.Error("failed to notify webhooks", "episode", payload.Episode.Title, "error", err)
+			}
+		}
+	}
+
+	if pb.Prune {
+		return pb.pruneEpisodes(ctx)
+	}
+	return nil
+}
+
+// currentRSS returns the published RSS feed, or nil when there is none yet.
+func (pb *publisher) currentRSS(ctx context.Context) (*rss.RSS, error) {
+	if pb.Storage == nil {
+		return nil, nil
+	}
+	data, err := pb.Storage.Download(ctx, "rss.xml")
+	if errors.Is(err, storage.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to download RSS file")
+	}
+	return rss.Parse(data)
+}
+
+// unreferencedEpisodes returns the episode files in the storage that the RSS feed does not
+// reference. Only MP3 files at the top level are considered, other objects are left alone.
+func (pb *publisher) unreferencedEpisodes(ctx context.Context) ([]storage.Object, error) {
+	objects, err := pb.Storage.List(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	referenced := make(map[string]bool, len(pb.RSSFeed.Channel.Items))
+	for _, item := range pb.RSSFeed.Channel.Items {
+		referenced[path.Base(item.Enclosure.URL)] = true
+	}
+
+	var unreferenced []storage.Object
+	for _, obj := range objects {
+		if strings.Contains(obj.Key, "/") || !strings.HasSuffix(obj.Key, ".mp3") || referenced[obj.Key] {
+			continue
+		}
+		unreferenced = append(unreferenced, obj)
+	}
+	return unreferenced, nil
+}
+
+// pruneEpisodes deletes the episode files no longer referenced by the RSS feed and reports
+// the reclaimed space.
+func (pb *publisher) pruneEpisodes(ctx context.Context) error {
+	unreferenced, err := pb.unreferencedEpisodes(ctx)
+	if err != nil {
+		return err
+	}
+	var reclaimed int64
+	for _, obj := range unreferenced {
+		if err := pb.Storage.Delete(ctx, obj.Key); err != nil {
+			return err
+		}
+		reclaimed += obj.Size
+	}
+	fmt.Printf("Pruned %d episode files, reclaimed %s.\n", len(unreferenced), formatSize(reclaimed))
+	return nil
+}
+
+// planRSS prints the changes the regenerated RSS feed would make to the published one,
+// and the episode files that would be pruned.
+func (pb *publisher) planRSS(ctx context.Context, current *rss.RSS) error {
+	diff := rss.DiffItems(current, pb.RSSFeed)
+	fmt.Println("RSS changes:")
+	if diff.Empty() {
+		fmt.Println("  (none)")
+	}
+	for _, item := range diff.Added {
+		fmt.Printf("  + %s (%s)\n", item.Title, item.Enclosure.URL)
+	}
+	for _, item := range diff.Changed {
+		fmt.Printf("  ~ %s (%s)\n", item.Title, item.Enclosure.URL)
+	}
+	for _, item := range diff.Removed {
+		fmt.Printf("  - %s (%s)\n", item.Title, item.Enclosure.URL)
+	}
+
+	if pb.Prune && pb.Storage != nil {
+		unreferenced, err := pb.unreferencedEpisodes(ctx)
+		if err != nil {
+			return err
+		}
+		var reclaimed int64
+		for _, obj := range unreferenced {
+			fmt.Printf("  prune %s (%s)\n", obj.Key, formatSize(obj.Size))
+			reclaimed += obj.Size
+		}
+		fmt.Printf("Would prune %d episode files, reclaiming %s.\n", len(unreferenced), formatSize(reclaimed))
+	}
+	fmt.Println("Dry run: nothing was merged or uploaded.")
 	return nil
 }
@@ -0,0 +1,183 @@
+package cmd
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"github.com/dmulholl/mp3lib"
+	"github.com/mopemope/quicknews/config"
+	"github.com/mopemope/quicknews/ent"
+	"github.com/mopemope/quicknews/ent/enttest"
+	"github.com/mopemope/quicknews/rss"
+	"github.com/mopemope/quicknews/tts"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupPublish creates a feed with two summarized articles published on 2024-01-01 whose
+// audio files are silent MP3 clips.
+func setupPublish(t *testing.T, name string) (*ent.Client, *config.Config) {
+	t.Helper()
+	ctx := context.Background()
+	client := enttest.Open(t, dialect.SQLite, "file:"+name+"?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() { _ = client.Close() })
+
+	audioPath := t.TempDir()
+	ref := &mp3lib.MP3Frame{
+		MPEGVersion:  mp3lib.MPEGVersion2,
+		MPEGLayer:    mp3lib.MPEGLayerIII,
+		ChannelMode:  mp3lib.Mono,
+		BitRate:      64000,
+		SamplingRate: 24000,
+		SampleCount:  576,
+		RawBytes:     []byte{0xff, 0xf3, 0x84, 0xc4},
+	}
+
+	f, err := client.Feed.Create().
+		SetURL("https://example.com/feed").
+		SetTitle("Example").
+		SetDescription("Example feed").
+		SetLink("https://example.com").
+		SetUpdatedAt(time.Now()).
+		Save(ctx)
+	require.NoError(t, err)
+
+	for i, title := range []string{"First", "Second"} {
+		url := "https://example.com/" + title
+		a, err := client.Article.Create().
+			SetTitle(title).
+			SetURL(url).
+			SetDescription("").
+			SetContent("").
+			SetPublishedAt(time.Date(2023, 12, 31, 10+i, 0, 0, 0, time.UTC)).
+			SetFeed(f).
+			Save(ctx)
+		require.NoError(t, err)
+
+		audioFile := title + ".mp3"
+		require.NoError(t, tts.WriteSilenceMP3(filepath.Join(audioPath, audioFile), ref, 480*time.Millisecond))
+		_, err = client.Summary.Create().
+			SetURL(url).
+			SetTitle(title).
+			SetSummary(title + " summary").
+			SetAudioFile(audioFile).
+			SetArticle(a).
+			SetFeed(f).
+			Save(ctx)
+		require.NoError(t, err)
+	}
+
+	cfg := &config.Config{
+		AudioPath: &audioPath,
+		Podcast: &config.Podcast{
+			ChannelTitle: "Test Podcast",
+			PublishURL:   "https://podcast.example.com",
+		},
+	}
+	return client, cfg
+}
+
+func TestPublishCmd_Out(t *testing.T) {
+	client, cfg := setupPublish(t, "publish_out")
+	out := t.TempDir()
+
+	cmd := &PublishCmd{Date: "2024-01-01", Range: 1, Out: out}
+	require.NoError(t, cmd.Run(client, cfg))
+
+	info, err := os.Stat(filepath.Join(out, "2024-01-01_Example.mp3"))
+	require.NoError(t, err)
+	assert.Positive(t, info.Size())
+
+	data, err := os.ReadFile(filepath.Join(out, "rss.xml"))
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "<title>2024-01-01 Example Podcast</title>")
+	assert.Contains(t, string(data), "https://podcast.example.com/2024-01-01_Example.mp3")
+	assert.Contains(t, string(data), "<itunes:episode>1</itunes:episode>")
+	assert.Contains(t, string(data), "https://example.com/Second")
+}
+
+func TestPublishCmd_OutKeepsFiles(t *testing.T) {
+	client, cfg := setupPublish(t, "publish_out_keep")
+	out := t.TempDir()
+	song := filepath.Join(out, "song.mp3")
+	require.NoError(t, os.WriteFile(song, []byte("data"), 0o644))
+
+	cmd := &PublishCmd{Date: "2024-01-01", Range: 1, Out: out}
+	require.NoError(t, cmd.Run(client, cfg))
+	assert.FileExists(t, song, "the output directory is only pruned with --prune")
+	assert.FileExists(t, filepath.Join(out, "2024-01-01_Example.mp3"))
+}
+
+func TestPublishCmd_OutNoWebhooks(t *testing.T) {
+	client, cfg := setupPublish(t, "publish_out_webhooks")
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+	}))
+	defer server.Close()
+	cfg.Webhooks = []*config.Webhook{{Name: "test", URL: server.URL}}
+
+	cmd := &PublishCmd{Date: "2024-01-01", Range: 1, Out: t.TempDir()}
+	require.NoError(t, cmd.Run(client, cfg))
+	assert.Zero(t, requests.Load(), "a local preview does not notify the webhooks")
+}
+
+func TestPublishCmd_DryRun(t *testing.T) {
+	client, cfg := setupPublish(t, "publish_dry_run")
+	out := t.TempDir()
+
+	cmd := &PublishCmd{Date: "2024-01-01", Range: 1, Out: out, DryRun: true}
+	require.NoError(t, cmd.Run(client, cfg))
+
+	entries, err := os.ReadDir(out)
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
+
+func TestPublishCmd_Retention(t *testing.T) {
+	client, cfg := setupPublish(t, "publish_retention")
+	out := t.TempDir()
+
+	// A previously published episode, a stale file and an unrelated object.
+	prev := rss.NewRSS(cfg.Podcast)
+	prev.AddItem(rss.RSSItem{
+		Title:    "2023-12-01 Example Podcast",
+		Guid:     "https://podcast.example.com/2023-12-01_Example.mp3",
+		PubDate:  "Fri, 01 Dec 2023 00:00:00 UTC",
+		AudioURL: "https://podcast.example.com/2023-12-01_Example.mp3",
+		Episode:  7,
+	})
+	require.NoError(t, prev.WriteToFile(filepath.Join(out, "rss.xml")))
+	for _, name := range []string{"2023-12-01_Example.mp3", "stale.mp3", "site/audio.mp3"} {
+		require.NoError(t, os.MkdirAll(filepath.Dir(filepath.Join(out, name)), os.ModePerm))
+		require.NoError(t, os.WriteFile(filepath.Join(out, name), []byte("data"), 0644))
+	}
+
+	cmd := &PublishCmd{Date: "2024-01-01", Range: 1, Out: out, Prune: true}
+	require.NoError(t, cmd.Run(client, cfg))
+
+	data, err := os.ReadFile(filepath.Join(out, "rss.xml"))
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "2023-12-01 Example Podcast")
+	assert.Contains(t, string(data), "<itunes:episode>8</itunes:episode>")
+	assert.FileExists(t, filepath.Join(out, "2023-12-01_Example.mp3"))
+	assert.NoFileExists(t, filepath.Join(out, "stale.mp3"))
+	assert.FileExists(t, filepath.Join(out, "site/audio.mp3"))
+
+	// Keeping a single episode expires the old one and prunes its file.
+	cfg.Podcast.RetentionCount = 1
+	require.NoError(t, cmd.Run(client, cfg))
+
+	data, err = os.ReadFile(filepath.Join(out, "rss.xml"))
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "2023-12-01 Example Podcast")
+	assert.NoFileExists(t, filepath.Join(out, "2023-12-01_Example.mp3"))
+	assert.FileExists(t, filepath.Join(out, "2024-01-01_Example.mp3"))
+}
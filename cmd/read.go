@@ -43,10 +43,10 @@ func (t *ReadCmd) Run(client *ent.Client, config *config.Config) error {
 	}
 
 	if !t.NoFetch {
+		startWebSub(client, config)
 		go func() {
 			for {
-				fetchArticles(client, config)
-				time.Sleep(time.Hour)
+				time.Sleep(fetchArticles(client, config))
 			}
 		}()
 	}
@@ -0,0 +1,131 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/mopemope/quicknews/config"
+	"github.com/mopemope/quicknews/ent"
+	"github.com/mopemope/quicknews/httpclient"
+	"github.com/mopemope/quicknews/models/article"
+	"github.com/mopemope/quicknews/models/feed"
+	"github.com/mopemope/quicknews/rules"
+	"github.com/mopemope/quicknews/scraper"
+)
+
+// RulesCmd groups the rule subcommands.
+type RulesCmd struct {
+	List RulesListCmd `cmd:"" default:"1" help:"List the configured rules."`
+	Test RulesTestCmd `cmd:"" help:"Show what the rules would do with the items of a feed or with a page, without saving anything."`
+}
+
+// RulesListCmd lists the configured rules.
+type RulesListCmd struct{}
+
+func (c *RulesListCmd) Run(config *config.Config) error {
+	if err := rules.New(config.Rules).Err(); err != nil {
+		return err
+	}
+
+	tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(tw, "NAME\tCONDITIONS\tACTIONS")
+	for _, r := range config.Rules {
+		var conditions []string
+		for _, cond := range []struct{ name, value string }{
+			{"feed", r.Feed},
+			{"title", r.Title},
+			{"description", r.Description},
+			{"author", r.Author},
+			{"keywords", strings.Join(r.Keywords, ",")},
+		} {
+			if cond.value != "" {
+				conditions = append(conditions, fmt.Sprintf("%s=%q", cond.name, cond.value))
+			}
+		}
+		if len(conditions) == 0 {
+			conditions = append(conditions, "*")
+		}
+		_, _ = fmt.Fprintf(tw, "%s\t%s\t%s\n", r.Name, strings.Join(conditions, " "), strings.Join(r.Actions, ","))
+	}
+	return tw.Flush()
+}
+
+// RulesTestCmd evaluates the rules without saving or summarizing anything.
+type RulesTestCmd struct {
+	URL string `arg:"" name:"url" help:"URL of a feed, to test each of its items, or of a page."`
+}
+
+func (c *RulesTestCmd) Run(client *ent.Client, config *config.Config) error {
+	engine := rules.New(config.Rules)
+	if err := engine.Err(); err != nil {
+		return err
+	}
+	settings, err := httpclient.New(config, c.URL)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	items, err := c.items(ctx, client, settings)
+	if err != nil {
+		return err
+	}
+	return printDecisions(os.Stdout, engine, items)
+}
+
+// items returns the items of the feed at the URL, or the page at the URL as a single item.
+func (c *RulesTestCmd) items(ctx context.Context, client *ent.Client, settings *httpclient.Settings) ([]*rules.Item, error) {
+	if parsed, err := newFeedParser(settings).ParseURLWithContext(c.URL, ctx); err == nil {
+		f, err := feed.NewRepository(client).GetByURL(ctx, c.URL)
+		if err != nil {
+			// Not subscribed yet; match on the title the feed declares.
+			f = &ent.Feed{Title: parsed.Title, URL: c.URL}
+		}
+		items := make([]*rules.Item, len(parsed.Items))
+		for i, item := range parsed.Items {
+			items[i] = rules.NewItem(f, item)
+		}
+		return items, nil
+	}
+
+	a, err := article.NewRepository(client).GetFromURL(ctx, c.URL)
+	if err != nil {
+		return nil, err
+	}
+	if a != nil {
+		item := &rules.Item{Title: a.Title, Description: a.Description}
+		if a.Edges.Feed != nil {
+			item.FeedTitle, item.FeedURL = a.Edges.Feed.Title, a.Edges.Feed.URL
+		}
+		return []*rules.Item{item}, nil
+	}
+	title, err := scraper.GetTitle(c.URL, scraper.WithHTTP(settings))
+	if err != nil {
+		return nil, err
+	}
+	return []*rules.Item{{Title: title}}, nil
+}
+
+func printDecisions(w io.Writer, engine *rules.Engine, items []*rules.Item) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(tw, "TITLE\tRULES\tACTIONS")
+	for _, item := range items {
+		d, err := engine.Evaluate(item)
+		if err != nil {
+			return err
+		}
+		matched, actions := "-", "summarize"
+		if len(d.Matched) > 0 {
+			matched = strings.Join(d.Matched, ",")
+		}
+		if a := d.Actions(); len(a) > 0 {
+			actions = strings.Join(a, " ")
+		}
+		_, _ = fmt.Fprintf(tw, "%s\t%s\t%s\n", item.Title, matched, actions)
+	}
+	return tw.Flush()
+}
@@ -0,0 +1,31 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/mopemope/quicknews/config"
+	"github.com/mopemope/quicknews/rules"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrintDecisions(t *testing.T) {
+	engine := rules.New([]*config.Rule{
+		{Name: "ads", Title: "(?i)sponsored", Actions: []string{rules.ActionSkip}},
+		{Name: "go", Keywords: []string{"go"}, Actions: []string{rules.ActionMarkRead}},
+	})
+
+	var buf bytes.Buffer
+	require.NoError(t, printDecisions(&buf, engine, []*rules.Item{
+		{Title: "Sponsored post"},
+		{Title: "Go 1.25 released"},
+		{Title: "Rust news"},
+	}))
+
+	lines := bytes.Split(bytes.TrimSpace(buf.Bytes()), []byte("\n"))
+	require.Len(t, lines, 4)
+	assert.Regexp(t, `^Sponsored post\s+ads\s+skip$`, string(lines[1]))
+	assert.Regexp(t, `^Go 1.25 released\s+go\s+mark-read$`, string(lines[2]))
+	assert.Regexp(t, `^Rust news\s+-\s+summarize$`, string(lines[3]))
+}
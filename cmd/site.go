@@ -0,0 +1,80 @@
+package cmd
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/mopemope/quicknews/config"
+	"github.com/mopemope/quicknews/ent"
+	"github.com/mopemope/quicknews/models/summary"
+	"github.com/mopemope/quicknews/site"
+	"github.com/mopemope/quicknews/storage"
+)
+
+type SiteCmd struct {
+	Out    string `short:"o" type:"path" help:"Write the site into this directory instead of uploading it."`
+	Days   int    `help:"Only include summaries of the last N days. 0 includes every summary." default:"0"`
+	Title  string `help:"Title of the site. Defaults to the podcast channel title."`
+	Prefix string `help:"Key prefix of the site in the storage. Ignored with --out." default:"site/"`
+}
+
+func (c *SiteCmd) Run(client *ent.Client, config *config.Config) error {
+	ctx := context.Background()
+
+	var store storage.Storage
+	prefix := c.Prefix
+	if c.Out != "" {
+		local, err := storage.NewLocalStorage(c.Out)
+		if err != nil {
+			return err
+		}
+		store, prefix = local, ""
+	} else {
+		r2client, err := storage.NewR2Storage(ctx, config)
+		if err != nil {
+			return err
+		}
+		store = r2client
+	}
+
+	sums, err := summary.NewRepository(client).GetAll(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get summaries")
+	}
+	if c.Days > 0 {
+		since := time.Now().AddDate(0, 0, -c.Days)
+		filtered := sums[:0]
+		for _, sum := range sums {
+			if sum.CreatedAt.After(since) {
+				filtered = append(filtered, sum)
+			}
+		}
+		sums = filtered
+	}
+
+	title := c.Title
+	if config.Podcast != nil {
+		title = cmp.Or(title, config.Podcast.ChannelTitle)
+	}
+	opts := &site.Options{
+		Title:  cmp.Or(title, "quicknews daily"),
+		Prefix: prefix,
+	}
+	if config.AudioPath != nil {
+		opts.AudioPath = *config.AudioPath
+	}
+
+	gen, err := site.NewGenerator(store, opts)
+	if err != nil {
+		return err
+	}
+	result, err := gen.Generate(ctx, sums)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Generated %d pages and %d audio files from %d summaries.\n", result.Pages, result.Audio, len(sums))
+	return nil
+}
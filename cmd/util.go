@@ -3,33 +3,120 @@ package cmd
 import (
 	"context"
 	"log/slog"
+	"time"
 
 	pond "github.com/alitto/pond/v2"
+	"github.com/mmcdole/gofeed"
+	"github.com/mopemope/quicknews/clock"
 	"github.com/mopemope/quicknews/cmd/fetch"
 	"github.com/mopemope/quicknews/config"
+	"github.com/mopemope/quicknews/database"
 	"github.com/mopemope/quicknews/ent"
 	"github.com/mopemope/quicknews/models/article"
 	"github.com/mopemope/quicknews/models/feed"
 	"github.com/mopemope/quicknews/models/summary"
+	"github.com/mopemope/quicknews/websub"
 )
 
-func fetchArticles(client *ent.Client, config *config.Config) {
+// Bounds of the wait between two background fetches.
+const (
+	minFetchWait = time.Minute
+	maxFetchWait = time.Hour
+)
+
+// maxQueuedItems bounds the items waiting for a worker of itemPool.
+const maxQueuedItems = 1000
+
+// itemPool processes the items of the background fetches and of the WebSub pushes, so that
+// pushes cannot start more work than the fetches do.
+var itemPool = pond.NewPool(3, pond.WithQueueSize(maxQueuedItems))
+
+// fetchArticles processes the items of the feeds that are due, prunes old articles when
+// the retention policy is automatic, and returns how long to wait
+// until the next feed is due.
+func fetchArticles(client *ent.Client, config *config.Config) time.Duration {
 	feedRepos := feed.NewRepository(client)
 	articleRepos := article.NewRepository(client)
 	summaryRepos := summary.NewRepository(client)
 
 	feedProcessor := fetch.NewFeedProcessor(feedRepos, articleRepos, summaryRepos, config)
 	ctx := context.Background()
-	items, err := feedProcessor.GetItems(ctx)
+	items, err := feedProcessor.GetDueItems(ctx)
 	if err != nil {
 		slog.Error("Error fetching items", "error", err)
-		return
+		return maxFetchWait
 	}
-	pool := pond.NewPool(3)
+	group := itemPool.NewGroup()
 	for _, item := range items {
-		pool.Submit(func() {
-			item.Process()
-		})
+		group.Submit(item.Process)
+	}
+	if err := group.Wait(); err != nil {
+		slog.Error("Error processing items", "error", err)
+	}
+	autoPrune(ctx, client, config)
+
+	next, err := feedProcessor.NextPoll(ctx)
+	if err != nil {
+		slog.Error("Error scheduling next fetch", "error", err)
+		return maxFetchWait
+	}
+	if next.IsZero() {
+		return maxFetchWait
+	}
+	return min(max(time.Until(next), minFetchWait), maxFetchWait)
+}
+
+// lastPrune is when autoPrune last applied the retention policy.
+var lastPrune time.Time
+
+// autoPrune applies the retention policy once a day when [retention] enables auto, and
+// vacuums the database when articles were pruned.
+func autoPrune(ctx context.Context, client *ent.Client, config *config.Config) {
+	if config.Retention == nil || !config.Retention.Auto || clock.Now().Sub(lastPrune) < 24*time.Hour {
+		return
 	}
-	pool.StopAndWait()
+	lastPrune = clock.Now()
+	result, err := prune(ctx, client, config, config.Retention, false)
+	if err != nil {
+		slog.Error("Error pruning articles", "error", err)
+		return
+	}
+	if len(result.Pruned) == 0 {
+		return
+	}
+	slog.Info("Pruned articles", "articles", len(result.Pruned), "summaries", result.Summaries, "audio_files", len(result.AudioFiles))
+	if err := database.Vacuum(ctx, config.DB); err != nil {
+		slog.Warn("Failed to vacuum database", "error", err)
+	}
+}
+
+// startWebSub runs the WebSub subscriber in the background when [websub] is configured:
+// it keeps the feeds advertising a hub subscribed and processes the items they push.
+func startWebSub(client *ent.Client, config *config.Config) {
+	if config.WebSub == nil {
+		return
+	}
+	feedRepos := feed.NewRepository(client)
+	articleRepos := article.NewRepository(client)
+	summaryRepos := summary.NewRepository(client)
+
+	feedProcessor := fetch.NewFeedProcessor(feedRepos, articleRepos, summaryRepos, config)
+	subscriber := websub.NewSubscriber(feedRepos, config, func(ctx context.Context, f *ent.Feed, parsed *gofeed.Feed) {
+		items, err := feedProcessor.PushedItems(ctx, f, parsed)
+		if err != nil {
+			slog.Error("Error processing pushed items", "title", f.Title, "error", err)
+			return
+		}
+		for _, item := range items {
+			if _, ok := itemPool.TrySubmit(item.Process); !ok {
+				// The next poll of the feed picks the item up
+				slog.Warn("Dropped pushed item, too many items queued", "title", item.DisplayName())
+			}
+		}
+	})
+	go func() {
+		if err := subscriber.Run(context.Background()); err != nil {
+			slog.Error("WebSub subscriber stopped", "error", err)
+		}
+	}()
 }
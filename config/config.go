@@ -1,8 +1,10 @@
 package config
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/BurntSushi/toml"
 	"github.com/caarlos0/env/v11"
@@ -15,17 +17,33 @@ type Config struct {
 	GeminiApiKey                 string  `toml:"gemini_api_key" env:"GEMINI_API_KEY"`
 	GeminiModel                  string  `toml:"gemini_model" env:"GEMINI_MODEL"`
 	ExportOrg                    string  `toml:"export_org" env:"EXPORT_ORG"`
+	ExportOrgTemplate            string  `toml:"export_org_template" env:"EXPORT_ORG_TEMPLATE"` // text/template file
+	ExportOrgDaily               bool    `toml:"export_org_daily" env:"EXPORT_ORG_DAILY"`       // One file per day with a heading per summary
+	ExportMarkdown               string  `toml:"export_markdown" env:"EXPORT_MARKDOWN"`         // Obsidian vault directory
 	AudioPath                    *string `toml:"audio" env:"AUDIO"`
 	UseGeminiTTS                 bool    `toml:"use_gemini_tts" env:"USE_GEMINI_TTS"`
 	EnableEnvOverride            bool    `toml:"enable_env_override" env:"ENABLE_ENV_OVERRIDE"`
 	SpeakingRate                 float64 `toml:"speaking_rate" env:"SPEAKING_RATE"`
 	RequireConfirm               bool    `toml:"require_confirm" env:"REQUIRE_CONFIRM"`
 	SaveAudioData                bool    `toml:"save_audio_data" env:"SAVE_AUDIO_DATA"`
+	FeedMaxFailures              int     `toml:"feed_max_failures" env:"FEED_MAX_FAILURES"` // Disable a feed after this many failed fetches in a row, 0 never disables
 	VoiceVox                     *VoiceVox
 	Prompt                       *Prompt
 	Cloudflare                   *Cloudflare
 	Podcast                      *Podcast
-	SourcePath                   string `toml:"-" env:"-"`
+	Loudness                     *Loudness
+	Mail                         *Mail
+	Dedup                        *Dedup
+	Polling                      *Polling
+	HTTP                         *HTTP
+	Crawl                        *Crawl
+	Transcript                   *Transcript
+	WebSub                       *WebSub
+	Retention                    *Retention
+	Feeds                        []*FeedHTTP `toml:"feeds" env:"-"`
+	Webhooks                     []*Webhook  `toml:"webhooks" env:"-"`
+	Rules                        []*Rule     `toml:"rules" env:"-"`
+	SourcePath                   string      `toml:"-" env:"-"`
 }
 
 type Podcast struct {
@@ -34,6 +52,146 @@ type Podcast struct {
 	ChannelDesc  string `toml:"channel_desc" env:"PODCAST_CHANNEL_DESC"`
 	Author       string `toml:"author" env:"PODCAST_AUTHOR"`
 	PublishURL   string `toml:"publish_url" env:"PODCAST_PUBLISH_URL"`
+	Combined     bool   `toml:"combined" env:"PODCAST_COMBINED"` // Publish one daily episode across all feeds
+
+	// Channel metadata
+	ImageURL   string `toml:"image_url" env:"PODCAST_IMAGE_URL"` // Channel artwork (itunes:image)
+	OwnerEmail string `toml:"owner_email" env:"PODCAST_OWNER_EMAIL"`
+	Language   string `toml:"language" env:"PODCAST_LANGUAGE"` // default: ja
+	Category   string `toml:"category" env:"PODCAST_CATEGORY"` // default: Technology
+	Explicit   bool   `toml:"explicit" env:"PODCAST_EXPLICIT"`
+
+	// Episode retention, 0 keeps every episode
+	RetentionDays  int `toml:"retention_days" env:"PODCAST_RETENTION_DAYS"`   // Drop episodes older than this
+	RetentionCount int `toml:"retention_count" env:"PODCAST_RETENTION_COUNT"` // Keep at most this many episodes
+
+	// Episode framing
+	IntroFile      string  `toml:"intro_file" env:"PODCAST_INTRO_FILE"`
+	OutroFile      string  `toml:"outro_file" env:"PODCAST_OUTRO_FILE"`
+	SpokenHeader   bool    `toml:"spoken_header" env:"PODCAST_SPOKEN_HEADER"`
+	HeaderTemplate string  `toml:"header_template" env:"PODCAST_HEADER_TEMPLATE"` // {date}, {feed} and {count} are replaced
+	Separator      string  `toml:"separator" env:"PODCAST_SEPARATOR"`             // silence, chime or an audio file path
+	SeparatorSec   float64 `toml:"separator_sec" env:"PODCAST_SEPARATOR_SEC"`
+}
+
+// Loudness enables EBU R128 loudness normalization of stored audio files and merged episodes.
+type Loudness struct {
+	TargetLUFS float64 `toml:"target_lufs" env:"LOUDNESS_TARGET_LUFS"` // Integrated loudness target (default: -16)
+	TruePeak   float64 `toml:"true_peak" env:"LOUDNESS_TRUE_PEAK"`     // Maximum peak in dBTP (default: -1.5)
+	LRA        float64 `toml:"lra" env:"LOUDNESS_LRA"`                 // Loudness range target, used by ffmpeg only (default: 11)
+}
+
+// Mail configures the SMTP server and recipients of the mail digest.
+type Mail struct {
+	Host     string   `toml:"host" env:"MAIL_HOST"`
+	Port     int      `toml:"port" env:"MAIL_PORT"` // default: 587
+	Username string   `toml:"username" env:"MAIL_USERNAME"`
+	Password string   `toml:"password" env:"MAIL_PASSWORD"`
+	StartTLS bool     `toml:"starttls" env:"MAIL_STARTTLS"`
+	From     string   `toml:"from" env:"MAIL_FROM"`
+	To       []string `toml:"to" env:"MAIL_TO"`
+	Subject  string   `toml:"subject" env:"MAIL_SUBJECT"` // {date} and {count} are replaced
+
+	// Audio of the summaries, attached when AttachAudio is set or linked below AudioBaseURL
+	AttachAudio  bool   `toml:"attach_audio" env:"MAIL_ATTACH_AUDIO"`
+	AudioBaseURL string `toml:"audio_base_url" env:"MAIL_AUDIO_BASE_URL"`
+}
+
+// Dedup enables the detection of the same story arriving from several feeds. URL
+// canonicalization is always applied.
+type Dedup struct {
+	ResolveRedirects bool    `toml:"resolve_redirects" env:"DEDUP_RESOLVE_REDIRECTS"` // Follow the redirects of item links
+	TitleThreshold   float64 `toml:"title_threshold" env:"DEDUP_TITLE_THRESHOLD"`     // Title similarity from 0 to 1 (default: 0.85)
+	WindowHours      int     `toml:"window_hours" env:"DEDUP_WINDOW_HOURS"`           // Compare titles with the articles of the last hours (default: 48)
+}
+
+// Polling bounds the interval between two polls of a feed, which is computed from how often
+// the feed posts and from the hints of its publisher.
+type Polling struct {
+	MinInterval time.Duration `toml:"min_interval" env:"POLLING_MIN_INTERVAL"` // default: 15m
+	MaxInterval time.Duration `toml:"max_interval" env:"POLLING_MAX_INTERVAL"` // default: 24h
+}
+
+// HTTP holds the defaults of the requests for feeds, pages and articles.
+type HTTP struct {
+	UserAgent string `toml:"user_agent" env:"HTTP_USER_AGENT"`
+	Proxy     string `toml:"proxy" env:"HTTP_PROXY_URL"` // e.g. http://proxy.example.com:3128; HTTP_PROXY and HTTPS_PROXY are used when empty
+}
+
+// Crawl limits the rate of the requests sent to each host, for feeds and pages alike, and
+// makes page scraping respect robots.txt.
+type Crawl struct {
+	RequestsPerSecond float64            `toml:"requests_per_second" env:"CRAWL_REQUESTS_PER_SECOND"` // Per host (default: 1)
+	Burst             int                `toml:"burst" env:"CRAWL_BURST"`                             // Requests sent at once before waiting (default: 3)
+	Hosts             map[string]float64 `toml:"hosts" env:"-"`                                       // Requests per second of specific hosts
+	IgnoreRobotsTxt   bool               `toml:"ignore_robots_txt" env:"CRAWL_IGNORE_ROBOTS_TXT"`
+}
+
+// Transcript makes podcast and video episodes summarized from a transcript of their
+// media instead of their web page: the transcript published with the episode
+// (podcast:transcript), or the output of a speech-to-text command.
+type Transcript struct {
+	Command   string        `toml:"command" env:"TRANSCRIPT_COMMAND"`         // Shell command printing the transcript, {file} is replaced with the downloaded media
+	Timeout   time.Duration `toml:"timeout" env:"TRANSCRIPT_TIMEOUT"`         // Of the download and the command (default: 30m)
+	MaxSizeMB int64         `toml:"max_size_mb" env:"TRANSCRIPT_MAX_SIZE_MB"` // Larger media are not downloaded (default: 500)
+}
+
+// WebSub subscribes to the hubs advertised by feeds, which push new items as soon as they
+// are published, while quicknews runs in a long-running mode (read, fetch --interval).
+type WebSub struct {
+	Listen      string        `toml:"listen" env:"WEBSUB_LISTEN"`             // Address of the callback server (default: :8585)
+	CallbackURL string        `toml:"callback_url" env:"WEBSUB_CALLBACK_URL"` // Public URL of the callback server, reachable by the hubs
+	Lease       time.Duration `toml:"lease" env:"WEBSUB_LEASE"`               // Requested lease, the hub's default when 0
+}
+
+// Retention selects the old articles deleted by the prune command and the automatic pruning.
+type Retention struct {
+	MaxAgeDays int  `toml:"max_age_days" env:"RETENTION_MAX_AGE_DAYS"` // Prune articles added more than this many days ago, 0 keeps them
+	MaxPerFeed int  `toml:"max_per_feed" env:"RETENTION_MAX_PER_FEED"` // Keep the newest articles of each feed, 0 for no limit
+	KeepUnread bool `toml:"keep_unread" env:"RETENTION_KEEP_UNREAD"`   // Never prune articles with an unread summary
+	Auto       bool `toml:"auto" env:"RETENTION_AUTO"`                 // Prune once a day while fetching in the background
+}
+
+// FeedHTTP configures the requests for the URLs starting with URL, such as a feed and the
+// pages of its site. The most specific entry applies. Secrets are given as env:NAME to
+// read an environment variable or cmd:COMMAND to run a shell command and use its output.
+type FeedHTTP struct {
+	URL       string            `toml:"url"`
+	Username  string            `toml:"username"` // HTTP basic auth
+	Password  string            `toml:"password"` // Secret
+	Token     string            `toml:"token"`    // Secret sent as a bearer token
+	Cookie    string            `toml:"cookie"`   // Secret Cookie header
+	Headers   map[string]string `toml:"headers"`
+	UserAgent string            `toml:"user_agent"`
+	Proxy     string            `toml:"proxy"`
+}
+
+// Webhook is an HTTP endpoint notified of new summaries and published episodes.
+type Webhook struct {
+	Name         string            `toml:"name"`
+	URL          string            `toml:"url"`
+	Events       []string          `toml:"events"`       // created, bookmarked, published; empty for every event
+	Secret       string            `toml:"secret"`       // Signs the body with HMAC-SHA256 in X-Quicknews-Signature
+	Template     string            `toml:"template"`     // text/template of the body; the JSON payload when empty
+	ContentType  string            `toml:"content_type"` // default: application/json
+	Headers      map[string]string `toml:"headers"`
+	AudioBaseURL string            `toml:"audio_base_url"` // Links the audio file of a summary
+	MaxRetries   int               `toml:"max_retries"`    // default: 3
+}
+
+// Rule matches incoming feed items before they are summarized. Every condition that is set
+// must match; a rule without conditions matches every item.
+type Rule struct {
+	Name        string   `toml:"name"`
+	Feed        string   `toml:"feed"`        // Feed title or URL
+	Title       string   `toml:"title"`       // Regular expression
+	Description string   `toml:"description"` // Regular expression
+	Author      string   `toml:"author"`      // Regular expression
+	Keywords    []string `toml:"keywords"`    // Any of them in the title, description or categories, ignoring case
+	Actions     []string `toml:"actions"`     // skip, mark-read, bookmark, tag, priority, prompt
+	Tags        []string `toml:"tags"`        // Added by the tag action
+	Priority    int      `toml:"priority"`    // Set by the priority action (default: 1)
+	Prompt      string   `toml:"prompt"`      // Summary prompt of the prompt action, %s is replaced with the URL
 }
 
 type Cloudflare struct {
@@ -67,6 +225,87 @@ func LoadConfig(path string) (*Config, error) {
 	if config.SpeakingRate == 0 {
 		config.SpeakingRate = 1.3
 	}
+	if config.Podcast != nil {
+		if config.Podcast.HeaderTemplate == "" {
+			config.Podcast.HeaderTemplate = "{date}、{feed}、{count}件の記事です。"
+		}
+		if config.Podcast.SeparatorSec == 0 {
+			config.Podcast.SeparatorSec = 1
+		}
+	}
+	if config.Loudness != nil {
+		if config.Loudness.TargetLUFS == 0 {
+			config.Loudness.TargetLUFS = -16
+		}
+		if config.Loudness.TruePeak == 0 {
+			config.Loudness.TruePeak = -1.5
+		}
+		if config.Loudness.LRA == 0 {
+			config.Loudness.LRA = 11
+		}
+	}
+	if config.Mail != nil {
+		if config.Mail.Port == 0 {
+			config.Mail.Port = 587
+		}
+		if config.Mail.Subject == "" {
+			config.Mail.Subject = "quicknews {date} ({count})"
+		}
+	}
+	if config.Dedup != nil {
+		if config.Dedup.TitleThreshold == 0 {
+			config.Dedup.TitleThreshold = 0.85
+		}
+		if config.Dedup.WindowHours == 0 {
+			config.Dedup.WindowHours = 48
+		}
+	}
+	if config.Polling != nil {
+		if config.Polling.MinInterval == 0 {
+			config.Polling.MinInterval = 15 * time.Minute
+		}
+		if config.Polling.MaxInterval == 0 {
+			config.Polling.MaxInterval = 24 * time.Hour
+		}
+	}
+	if config.Crawl != nil {
+		if config.Crawl.RequestsPerSecond == 0 {
+			config.Crawl.RequestsPerSecond = 1
+		}
+		if config.Crawl.Burst == 0 {
+			config.Crawl.Burst = 3
+		}
+	}
+	if config.Transcript != nil {
+		if config.Transcript.Timeout == 0 {
+			config.Transcript.Timeout = 30 * time.Minute
+		}
+		if config.Transcript.MaxSizeMB == 0 {
+			config.Transcript.MaxSizeMB = 500
+		}
+	}
+	if config.WebSub != nil && config.WebSub.Listen == "" {
+		config.WebSub.Listen = ":8585"
+	}
+	for i, w := range config.Webhooks {
+		if w.Name == "" {
+			w.Name = fmt.Sprintf("webhook%d", i+1)
+		}
+		if w.ContentType == "" {
+			w.ContentType = "application/json"
+		}
+		if w.MaxRetries == 0 {
+			w.MaxRetries = 3
+		}
+	}
+	for i, r := range config.Rules {
+		if r.Name == "" {
+			r.Name = fmt.Sprintf("rule%d", i+1)
+		}
+		if r.Priority == 0 {
+			r.Priority = 1
+		}
+	}
 	if config.DB == "" {
 		home, err := os.UserHomeDir()
 		if err != nil {
@@ -4,6 +4,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/BurntSushi/toml"
 	"github.com/stretchr/testify/assert"
@@ -82,3 +83,74 @@ func TestLoadConfig_WithInvalidPath(t *testing.T) {
 	_, err := LoadConfig("/non/existent/path.toml")
 	assert.Error(t, err)
 }
+
+func TestLoadConfig_Webhooks(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.toml")
+	data := `
+db = "test.db"
+
+[[webhooks]]
+url = "https://example.com/hook"
+events = ["created"]
+template = '{"text": {{json .Summary.Title}}}'
+
+[[webhooks]]
+name = "signed"
+url = "https://example.com/signed"
+secret = "s3cret"
+max_retries = 5
+headers = { Authorization = "Bearer token" }
+`
+	require.NoError(t, os.WriteFile(configPath, []byte(data), 0644))
+
+	loadedConfig, err := LoadConfig(configPath)
+	require.NoError(t, err)
+	require.Len(t, loadedConfig.Webhooks, 2)
+
+	first := loadedConfig.Webhooks[0]
+	assert.Equal(t, "webhook1", first.Name)
+	assert.Equal(t, []string{"created"}, first.Events)
+	assert.Equal(t, `{"text": {{json .Summary.Title}}}`, first.Template)
+	assert.Equal(t, "application/json", first.ContentType)
+	assert.Equal(t, 3, first.MaxRetries)
+
+	second := loadedConfig.Webhooks[1]
+	assert.Equal(t, "signed", second.Name)
+	assert.Equal(t, 5, second.MaxRetries)
+	assert.Equal(t, "Bearer token", second.Headers["Authorization"])
+}
+
+func TestLoadConfig_Polling(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.toml")
+	data := `
+db = "test.db"
+
+[polling]
+min_interval = "30m"
+`
+	require.NoError(t, os.WriteFile(configPath, []byte(data), 0644))
+
+	loadedConfig, err := LoadConfig(configPath)
+	require.NoError(t, err)
+	require.NotNil(t, loadedConfig.Polling)
+	assert.Equal(t, 30*time.Minute, loadedConfig.Polling.MinInterval)
+	assert.Equal(t, 24*time.Hour, loadedConfig.Polling.MaxInterval)
+}
+
+func TestLoadConfig_Transcript(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.toml")
+	data := `
+db = "test.db"
+
+[transcript]
+command = "whisper {file}"
+`
+	require.NoError(t, os.WriteFile(configPath, []byte(data), 0644))
+
+	loadedConfig, err := LoadConfig(configPath)
+	require.NoError(t, err)
+	require.NotNil(t, loadedConfig.Transcript)
+	assert.Equal(t, "whisper {file}", loadedConfig.Transcript.Command)
+	assert.Equal(t, 30*time.Minute, loadedConfig.Transcript.Timeout)
+	assert.Equal(t, int64(500), loadedConfig.Transcript.MaxSizeMB)
+}
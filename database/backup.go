@@ -0,0 +1,185 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"slices"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql/schema"
+	"github.com/cockroachdb/errors"
+	"github.com/mattn/go-sqlite3"
+	"github.com/mopemope/quicknews/ent/migrate"
+)
+
+// Snapshot writes a consistent copy of the database to dest with VACUUM INTO. Other
+// connections and processes can keep using the database meanwhile.
+func Snapshot(ctx context.Context, path, dest string) error {
+	db, err := sql.Open("sqlite3", path)
+	if err != nil {
+		return errors.Wrap(err, "failed to open database")
+	}
+	defer func() {
+		_ = db.Close()
+	}()
+	if _, err := db.ExecContext(ctx, "VACUUM INTO ?", dest); err != nil {
+		return errors.Wrap(err, "failed to snapshot database")
+	}
+	return nil
+}
+
+// Restore replaces the content of the database with the database file src through the
+// SQLite online backup API, so connections already open see the restored content. It
+// waits while other connections hold a lock on the database.
+func Restore(ctx context.Context, src, path string) error {
+	srcDB, err := sql.Open("sqlite3", "file:"+src+"?mode=ro")
+	if err != nil {
+		return errors.Wrap(err, "failed to open backup")
+	}
+	defer func() {
+		_ = srcDB.Close()
+	}()
+	destDB, err := sql.Open("sqlite3", path)
+	if err != nil {
+		return errors.Wrap(err, "failed to open database")
+	}
+	defer func() {
+		_ = destDB.Close()
+	}()
+
+	srcConn, err := srcDB.Conn(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to open backup")
+	}
+	defer func() {
+		_ = srcConn.Close()
+	}()
+	destConn, err := destDB.Conn(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to open database")
+	}
+	defer func() {
+		_ = destConn.Close()
+	}()
+
+	return destConn.Raw(func(dest any) error {
+		return srcConn.Raw(func(src any) error {
+			backup, err := dest.(*sqlite3.SQLiteConn).Backup("main", src.(*sqlite3.SQLiteConn), "main")
+			if err != nil {
+				return errors.Wrap(err, "failed to start restore")
+			}
+			for {
+				done, err := backup.Step(-1)
+				if err != nil {
+					_ = backup.Close()
+					return errors.Wrap(err, "failed to restore database")
+				}
+				if done {
+					break
+				}
+				// The database is locked by another connection
+				select {
+				case <-ctx.Done():
+					_ = backup.Close()
+					return ctx.Err()
+				case <-time.After(100 * time.Millisecond):
+				}
+			}
+			if err := backup.Finish(); err != nil {
+				return errors.Wrap(err, "failed to restore database")
+			}
+			return nil
+		})
+	})
+}
+
+// CheckSchema checks that the database file at path can be used by this version. The
+// database must pass an integrity check and hold the tables of quicknews; tables and
+// columns unknown to this version mean it was written by a newer one. It returns the
+// tables and columns of this version the database lacks, which the auto migration adds.
+func CheckSchema(ctx context.Context, path string) ([]string, error) {
+	db, err := sql.Open("sqlite3", "file:"+path+"?mode=ro")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open database")
+	}
+	defer func() {
+		_ = db.Close()
+	}()
+
+	var result string
+	if err := db.QueryRowContext(ctx, "PRAGMA quick_check").Scan(&result); err != nil {
+		return nil, errors.Wrap(err, "failed to check database")
+	}
+	if result != "ok" {
+		return nil, errors.Newf("database is corrupted: %s", result)
+	}
+
+	tables, err := queryStrings(ctx, db, "SELECT name FROM sqlite_master WHERE type = 'table' AND name NOT LIKE 'sqlite_%'")
+	if err != nil {
+		return nil, err
+	}
+	known := make(map[string]bool, len(migrate.Tables))
+	for _, t := range migrate.Tables {
+		known[t.Name] = true
+	}
+	var unknown []string
+	for _, name := range tables {
+		if !known[name] {
+			unknown = append(unknown, name)
+		}
+	}
+	for _, required := range []string{migrate.FeedsTable.Name, migrate.ArticlesTable.Name, migrate.SummariesTable.Name} {
+		if !slices.Contains(tables, required) {
+			return nil, errors.Newf("not a quicknews database: no %s table", required)
+		}
+	}
+
+	var missing []string
+	for _, t := range migrate.Tables {
+		if !slices.Contains(tables, t.Name) {
+			missing = append(missing, t.Name)
+			continue
+		}
+		columns, err := queryStrings(ctx, db, "SELECT name FROM pragma_table_info(?)", t.Name)
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range t.Columns {
+			if !slices.Contains(columns, c.Name) {
+				missing = append(missing, t.Name+"."+c.Name)
+			}
+		}
+		for _, name := range columns {
+			if !slices.ContainsFunc(t.Columns, func(c *schema.Column) bool { return c.Name == name }) {
+				unknown = append(unknown, t.Name+"."+name)
+			}
+		}
+	}
+	if len(unknown) > 0 {
+		return nil, errors.Newf("database was written by a newer version: unknown %s", strings.Join(unknown, ", "))
+	}
+	return missing, nil
+}
+
+func queryStrings(ctx context.Context, db *sql.DB, query string, args ...any) ([]string, error) {
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read schema")
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+	var values []string
+	for rows.Next() {
+		var v string
+		if err := rows.Scan(&v); err != nil {
+			return nil, errors.Wrap(err, "failed to read schema")
+		}
+		values = append(values, v)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to read schema")
+	}
+	return values, nil
+}
@@ -0,0 +1,95 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"github.com/mopemope/quicknews/ent"
+	"github.com/mopemope/quicknews/ent/enttest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func openFile(t *testing.T, path string) *ent.Client {
+	return enttest.Open(t, dialect.SQLite, "file:"+path+"?cache=shared&_fk=1")
+}
+
+func createFeed(t *testing.T, client *ent.Client, url string) {
+	_, err := client.Feed.Create().
+		SetURL(url).
+		SetTitle(url).
+		SetUpdatedAt(time.Now()).
+		Save(context.Background())
+	require.NoError(t, err)
+}
+
+func feedURLs(t *testing.T, client *ent.Client) []string {
+	feeds, err := client.Feed.Query().All(context.Background())
+	require.NoError(t, err)
+	var urls []string
+	for _, f := range feeds {
+		urls = append(urls, f.URL)
+	}
+	return urls
+}
+
+func TestSnapshotAndRestore(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	path := filepath.Join(dir, "quicknews.db")
+	client := openFile(t, path)
+	defer func() { _ = client.Close() }()
+	createFeed(t, client, "https://example.com/feed")
+
+	snapshot := filepath.Join(dir, "snapshot.db")
+	require.NoError(t, Snapshot(ctx, path, snapshot))
+	missing, err := CheckSchema(ctx, snapshot)
+	require.NoError(t, err)
+	assert.Empty(t, missing)
+
+	createFeed(t, client, "https://example.com/other")
+	require.NoError(t, Restore(ctx, snapshot, path))
+	assert.Equal(t, []string{"https://example.com/feed"}, feedURLs(t, client), "the open client sees the restored content")
+
+	require.NoError(t, Vacuum(ctx, path))
+}
+
+func TestCheckSchema(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	path := filepath.Join(dir, "quicknews.db")
+	client := openFile(t, path)
+	require.NoError(t, client.Close())
+
+	exec := func(query string) {
+		db, err := sql.Open("sqlite3", path)
+		require.NoError(t, err)
+		defer func() { _ = db.Close() }()
+		_, err = db.Exec(query)
+		require.NoError(t, err)
+	}
+
+	// An older version lacks columns, which the migration adds
+	exec("ALTER TABLE feeds DROP COLUMN hub_url")
+	missing, err := CheckSchema(ctx, path)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"feeds.hub_url"}, missing)
+
+	// A newer version has columns unknown to this one
+	exec("ALTER TABLE feeds ADD COLUMN from_the_future TEXT")
+	_, err = CheckSchema(ctx, path)
+	assert.ErrorContains(t, err, "feeds.from_the_future")
+
+	other := filepath.Join(dir, "other.db")
+	db, err := sql.Open("sqlite3", other)
+	require.NoError(t, err)
+	_, err = db.Exec("CREATE TABLE notes (id INTEGER PRIMARY KEY)")
+	require.NoError(t, err)
+	require.NoError(t, db.Close())
+	_, err = CheckSchema(ctx, other)
+	assert.ErrorContains(t, err, "not a quicknews database")
+}
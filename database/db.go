@@ -2,6 +2,7 @@ package database
 
 import (
 	"context"
+	"database/sql"
 	"log/slog"
 
 	"github.com/cockroachdb/errors"
@@ -32,3 +33,19 @@ func WithTx(ctx context.Context, client *ent.Client, fn func(tx *ent.Tx) error)
 	}
 	return nil
 }
+
+// Vacuum rebuilds the SQLite database file to give the space of deleted rows back to the
+// file system.
+func Vacuum(ctx context.Context, path string) error {
+	db, err := sql.Open("sqlite3", path)
+	if err != nil {
+		return errors.Wrap(err, "failed to open database")
+	}
+	defer func() {
+		_ = db.Close()
+	}()
+	if _, err := db.ExecContext(ctx, "VACUUM"); err != nil {
+		return errors.Wrap(err, "failed to vacuum database")
+	}
+	return nil
+}
@@ -0,0 +1,118 @@
+// Package dedup detects the same story arriving from several feeds under different URLs
+// or with slightly different titles.
+package dedup
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+)
+
+// trackingParams are query parameters that only identify the referrer of a link.
+var trackingParams = []string{
+	"fbclid", "gclid", "dclid", "msclkid", "yclid", "igshid",
+	"mc_cid", "mc_eid", "_hsenc", "_hsmi", "mkt_tok",
+	"ref_src", "ref_url", "spm", "cmpid", "ncid", "sr_share",
+}
+
+// Canonicalize normalizes an article URL so that the copies of a story linked from
+// different aggregators compare equal: the fragment, tracking parameters (utm_* and
+// others) and AMP variants are removed, the scheme and host are lowercased and the
+// remaining query parameters are sorted. A URL that cannot be parsed is returned as is.
+func Canonicalize(raw string) string {
+	u, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil || u.Host == "" {
+		return raw
+	}
+	u = ampOrigin(u)
+
+	u.Scheme = strings.ToLower(u.Scheme)
+	u.Host = strings.ToLower(u.Host)
+	if port := u.Port(); (u.Scheme == "http" && port == "80") || (u.Scheme == "https" && port == "443") {
+		u.Host = u.Hostname()
+	}
+	u.Host = strings.TrimPrefix(u.Host, "amp.")
+	u.Fragment = ""
+	u.RawFragment = ""
+
+	q := u.Query()
+	for key := range q {
+		lower := strings.ToLower(key)
+		if strings.HasPrefix(lower, "utm_") || slices.Contains(trackingParams, lower) {
+			q.Del(key)
+		}
+	}
+	// AMP switches
+	if v := q["amp"]; len(v) > 0 && (v[0] == "" || v[0] == "1" || v[0] == "true") {
+		q.Del("amp")
+	}
+	if strings.EqualFold(q.Get("outputType"), "amp") {
+		q.Del("outputType")
+	}
+	u.RawQuery = q.Encode() // sorted by key
+
+	p := u.Path
+	switch {
+	case strings.HasSuffix(p, "/amp/"), strings.HasSuffix(p, "/amp"):
+		p = strings.TrimSuffix(strings.TrimSuffix(p, "/"), "/amp")
+	case strings.HasSuffix(p, ".amp.html"):
+		p = strings.TrimSuffix(p, ".amp.html") + ".html"
+	case strings.HasSuffix(p, ".amp"):
+		p = strings.TrimSuffix(p, ".amp")
+	}
+	if p != u.Path {
+		u.Path, u.RawPath = p, ""
+	}
+	return u.String()
+}
+
+// ampOrigin returns the original URL of a page served from the Google AMP cache,
+// e.g. https://example-com.cdn.ampproject.org/c/s/example.com/story.
+func ampOrigin(u *url.URL) *url.URL {
+	if !strings.HasSuffix(u.Hostname(), ".cdn.ampproject.org") {
+		return u
+	}
+	rest := strings.TrimPrefix(u.Path, "/")
+	// Content type (c, i, r) and an optional "s" for https.
+	kind, rest, ok := strings.Cut(rest, "/")
+	if !ok || len(kind) != 1 {
+		return u
+	}
+	scheme := "http"
+	if s, r, ok := strings.Cut(rest, "/"); ok && s == "s" {
+		scheme, rest = "https", r
+	}
+	origin, err := url.Parse(scheme + "://" + path.Clean("/" + rest)[1:])
+	if err != nil || origin.Host == "" {
+		return u
+	}
+	origin.RawQuery = u.RawQuery
+	return origin
+}
+
+// ResolveRedirects follows the redirects of a URL, such as the links of aggregators and
+// URL shorteners, and returns the final URL.
+func ResolveRedirects(ctx context.Context, client *http.Client, raw string) (string, error) {
+	for _, method := range []string{http.MethodHead, http.MethodGet} {
+		req, err := http.NewRequestWithContext(ctx, method, raw, nil)
+		if err != nil {
+			return raw, errors.Wrap(err, "failed to create request")
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			return raw, errors.Wrapf(err, "failed to resolve %s", raw)
+		}
+		_ = resp.Body.Close()
+		// Some servers do not support HEAD; retry with GET.
+		if resp.StatusCode == http.StatusMethodNotAllowed || resp.StatusCode == http.StatusNotImplemented {
+			continue
+		}
+		return resp.Request.URL.String(), nil
+	}
+	return raw, nil
+}
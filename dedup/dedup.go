@@ -0,0 +1,139 @@
+package dedup
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/mopemope/quicknews/clock"
+	"github.com/mopemope/quicknews/config"
+	"github.com/mopemope/quicknews/ent"
+	"github.com/mopemope/quicknews/httpclient"
+	"github.com/mopemope/quicknews/models/article"
+)
+
+// Detector finds the article a new feed item duplicates.
+type Detector struct {
+	articleRepos article.ArticleRepository
+	config       *config.Dedup // nil disables redirect resolution and title matching
+	httpConfig   *config.Config
+}
+
+func NewDetector(articleRepos article.ArticleRepository, config *config.Config) *Detector {
+	return &Detector{
+		articleRepos: articleRepos,
+		config:       config.Dedup,
+		httpConfig:   config,
+	}
+}
+
+// CanonicalURL returns the canonical URL of an item link, following its redirects first
+// when resolve_redirects is enabled. The requests use the proxy, credentials and rate limit
+// configured for the link.
+func (d *Detector) CanonicalURL(ctx context.Context, link string) string {
+	if d.config != nil && d.config.ResolveRedirects {
+		if resolved, err := d.resolve(ctx, link); err != nil {
+			slog.Warn("Failed to resolve redirects", "link", link, "error", err)
+		} else {
+			link = resolved
+		}
+	}
+	return Canonicalize(link)
+}
+
+func (d *Detector) resolve(ctx context.Context, link string) (string, error) {
+	settings, err := httpclient.New(d.httpConfig, link)
+	if err != nil {
+		return link, err
+	}
+	return ResolveRedirects(ctx, settings.Client(10*time.Second), link)
+}
+
+// FindByTitle returns the recent article of another feed whose title is the most similar
+// to the title of an item of the feed, when the similarity reaches the threshold, or nil.
+// Articles with a summary are preferred. Titles within a feed are not compared, as numbered
+// posts such as "Weekly update 123" and "Weekly update 124" look alike.
+func (d *Detector) FindByTitle(ctx context.Context, feed *ent.Feed, title string) (*ent.Article, error) {
+	if d.config == nil || d.config.TitleThreshold <= 0 {
+		return nil, nil
+	}
+	since := clock.Now().Add(-time.Duration(d.config.WindowHours) * time.Hour)
+	recent, err := d.articleRepos.GetRecent(ctx, since)
+	if err != nil {
+		return nil, err
+	}
+
+	var best *ent.Article
+	bestScore := 0.0
+	for _, a := range recent {
+		if a.Edges.Feed != nil && a.Edges.Feed.ID == feed.ID {
+			continue
+		}
+		score := Similarity(title, a.Title)
+		if score < d.config.TitleThreshold {
+			continue
+		}
+		if best == nil || better(a, score, best, bestScore) {
+			best, bestScore = a, score
+		}
+	}
+	if best != nil {
+		slog.Debug("Found similar title", "title", title, "original", best.Title, "similarity", bestScore)
+	}
+	return best, nil
+}
+
+// better reports whether the article a with the similarity score is a better original
+// than b: one with a summary wins, then the most similar one.
+func better(a *ent.Article, score float64, b *ent.Article, bScore float64) bool {
+	if summarized := a.Edges.Summary != nil; summarized != (b.Edges.Summary != nil) {
+		return summarized
+	}
+	return score > bScore
+}
+
+// Similarity returns the Sørensen–Dice coefficient of the character bigrams of two
+// normalized titles, from 0 to 1. Character bigrams work for titles in languages written
+// without spaces as well.
+func Similarity(a, b string) float64 {
+	ba, bb := bigrams(normalize(a)), bigrams(normalize(b))
+	if len(ba) == 0 || len(bb) == 0 {
+		return 0
+	}
+	counts := make(map[string]int, len(ba))
+	for _, g := range ba {
+		counts[g]++
+	}
+	shared := 0
+	for _, g := range bb {
+		if counts[g] > 0 {
+			counts[g]--
+			shared++
+		}
+	}
+	return 2 * float64(shared) / float64(len(ba)+len(bb))
+}
+
+// normalize lowercases a title and drops punctuation, symbols and spaces.
+func normalize(s string) []rune {
+	var runes []rune
+	for _, r := range strings.ToLower(s) {
+		if unicode.IsLetter(r) || unicode.IsNumber(r) {
+			runes = append(runes, r)
+		}
+	}
+	return runes
+}
+
+func bigrams(runes []rune) []string {
+	if len(runes) == 1 {
+		return []string{string(runes)}
+	}
+	grams := make([]string, 0, len(runes))
+	for i := 0; i+1 < len(runes); i++ {
+		grams = append(grams, string(runes[i:i+2]))
+	}
+	return grams
+}
@@ -0,0 +1,179 @@
+package dedup
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/mopemope/quicknews/config"
+	"github.com/mopemope/quicknews/ent"
+	"github.com/mopemope/quicknews/ent/enttest"
+	"github.com/mopemope/quicknews/models/article"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCanonicalize(t *testing.T) {
+	tests := []struct {
+		name string
+		raw  string
+		want string
+	}{
+		{"tracking params", "https://example.com/story?utm_source=rss&utm_medium=feed&id=1&fbclid=abc", "https://example.com/story?id=1"},
+		{"fragment", "https://example.com/story#comments", "https://example.com/story"},
+		{"case and default port", "HTTPS://Example.COM:443/Story", "https://example.com/Story"},
+		{"sorted query", "https://example.com/s?b=2&a=1", "https://example.com/s?a=1&b=2"},
+		{"amp path", "https://example.com/news/story/amp/", "https://example.com/news/story"},
+		{"amp html", "https://example.com/news/story.amp.html", "https://example.com/news/story.html"},
+		{"amp host", "https://amp.example.com/story", "https://example.com/story"},
+		{"amp query", "https://example.com/story?amp=1", "https://example.com/story"},
+		{"amp cache", "https://example-com.cdn.ampproject.org/c/s/example.com/story/amp", "https://example.com/story"},
+		{"unchanged", "https://example.com/story/", "https://example.com/story/"},
+		{"not a url", "not a url", "not a url"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Canonicalize(tt.raw))
+		})
+	}
+}
+
+func TestResolveRedirects(t *testing.T) {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/short":
+			http.Redirect(w, r, server.URL+"/story?utm_source=short", http.StatusMovedPermanently)
+		case "/story":
+			if r.Method == http.MethodHead {
+				w.WriteHeader(http.StatusMethodNotAllowed)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		}
+	}))
+	defer server.Close()
+
+	resolved, err := ResolveRedirects(context.Background(), server.Client(), server.URL+"/short")
+	require.NoError(t, err)
+	assert.Equal(t, server.URL+"/story?utm_source=short", resolved)
+	assert.Equal(t, server.URL+"/story", Canonicalize(resolved))
+}
+
+func TestDetector_CanonicalURL(t *testing.T) {
+	var server *httptest.Server
+	agents := make(chan string, 2)
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		agents <- r.UserAgent()
+		if r.URL.Path == "/short" {
+			http.Redirect(w, r, server.URL+"/story?utm_source=short", http.StatusMovedPermanently)
+		}
+	}))
+	defer server.Close()
+
+	d := NewDetector(nil, &config.Config{
+		HTTP:  &config.HTTP{UserAgent: "quicknews-test"},
+		Dedup: &config.Dedup{ResolveRedirects: true},
+	})
+	assert.Equal(t, server.URL+"/story", d.CanonicalURL(context.Background(), server.URL+"/short"))
+	assert.Equal(t, "quicknews-test", <-agents, "the configured settings apply")
+	assert.Equal(t, "quicknews-test", <-agents)
+}
+
+func TestSimilarity(t *testing.T) {
+	assert.InDelta(t, 1.0, Similarity("Go 1.25 is released", "Go 1.25 is released!"), 0.001)
+	assert.InDelta(t, 1.0, Similarity("GO 1.25 IS RELEASED", "go 1.25 is released"), 0.001)
+	assert.Greater(t, Similarity("Apple announces new MacBook Pro with M5 chip", "Apple announces the new MacBook Pro with M5 chip"), 0.85)
+	// Related but not the same wording stays below the default threshold.
+	assert.Less(t, Similarity("Go 1.25 is released", "Go 1.25 has been released"), 0.85)
+	assert.Less(t, Similarity("Go 1.25 is released", "Rust 2024 edition announced"), 0.3)
+	assert.Greater(t, Similarity("新しいGoのバージョンが公開された", "新しいGoのバージョンが公開されました"), 0.85)
+	assert.Zero(t, Similarity("", "Go"))
+}
+
+func TestDetector_FindByTitle(t *testing.T) {
+	client := enttest.Open(t, dialect.SQLite, "file:dedup?mode=memory&cache=shared&_fk=1")
+	defer func() { _ = client.Close() }()
+	ctx := context.Background()
+
+	feed, err := client.Feed.Create().
+		SetURL("https://example.com/feed").
+		SetTitle("Test Feed").
+		SetLink("https://example.com").
+		SetUpdatedAt(time.Now()).
+		Save(ctx)
+	require.NoError(t, err)
+
+	repos := article.NewRepository(client)
+	orig := &ent.Article{Title: "Go 1.25 is released", URL: "https://example.com/go125", PublishedAt: time.Now()}
+	orig.Edges.Feed = feed
+	orig, err = repos.Save(ctx, orig)
+	require.NoError(t, err)
+
+	dup := &ent.Article{Title: "Go 1.25 is released!", URL: "https://other.example.com/go125", PublishedAt: time.Now()}
+	dup.Edges.Feed = feed
+	dup.Edges.DuplicateOf = orig
+	dup, err = repos.Save(ctx, dup)
+	require.NoError(t, err)
+
+	stored, err := repos.GetFromURL(ctx, dup.URL)
+	require.NoError(t, err)
+	require.NotNil(t, stored.Edges.DuplicateOf)
+	assert.Equal(t, orig.ID, stored.Edges.DuplicateOf.ID)
+
+	other, err := client.Feed.Create().
+		SetURL("https://other.example.com/feed").
+		SetTitle("Other Feed").
+		SetLink("https://other.example.com").
+		SetUpdatedAt(time.Now()).
+		Save(ctx)
+	require.NoError(t, err)
+
+	d := NewDetector(repos, &config.Config{Dedup: &config.Dedup{TitleThreshold: 0.85, WindowHours: 48}})
+	found, err := d.FindByTitle(ctx, other, "GO 1.25 is released.")
+	require.NoError(t, err)
+	require.NotNil(t, found)
+	assert.Equal(t, orig.ID, found.ID, "duplicates are never the original")
+
+	found, err = d.FindByTitle(ctx, feed, "GO 1.25 is released.")
+	require.NoError(t, err)
+	assert.Nil(t, found, "articles of the same feed are not compared")
+
+	found, err = d.FindByTitle(ctx, other, "Rust 2024 edition announced")
+	require.NoError(t, err)
+	assert.Nil(t, found)
+
+	found, err = NewDetector(repos, &config.Config{}).FindByTitle(ctx, other, orig.Title)
+	require.NoError(t, err)
+	assert.Nil(t, found, "title matching needs the dedup section")
+
+	// A less similar article with a summary is preferred
+	third, err := client.Feed.Create().
+		SetURL("https://third.example.com/feed").
+		SetTitle("Third Feed").
+		SetLink("https://third.example.com").
+		SetUpdatedAt(time.Now()).
+		Save(ctx)
+	require.NoError(t, err)
+	summarized := &ent.Article{Title: "Go 1.25 is released now", URL: "https://third.example.com/go125", PublishedAt: time.Now()}
+	summarized.Edges.Feed = third
+	summarized, err = repos.Save(ctx, summarized)
+	require.NoError(t, err)
+	_, err = client.Summary.Create().
+		SetURL(summarized.URL).
+		SetTitle(summarized.Title).
+		SetSummary("summary").
+		SetArticle(summarized).
+		SetFeed(third).
+		Save(ctx)
+	require.NoError(t, err)
+
+	found, err = d.FindByTitle(ctx, other, "GO 1.25 is released.")
+	require.NoError(t, err)
+	require.NotNil(t, found)
+	assert.Equal(t, summarized.ID, found.ID)
+}
@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -12,6 +13,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/mopemope/quicknews/ent/article"
 	"github.com/mopemope/quicknews/ent/feed"
+	"github.com/mopemope/quicknews/ent/schema"
 	"github.com/mopemope/quicknews/ent/summary"
 )
 
@@ -29,15 +31,24 @@ type Article struct {
 	Description string `json:"description,omitempty"`
 	// Full content of the article
 	Content string `json:"content,omitempty"`
+	// Author of the article
+	Author string `json:"author,omitempty"`
+	// Categories of the article given by the feed
+	Categories []string `json:"categories,omitempty"`
+	// URL of the image of the article
+	ImageURL string `json:"image_url,omitempty"`
+	// Media files attached to the article
+	Enclosures []schema.Enclosure `json:"enclosures,omitempty"`
 	// Time the article was published
 	PublishedAt time.Time `json:"published_at,omitempty"`
 	// Time the article was added to the database
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the ArticleQuery when eager-loading is set.
-	Edges         ArticleEdges `json:"edges"`
-	feed_articles *uuid.UUID
-	selectValues  sql.SelectValues
+	Edges              ArticleEdges `json:"edges"`
+	article_duplicates *uuid.UUID
+	feed_articles      *uuid.UUID
+	selectValues       sql.SelectValues
 }
 
 // ArticleEdges holds the relations/edges for other nodes in the graph.
@@ -46,9 +57,13 @@ type ArticleEdges struct {
 	Feed *Feed `json:"feed,omitempty"`
 	// Summary holds the value of the summary edge.
 	Summary *Summary `json:"summary,omitempty"`
+	// DuplicateOf holds the value of the duplicate_of edge.
+	DuplicateOf *Article `json:"duplicate_of,omitempty"`
+	// Duplicates holds the value of the duplicates edge.
+	Duplicates []*Article `json:"duplicates,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [4]bool
 }
 
 // FeedOrErr returns the Feed value or an error if the edge
@@ -73,18 +88,42 @@ func (e ArticleEdges) SummaryOrErr() (*Summary, error) {
 	return nil, &NotLoadedError{edge: "summary"}
 }
 
+// DuplicateOfOrErr returns the DuplicateOf value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ArticleEdges) DuplicateOfOrErr() (*Article, error) {
+	if e.DuplicateOf != nil {
+		return e.DuplicateOf, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: article.Label}
+	}
+	return nil, &NotLoadedError{edge: "duplicate_of"}
+}
+
+// DuplicatesOrErr returns the Duplicates value or an error if the edge
+// was not loaded in eager-loading.
+func (e ArticleEdges) DuplicatesOrErr() ([]*Article, error) {
+	if e.loadedTypes[3] {
+		return e.Duplicates, nil
+	}
+	return nil, &NotLoadedError{edge: "duplicates"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Article) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case article.FieldTitle, article.FieldURL, article.FieldDescription, article.FieldContent:
+		case article.FieldCategories, article.FieldEnclosures:
+			values[i] = new([]byte)
+		case article.FieldTitle, article.FieldURL, article.FieldDescription, article.FieldContent, article.FieldAuthor, article.FieldImageURL:
 			values[i] = new(sql.NullString)
 		case article.FieldPublishedAt, article.FieldCreatedAt:
 			values[i] = new(sql.NullTime)
 		case article.FieldID:
 			values[i] = new(uuid.UUID)
-		case article.ForeignKeys[0]: // feed_articles
+		case article.ForeignKeys[0]: // article_duplicates
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case article.ForeignKeys[1]: // feed_articles
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
 		default:
 			values[i] = new(sql.UnknownType)
@@ -131,6 +170,34 @@ func (a *Article) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				a.Content = value.String
 			}
+		case article.FieldAuthor:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field author", values[i])
+			} else if value.Valid {
+				a.Author = value.String
+			}
+		case article.FieldCategories:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field categories", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &a.Categories); err != nil {
+					return fmt.Errorf("unmarshal field categories: %w", err)
+				}
+			}
+		case article.FieldImageURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field image_url", values[i])
+			} else if value.Valid {
+				a.ImageURL = value.String
+			}
+		case article.FieldEnclosures:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field enclosures", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &a.Enclosures); err != nil {
+					return fmt.Errorf("unmarshal field enclosures: %w", err)
+				}
+			}
 		case article.FieldPublishedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field published_at", values[i])
@@ -144,6 +211,13 @@ func (a *Article) assignValues(columns []string, values []any) error {
 				a.CreatedAt = value.Time
 			}
 		case article.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field article_duplicates", values[i])
+			} else if value.Valid {
+				a.article_duplicates = new(uuid.UUID)
+				*a.article_duplicates = *value.S.(*uuid.UUID)
+			}
+		case article.ForeignKeys[1]:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field feed_articles", values[i])
 			} else if value.Valid {
@@ -173,6 +247,16 @@ func (a *Article) QuerySummary() *SummaryQuery {
 	return NewArticleClient(a.config).QuerySummary(a)
 }
 
+// QueryDuplicateOf queries the "duplicate_of" edge of the Article entity.
+func (a *Article) QueryDuplicateOf() *ArticleQuery {
+	return NewArticleClient(a.config).QueryDuplicateOf(a)
+}
+
+// QueryDuplicates queries the "duplicates" edge of the Article entity.
+func (a *Article) QueryDuplicates() *ArticleQuery {
+	return NewArticleClient(a.config).QueryDuplicates(a)
+}
+
 // Update returns a builder for updating this Article.
 // Note that you need to call Article.Unwrap() before calling this method if this Article
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -208,6 +292,18 @@ func (a *Article) String() string {
 	builder.WriteString("content=")
 	builder.WriteString(a.Content)
 	builder.WriteString(", ")
+	builder.WriteString("author=")
+	builder.WriteString(a.Author)
+	builder.WriteString(", ")
+	builder.WriteString("categories=")
+	builder.WriteString(fmt.Sprintf("%v", a.Categories))
+	builder.WriteString(", ")
+	builder.WriteString("image_url=")
+	builder.WriteString(a.ImageURL)
+	builder.WriteString(", ")
+	builder.WriteString("enclosures=")
+	builder.WriteString(fmt.Sprintf("%v", a.Enclosures))
+	builder.WriteString(", ")
 	builder.WriteString("published_at=")
 	builder.WriteString(a.PublishedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -13,6 +13,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/mopemope/quicknews/ent/article"
 	"github.com/mopemope/quicknews/ent/feed"
+	"github.com/mopemope/quicknews/ent/schema"
 	"github.com/mopemope/quicknews/ent/summary"
 )
 
@@ -63,6 +64,46 @@ func (ac *ArticleCreate) SetNillableContent(s *string) *ArticleCreate {
 	return ac
 }
 
+// SetAuthor sets the "author" field.
+func (ac *ArticleCreate) SetAuthor(s string) *ArticleCreate {
+	ac.mutation.SetAuthor(s)
+	return ac
+}
+
+// SetNillableAuthor sets the "author" field if the given value is not nil.
+func (ac *ArticleCreate) SetNillableAuthor(s *string) *ArticleCreate {
+	if s != nil {
+		ac.SetAuthor(*s)
+	}
+	return ac
+}
+
+// SetCategories sets the "categories" field.
+func (ac *ArticleCreate) SetCategories(s []string) *ArticleCreate {
+	ac.mutation.SetCategories(s)
+	return ac
+}
+
+// SetImageURL sets the "image_url" field.
+func (ac *ArticleCreate) SetImageURL(s string) *ArticleCreate {
+	ac.mutation.SetImageURL(s)
+	return ac
+}
+
+// SetNillableImageURL sets the "image_url" field if the given value is not nil.
+func (ac *ArticleCreate) SetNillableImageURL(s *string) *ArticleCreate {
+	if s != nil {
+		ac.SetImageURL(*s)
+	}
+	return ac
+}
+
+// SetEnclosures sets the "enclosures" field.
+func (ac *ArticleCreate) SetEnclosures(s []schema.Enclosure) *ArticleCreate {
+	ac.mutation.SetEnclosures(s)
+	return ac
+}
+
 // SetPublishedAt sets the "published_at" field.
 func (ac *ArticleCreate) SetPublishedAt(t time.Time) *ArticleCreate {
 	ac.mutation.SetPublishedAt(t)
@@ -135,6 +176,40 @@ func (ac *ArticleCreate) SetSummary(s *Summary) *ArticleCreate {
 	return ac.SetSummaryID(s.ID)
 }
 
+// SetDuplicateOfID sets the "duplicate_of" edge to the Article entity by ID.
+func (ac *ArticleCreate) SetDuplicateOfID(id uuid.UUID) *ArticleCreate {
+	ac.mutation.SetDuplicateOfID(id)
+	return ac
+}
+
+// SetNillableDuplicateOfID sets the "duplicate_of" edge to the Article entity by ID if the given value is not nil.
+func (ac *ArticleCreate) SetNillableDuplicateOfID(id *uuid.UUID) *ArticleCreate {
+	if id != nil {
+		ac = ac.SetDuplicateOfID(*id)
+	}
+	return ac
+}
+
+// SetDuplicateOf sets the "duplicate_of" edge to the Article entity.
+func (ac *ArticleCreate) SetDuplicateOf(a *Article) *ArticleCreate {
+	return ac.SetDuplicateOfID(a.ID)
+}
+
+// AddDuplicateIDs adds the "duplicates" edge to the Article entity by IDs.
+func (ac *ArticleCreate) AddDuplicateIDs(ids ...uuid.UUID) *ArticleCreate {
+	ac.mutation.AddDuplicateIDs(ids...)
+	return ac
+}
+
+// AddDuplicates adds the "duplicates" edges to the Article entity.
+func (ac *ArticleCreate) AddDuplicates(a ...*Article) *ArticleCreate {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return ac.AddDuplicateIDs(ids...)
+}
+
 // Mutation returns the ArticleMutation object of the builder.
 func (ac *ArticleCreate) Mutation() *ArticleMutation {
 	return ac.mutation
@@ -255,6 +330,22 @@ func (ac *ArticleCreate) createSpec() (*Article, *sqlgraph.CreateSpec) {
 		_spec.SetField(article.FieldContent, field.TypeString, value)
 		_node.Content = value
 	}
+	if value, ok := ac.mutation.Author(); ok {
+		_spec.SetField(article.FieldAuthor, field.TypeString, value)
+		_node.Author = value
+	}
+	if value, ok := ac.mutation.Categories(); ok {
+		_spec.SetField(article.FieldCategories, field.TypeJSON, value)
+		_node.Categories = value
+	}
+	if value, ok := ac.mutation.ImageURL(); ok {
+		_spec.SetField(article.FieldImageURL, field.TypeString, value)
+		_node.ImageURL = value
+	}
+	if value, ok := ac.mutation.Enclosures(); ok {
+		_spec.SetField(article.FieldEnclosures, field.TypeJSON, value)
+		_node.Enclosures = value
+	}
 	if value, ok := ac.mutation.PublishedAt(); ok {
 		_spec.SetField(article.FieldPublishedAt, field.TypeTime, value)
 		_node.PublishedAt = value
@@ -296,6 +387,39 @@ func (ac *ArticleCreate) createSpec() (*Article, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := ac.mutation.DuplicateOfIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   article.DuplicateOfTable,
+			Columns: []string{article.DuplicateOfColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(article.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.article_duplicates = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ac.mutation.DuplicatesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   article.DuplicatesTable,
+			Columns: []string{article.DuplicatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(article.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -22,13 +22,15 @@ import (
 // ArticleQuery is the builder for querying Article entities.
 type ArticleQuery struct {
 	config
-	ctx         *QueryContext
-	order       []article.OrderOption
-	inters      []Interceptor
-	predicates  []predicate.Article
-	withFeed    *FeedQuery
-	withSummary *SummaryQuery
-	withFKs     bool
+	ctx             *QueryContext
+	order           []article.OrderOption
+	inters          []Interceptor
+	predicates      []predicate.Article
+	withFeed        *FeedQuery
+	withSummary     *SummaryQuery
+	withDuplicateOf *ArticleQuery
+	withDuplicates  *ArticleQuery
+	withFKs         bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -109,6 +111,50 @@ func (aq *ArticleQuery) QuerySummary() *SummaryQuery {
 	return query
 }
 
+// QueryDuplicateOf chains the current query on the "duplicate_of" edge.
+func (aq *ArticleQuery) QueryDuplicateOf() *ArticleQuery {
+	query := (&ArticleClient{config: aq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := aq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := aq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(article.Table, article.FieldID, selector),
+			sqlgraph.To(article.Table, article.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, article.DuplicateOfTable, article.DuplicateOfColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(aq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryDuplicates chains the current query on the "duplicates" edge.
+func (aq *ArticleQuery) QueryDuplicates() *ArticleQuery {
+	query := (&ArticleClient{config: aq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := aq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := aq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(article.Table, article.FieldID, selector),
+			sqlgraph.To(article.Table, article.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, article.DuplicatesTable, article.DuplicatesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(aq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Article entity from the query.
 // Returns a *NotFoundError when no Article was found.
 func (aq *ArticleQuery) First(ctx context.Context) (*Article, error) {
@@ -296,13 +342,15 @@ func (aq *ArticleQuery) Clone() *ArticleQuery {
 		return nil
 	}
 	return &ArticleQuery{
-		config:      aq.config,
-		ctx:         aq.ctx.Clone(),
-		order:       append([]article.OrderOption{}, aq.order...),
-		inters:      append([]Interceptor{}, aq.inters...),
-		predicates:  append([]predicate.Article{}, aq.predicates...),
-		withFeed:    aq.withFeed.Clone(),
-		withSummary: aq.withSummary.Clone(),
+		config:          aq.config,
+		ctx:             aq.ctx.Clone(),
+		order:           append([]article.OrderOption{}, aq.order...),
+		inters:          append([]Interceptor{}, aq.inters...),
+		predicates:      append([]predicate.Article{}, aq.predicates...),
+		withFeed:        aq.withFeed.Clone(),
+		withSummary:     aq.withSummary.Clone(),
+		withDuplicateOf: aq.withDuplicateOf.Clone(),
+		withDuplicates:  aq.withDuplicates.Clone(),
 		// clone intermediate query.
 		sql:  aq.sql.Clone(),
 		path: aq.path,
@@ -331,6 +379,28 @@ func (aq *ArticleQuery) WithSummary(opts ...func(*SummaryQuery)) *ArticleQuery {
 	return aq
 }
 
+// WithDuplicateOf tells the query-builder to eager-load the nodes that are connected to
+// the "duplicate_of" edge. The optional arguments are used to configure the query builder of the edge.
+func (aq *ArticleQuery) WithDuplicateOf(opts ...func(*ArticleQuery)) *ArticleQuery {
+	query := (&ArticleClient{config: aq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	aq.withDuplicateOf = query
+	return aq
+}
+
+// WithDuplicates tells the query-builder to eager-load the nodes that are connected to
+// the "duplicates" edge. The optional arguments are used to configure the query builder of the edge.
+func (aq *ArticleQuery) WithDuplicates(opts ...func(*ArticleQuery)) *ArticleQuery {
+	query := (&ArticleClient{config: aq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	aq.withDuplicates = query
+	return aq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -410,12 +480,14 @@ func (aq *ArticleQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Arti
 		nodes       = []*Article{}
 		withFKs     = aq.withFKs
 		_spec       = aq.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [4]bool{
 			aq.withFeed != nil,
 			aq.withSummary != nil,
+			aq.withDuplicateOf != nil,
+			aq.withDuplicates != nil,
 		}
 	)
-	if aq.withFeed != nil {
+	if aq.withFeed != nil || aq.withDuplicateOf != nil {
 		withFKs = true
 	}
 	if withFKs {
@@ -451,6 +523,19 @@ func (aq *ArticleQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Arti
 			return nil, err
 		}
 	}
+	if query := aq.withDuplicateOf; query != nil {
+		if err := aq.loadDuplicateOf(ctx, query, nodes, nil,
+			func(n *Article, e *Article) { n.Edges.DuplicateOf = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := aq.withDuplicates; query != nil {
+		if err := aq.loadDuplicates(ctx, query, nodes,
+			func(n *Article) { n.Edges.Duplicates = []*Article{} },
+			func(n *Article, e *Article) { n.Edges.Duplicates = append(n.Edges.Duplicates, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -514,6 +599,69 @@ func (aq *ArticleQuery) loadSummary(ctx context.Context, query *SummaryQuery, no
 	}
 	return nil
 }
+func (aq *ArticleQuery) loadDuplicateOf(ctx context.Context, query *ArticleQuery, nodes []*Article, init func(*Article), assign func(*Article, *Article)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*Article)
+	for i := range nodes {
+		if nodes[i].article_duplicates == nil {
+			continue
+		}
+		fk := *nodes[i].article_duplicates
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(article.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "article_duplicates" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (aq *ArticleQuery) loadDuplicates(ctx context.Context, query *ArticleQuery, nodes []*Article, init func(*Article), assign func(*Article, *Article)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Article)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Article(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(article.DuplicatesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.article_duplicates
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "article_duplicates" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "article_duplicates" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (aq *ArticleQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := aq.querySpec()
@@ -10,11 +10,13 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 	"github.com/mopemope/quicknews/ent/article"
 	"github.com/mopemope/quicknews/ent/feed"
 	"github.com/mopemope/quicknews/ent/predicate"
+	"github.com/mopemope/quicknews/ent/schema"
 	"github.com/mopemope/quicknews/ent/summary"
 )
 
@@ -99,6 +101,82 @@ func (au *ArticleUpdate) ClearContent() *ArticleUpdate {
 	return au
 }
 
+// SetAuthor sets the "author" field.
+func (au *ArticleUpdate) SetAuthor(s string) *ArticleUpdate {
+	au.mutation.SetAuthor(s)
+	return au
+}
+
+// SetNillableAuthor sets the "author" field if the given value is not nil.
+func (au *ArticleUpdate) SetNillableAuthor(s *string) *ArticleUpdate {
+	if s != nil {
+		au.SetAuthor(*s)
+	}
+	return au
+}
+
+// ClearAuthor clears the value of the "author" field.
+func (au *ArticleUpdate) ClearAuthor() *ArticleUpdate {
+	au.mutation.ClearAuthor()
+	return au
+}
+
+// SetCategories sets the "categories" field.
+func (au *ArticleUpdate) SetCategories(s []string) *ArticleUpdate {
+	au.mutation.SetCategories(s)
+	return au
+}
+
+// AppendCategories appends s to the "categories" field.
+func (au *ArticleUpdate) AppendCategories(s []string) *ArticleUpdate {
+	au.mutation.AppendCategories(s)
+	return au
+}
+
+// ClearCategories clears the value of the "categories" field.
+func (au *ArticleUpdate) ClearCategories() *ArticleUpdate {
+	au.mutation.ClearCategories()
+	return au
+}
+
+// SetImageURL sets the "image_url" field.
+func (au *ArticleUpdate) SetImageURL(s string) *ArticleUpdate {
+	au.mutation.SetImageURL(s)
+	return au
+}
+
+// SetNillableImageURL sets the "image_url" field if the given value is not nil.
+func (au *ArticleUpdate) SetNillableImageURL(s *string) *ArticleUpdate {
+	if s != nil {
+		au.SetImageURL(*s)
+	}
+	return au
+}
+
+// ClearImageURL clears the value of the "image_url" field.
+func (au *ArticleUpdate) ClearImageURL() *ArticleUpdate {
+	au.mutation.ClearImageURL()
+	return au
+}
+
+// SetEnclosures sets the "enclosures" field.
+func (au *ArticleUpdate) SetEnclosures(s []schema.Enclosure) *ArticleUpdate {
+	au.mutation.SetEnclosures(s)
+	return au
+}
+
+// AppendEnclosures appends s to the "enclosures" field.
+func (au *ArticleUpdate) AppendEnclosures(s []schema.Enclosure) *ArticleUpdate {
+	au.mutation.AppendEnclosures(s)
+	return au
+}
+
+// ClearEnclosures clears the value of the "enclosures" field.
+func (au *ArticleUpdate) ClearEnclosures() *ArticleUpdate {
+	au.mutation.ClearEnclosures()
+	return au
+}
+
 // SetPublishedAt sets the "published_at" field.
 func (au *ArticleUpdate) SetPublishedAt(t time.Time) *ArticleUpdate {
 	au.mutation.SetPublishedAt(t)
@@ -149,6 +227,40 @@ func (au *ArticleUpdate) SetSummary(s *Summary) *ArticleUpdate {
 	return au.SetSummaryID(s.ID)
 }
 
+// SetDuplicateOfID sets the "duplicate_of" edge to the Article entity by ID.
+func (au *ArticleUpdate) SetDuplicateOfID(id uuid.UUID) *ArticleUpdate {
+	au.mutation.SetDuplicateOfID(id)
+	return au
+}
+
+// SetNillableDuplicateOfID sets the "duplicate_of" edge to the Article entity by ID if the given value is not nil.
+func (au *ArticleUpdate) SetNillableDuplicateOfID(id *uuid.UUID) *ArticleUpdate {
+	if id != nil {
+		au = au.SetDuplicateOfID(*id)
+	}
+	return au
+}
+
+// SetDuplicateOf sets the "duplicate_of" edge to the Article entity.
+func (au *ArticleUpdate) SetDuplicateOf(a *Article) *ArticleUpdate {
+	return au.SetDuplicateOfID(a.ID)
+}
+
+// AddDuplicateIDs adds the "duplicates" edge to the Article entity by IDs.
+func (au *ArticleUpdate) AddDuplicateIDs(ids ...uuid.UUID) *ArticleUpdate {
+	au.mutation.AddDuplicateIDs(ids...)
+	return au
+}
+
+// AddDuplicates adds the "duplicates" edges to the Article entity.
+func (au *ArticleUpdate) AddDuplicates(a ...*Article) *ArticleUpdate {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return au.AddDuplicateIDs(ids...)
+}
+
 // Mutation returns the ArticleMutation object of the builder.
 func (au *ArticleUpdate) Mutation() *ArticleMutation {
 	return au.mutation
@@ -166,6 +278,33 @@ func (au *ArticleUpdate) ClearSummary() *ArticleUpdate {
 	return au
 }
 
+// ClearDuplicateOf clears the "duplicate_of" edge to the Article entity.
+func (au *ArticleUpdate) ClearDuplicateOf() *ArticleUpdate {
+	au.mutation.ClearDuplicateOf()
+	return au
+}
+
+// ClearDuplicates clears all "duplicates" edges to the Article entity.
+func (au *ArticleUpdate) ClearDuplicates() *ArticleUpdate {
+	au.mutation.ClearDuplicates()
+	return au
+}
+
+// RemoveDuplicateIDs removes the "duplicates" edge to Article entities by IDs.
+func (au *ArticleUpdate) RemoveDuplicateIDs(ids ...uuid.UUID) *ArticleUpdate {
+	au.mutation.RemoveDuplicateIDs(ids...)
+	return au
+}
+
+// RemoveDuplicates removes "duplicates" edges to Article entities.
+func (au *ArticleUpdate) RemoveDuplicates(a ...*Article) *ArticleUpdate {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return au.RemoveDuplicateIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (au *ArticleUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, au.sqlSave, au.mutation, au.hooks)
@@ -241,6 +380,40 @@ func (au *ArticleUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if au.mutation.ContentCleared() {
 		_spec.ClearField(article.FieldContent, field.TypeString)
 	}
+	if value, ok := au.mutation.Author(); ok {
+		_spec.SetField(article.FieldAuthor, field.TypeString, value)
+	}
+	if au.mutation.AuthorCleared() {
+		_spec.ClearField(article.FieldAuthor, field.TypeString)
+	}
+	if value, ok := au.mutation.Categories(); ok {
+		_spec.SetField(article.FieldCategories, field.TypeJSON, value)
+	}
+	if value, ok := au.mutation.AppendedCategories(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, article.FieldCategories, value)
+		})
+	}
+	if au.mutation.CategoriesCleared() {
+		_spec.ClearField(article.FieldCategories, field.TypeJSON)
+	}
+	if value, ok := au.mutation.ImageURL(); ok {
+		_spec.SetField(article.FieldImageURL, field.TypeString, value)
+	}
+	if au.mutation.ImageURLCleared() {
+		_spec.ClearField(article.FieldImageURL, field.TypeString)
+	}
+	if value, ok := au.mutation.Enclosures(); ok {
+		_spec.SetField(article.FieldEnclosures, field.TypeJSON, value)
+	}
+	if value, ok := au.mutation.AppendedEnclosures(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, article.FieldEnclosures, value)
+		})
+	}
+	if au.mutation.EnclosuresCleared() {
+		_spec.ClearField(article.FieldEnclosures, field.TypeJSON)
+	}
 	if value, ok := au.mutation.PublishedAt(); ok {
 		_spec.SetField(article.FieldPublishedAt, field.TypeTime, value)
 	}
@@ -305,6 +478,80 @@ func (au *ArticleUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if au.mutation.DuplicateOfCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   article.DuplicateOfTable,
+			Columns: []string{article.DuplicateOfColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(article.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.DuplicateOfIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   article.DuplicateOfTable,
+			Columns: []string{article.DuplicateOfColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(article.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if au.mutation.DuplicatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   article.DuplicatesTable,
+			Columns: []string{article.DuplicatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(article.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.RemovedDuplicatesIDs(); len(nodes) > 0 && !au.mutation.DuplicatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   article.DuplicatesTable,
+			Columns: []string{article.DuplicatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(article.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.DuplicatesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   article.DuplicatesTable,
+			Columns: []string{article.DuplicatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(article.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, au.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{article.Label}
@@ -393,6 +640,82 @@ func (auo *ArticleUpdateOne) ClearContent() *ArticleUpdateOne {
 	return auo
 }
 
+// SetAuthor sets the "author" field.
+func (auo *ArticleUpdateOne) SetAuthor(s string) *ArticleUpdateOne {
+	auo.mutation.SetAuthor(s)
+	return auo
+}
+
+// SetNillableAuthor sets the "author" field if the given value is not nil.
+func (auo *ArticleUpdateOne) SetNillableAuthor(s *string) *ArticleUpdateOne {
+	if s != nil {
+		auo.SetAuthor(*s)
+	}
+	return auo
+}
+
+// ClearAuthor clears the value of the "author" field.
+func (auo *ArticleUpdateOne) ClearAuthor() *ArticleUpdateOne {
+	auo.mutation.ClearAuthor()
+	return auo
+}
+
+// SetCategories sets the "categories" field.
+func (auo *ArticleUpdateOne) SetCategories(s []string) *ArticleUpdateOne {
+	auo.mutation.SetCategories(s)
+	return auo
+}
+
+// AppendCategories appends s to the "categories" field.
+func (auo *ArticleUpdateOne) AppendCategories(s []string) *ArticleUpdateOne {
+	auo.mutation.AppendCategories(s)
+	return auo
+}
+
+// ClearCategories clears the value of the "categories" field.
+func (auo *ArticleUpdateOne) ClearCategories() *ArticleUpdateOne {
+	auo.mutation.ClearCategories()
+	return auo
+}
+
+// SetImageURL sets the "image_url" field.
+func (auo *ArticleUpdateOne) SetImageURL(s string) *ArticleUpdateOne {
+	auo.mutation.SetImageURL(s)
+	return auo
+}
+
+// SetNillableImageURL sets the "image_url" field if the given value is not nil.
+func (auo *ArticleUpdateOne) SetNillableImageURL(s *string) *ArticleUpdateOne {
+	if s != nil {
+		auo.SetImageURL(*s)
+	}
+	return auo
+}
+
+// ClearImageURL clears the value of the "image_url" field.
+func (auo *ArticleUpdateOne) ClearImageURL() *ArticleUpdateOne {
+	auo.mutation.ClearImageURL()
+	return auo
+}
+
+// SetEnclosures sets the "enclosures" field.
+func (auo *ArticleUpdateOne) SetEnclosures(s []schema.Enclosure) *ArticleUpdateOne {
+	auo.mutation.SetEnclosures(s)
+	return auo
+}
+
+// AppendEnclosures appends s to the "enclosures" field.
+func (auo *ArticleUpdateOne) AppendEnclosures(s []schema.Enclosure) *ArticleUpdateOne {
+	auo.mutation.AppendEnclosures(s)
+	return auo
+}
+
+// ClearEnclosures clears the value of the "enclosures" field.
+func (auo *ArticleUpdateOne) ClearEnclosures() *ArticleUpdateOne {
+	auo.mutation.ClearEnclosures()
+	return auo
+}
+
 // SetPublishedAt sets the "published_at" field.
 func (auo *ArticleUpdateOne) SetPublishedAt(t time.Time) *ArticleUpdateOne {
 	auo.mutation.SetPublishedAt(t)
@@ -443,6 +766,40 @@ func (auo *ArticleUpdateOne) SetSummary(s *Summary) *ArticleUpdateOne {
 	return auo.SetSummaryID(s.ID)
 }
 
+// SetDuplicateOfID sets the "duplicate_of" edge to the Article entity by ID.
+func (auo *ArticleUpdateOne) SetDuplicateOfID(id uuid.UUID) *ArticleUpdateOne {
+	auo.mutation.SetDuplicateOfID(id)
+	return auo
+}
+
+// SetNillableDuplicateOfID sets the "duplicate_of" edge to the Article entity by ID if the given value is not nil.
+func (auo *ArticleUpdateOne) SetNillableDuplicateOfID(id *uuid.UUID) *ArticleUpdateOne {
+	if id != nil {
+		auo = auo.SetDuplicateOfID(*id)
+	}
+	return auo
+}
+
+// SetDuplicateOf sets the "duplicate_of" edge to the Article entity.
+func (auo *ArticleUpdateOne) SetDuplicateOf(a *Article) *ArticleUpdateOne {
+	return auo.SetDuplicateOfID(a.ID)
+}
+
+// AddDuplicateIDs adds the "duplicates" edge to the Article entity by IDs.
+func (auo *ArticleUpdateOne) AddDuplicateIDs(ids ...uuid.UUID) *ArticleUpdateOne {
+	auo.mutation.AddDuplicateIDs(ids...)
+	return auo
+}
+
+// AddDuplicates adds the "duplicates" edges to the Article entity.
+func (auo *ArticleUpdateOne) AddDuplicates(a ...*Article) *ArticleUpdateOne {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return auo.AddDuplicateIDs(ids...)
+}
+
 // Mutation returns the ArticleMutation object of the builder.
 func (auo *ArticleUpdateOne) Mutation() *ArticleMutation {
 	return auo.mutation
@@ -460,6 +817,33 @@ func (auo *ArticleUpdateOne) ClearSummary() *ArticleUpdateOne {
 	return auo
 }
 
+// ClearDuplicateOf clears the "duplicate_of" edge to the Article entity.
+func (auo *ArticleUpdateOne) ClearDuplicateOf() *ArticleUpdateOne {
+	auo.mutation.ClearDuplicateOf()
+	return auo
+}
+
+// ClearDuplicates clears all "duplicates" edges to the Article entity.
+func (auo *ArticleUpdateOne) ClearDuplicates() *ArticleUpdateOne {
+	auo.mutation.ClearDuplicates()
+	return auo
+}
+
+// RemoveDuplicateIDs removes the "duplicates" edge to Article entities by IDs.
+func (auo *ArticleUpdateOne) RemoveDuplicateIDs(ids ...uuid.UUID) *ArticleUpdateOne {
+	auo.mutation.RemoveDuplicateIDs(ids...)
+	return auo
+}
+
+// RemoveDuplicates removes "duplicates" edges to Article entities.
+func (auo *ArticleUpdateOne) RemoveDuplicates(a ...*Article) *ArticleUpdateOne {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return auo.RemoveDuplicateIDs(ids...)
+}
+
 // Where appends a list predicates to the ArticleUpdate builder.
 func (auo *ArticleUpdateOne) Where(ps ...predicate.Article) *ArticleUpdateOne {
 	auo.mutation.Where(ps...)
@@ -565,6 +949,40 @@ func (auo *ArticleUpdateOne) sqlSave(ctx context.Context) (_node *Article, err e
 	if auo.mutation.ContentCleared() {
 		_spec.ClearField(article.FieldContent, field.TypeString)
 	}
+	if value, ok := auo.mutation.Author(); ok {
+		_spec.SetField(article.FieldAuthor, field.TypeString, value)
+	}
+	if auo.mutation.AuthorCleared() {
+		_spec.ClearField(article.FieldAuthor, field.TypeString)
+	}
+	if value, ok := auo.mutation.Categories(); ok {
+		_spec.SetField(article.FieldCategories, field.TypeJSON, value)
+	}
+	if value, ok := auo.mutation.AppendedCategories(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, article.FieldCategories, value)
+		})
+	}
+	if auo.mutation.CategoriesCleared() {
+		_spec.ClearField(article.FieldCategories, field.TypeJSON)
+	}
+	if value, ok := auo.mutation.ImageURL(); ok {
+		_spec.SetField(article.FieldImageURL, field.TypeString, value)
+	}
+	if auo.mutation.ImageURLCleared() {
+		_spec.ClearField(article.FieldImageURL, field.TypeString)
+	}
+	if value, ok := auo.mutation.Enclosures(); ok {
+		_spec.SetField(article.FieldEnclosures, field.TypeJSON, value)
+	}
+	if value, ok := auo.mutation.AppendedEnclosures(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, article.FieldEnclosures, value)
+		})
+	}
+	if auo.mutation.EnclosuresCleared() {
+		_spec.ClearField(article.FieldEnclosures, field.TypeJSON)
+	}
 	if value, ok := auo.mutation.PublishedAt(); ok {
 		_spec.SetField(article.FieldPublishedAt, field.TypeTime, value)
 	}
@@ -629,6 +1047,80 @@ func (auo *ArticleUpdateOne) sqlSave(ctx context.Context) (_node *Article, err e
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if auo.mutation.DuplicateOfCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   article.DuplicateOfTable,
+			Columns: []string{article.DuplicateOfColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(article.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.DuplicateOfIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   article.DuplicateOfTable,
+			Columns: []string{article.DuplicateOfColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(article.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if auo.mutation.DuplicatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   article.DuplicatesTable,
+			Columns: []string{article.DuplicatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(article.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.RemovedDuplicatesIDs(); len(nodes) > 0 && !auo.mutation.DuplicatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   article.DuplicatesTable,
+			Columns: []string{article.DuplicatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(article.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.DuplicatesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   article.DuplicatesTable,
+			Columns: []string{article.DuplicatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(article.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Article{config: auo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -15,6 +15,7 @@ import (
 	"github.com/mopemope/quicknews/ent/article"
 	"github.com/mopemope/quicknews/ent/feed"
 	"github.com/mopemope/quicknews/ent/summary"
+	"github.com/mopemope/quicknews/ent/tombstone"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -75,9 +76,10 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			article.Table: article.ValidColumn,
-			feed.Table:    feed.ValidColumn,
-			summary.Table: summary.ValidColumn,
+			article.Table:   article.ValidColumn,
+			feed.Table:      feed.ValidColumn,
+			summary.Table:   summary.ValidColumn,
+			tombstone.Table: tombstone.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
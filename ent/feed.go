@@ -33,6 +33,32 @@ type Feed struct {
 	IsBookmark bool `json:"is_bookmark,omitempty"`
 	// Time the feed was checked
 	LastCheckedAt time.Time `json:"last_checked_at,omitempty"`
+	// Number of fetches that failed in a row
+	ConsecutiveFailures int `json:"consecutive_failures,omitempty"`
+	// Error of the last failed fetch, cleared by a successful fetch
+	LastError string `json:"last_error,omitempty"`
+	// HTTP status of the last fetch, 0 when no response was received
+	LastStatus int `json:"last_status,omitempty"`
+	// Time the feed was last fetched successfully
+	LastSuccessAt *time.Time `json:"last_success_at,omitempty"`
+	// Disabled feeds are not fetched
+	Disabled bool `json:"disabled,omitempty"`
+	// Time the feed is due to be polled, nil when due now
+	NextPollAt *time.Time `json:"next_poll_at,omitempty"`
+	// Minimum polling interval in minutes, 0 for the configured one
+	MinInterval int `json:"min_interval,omitempty"`
+	// Maximum polling interval in minutes, 0 for the configured one
+	MaxInterval int `json:"max_interval,omitempty"`
+	// WebSub hub advertised by the feed
+	HubURL string `json:"hub_url,omitempty"`
+	// Topic URL of the feed at its hub, the feed URL when empty
+	TopicURL string `json:"topic_url,omitempty"`
+	// State of the WebSub subscription: pending, subscribed, denied, or empty when not subscribed
+	WebsubState string `json:"websub_state,omitempty"`
+	// Secret the hub signs the pushed content with
+	WebsubSecret string `json:"-"`
+	// Time the WebSub subscription lease expires
+	WebsubExpiresAt *time.Time `json:"websub_expires_at,omitempty"`
 	// Time the feed was added
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// Last updated time from the feed
@@ -77,13 +103,13 @@ func (*Feed) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case feed.FieldIsBookmark:
+		case feed.FieldIsBookmark, feed.FieldDisabled:
 			values[i] = new(sql.NullBool)
-		case feed.FieldOrder:
+		case feed.FieldOrder, feed.FieldConsecutiveFailures, feed.FieldLastStatus, feed.FieldMinInterval, feed.FieldMaxInterval:
 			values[i] = new(sql.NullInt64)
-		case feed.FieldURL, feed.FieldTitle, feed.FieldDescription, feed.FieldLink:
+		case feed.FieldURL, feed.FieldTitle, feed.FieldDescription, feed.FieldLink, feed.FieldLastError, feed.FieldHubURL, feed.FieldTopicURL, feed.FieldWebsubState, feed.FieldWebsubSecret:
 			values[i] = new(sql.NullString)
-		case feed.FieldLastCheckedAt, feed.FieldCreatedAt, feed.FieldUpdatedAt:
+		case feed.FieldLastCheckedAt, feed.FieldLastSuccessAt, feed.FieldNextPollAt, feed.FieldWebsubExpiresAt, feed.FieldCreatedAt, feed.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		case feed.FieldID:
 			values[i] = new(uuid.UUID)
@@ -150,6 +176,87 @@ func (f *Feed) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				f.LastCheckedAt = value.Time
 			}
+		case feed.FieldConsecutiveFailures:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field consecutive_failures", values[i])
+			} else if value.Valid {
+				f.ConsecutiveFailures = int(value.Int64)
+			}
+		case feed.FieldLastError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_error", values[i])
+			} else if value.Valid {
+				f.LastError = value.String
+			}
+		case feed.FieldLastStatus:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field last_status", values[i])
+			} else if value.Valid {
+				f.LastStatus = int(value.Int64)
+			}
+		case feed.FieldLastSuccessAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_success_at", values[i])
+			} else if value.Valid {
+				f.LastSuccessAt = new(time.Time)
+				*f.LastSuccessAt = value.Time
+			}
+		case feed.FieldDisabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field disabled", values[i])
+			} else if value.Valid {
+				f.Disabled = value.Bool
+			}
+		case feed.FieldNextPollAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field next_poll_at", values[i])
+			} else if value.Valid {
+				f.NextPollAt = new(time.Time)
+				*f.NextPollAt = value.Time
+			}
+		case feed.FieldMinInterval:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field min_interval", values[i])
+			} else if value.Valid {
+				f.MinInterval = int(value.Int64)
+			}
+		case feed.FieldMaxInterval:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_interval", values[i])
+			} else if value.Valid {
+				f.MaxInterval = int(value.Int64)
+			}
+		case feed.FieldHubURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field hub_url", values[i])
+			} else if value.Valid {
+				f.HubURL = value.String
+			}
+		case feed.FieldTopicURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field topic_url", values[i])
+			} else if value.Valid {
+				f.TopicURL = value.String
+			}
+		case feed.FieldWebsubState:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field websub_state", values[i])
+			} else if value.Valid {
+				f.WebsubState = value.String
+			}
+		case feed.FieldWebsubSecret:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field websub_secret", values[i])
+			} else if value.Valid {
+				f.WebsubSecret = value.String
+			}
+		case feed.FieldWebsubExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field websub_expires_at", values[i])
+			} else if value.Valid {
+				f.WebsubExpiresAt = new(time.Time)
+				*f.WebsubExpiresAt = value.Time
+			}
 		case feed.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -229,6 +336,50 @@ func (f *Feed) String() string {
 	builder.WriteString("last_checked_at=")
 	builder.WriteString(f.LastCheckedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
+	builder.WriteString("consecutive_failures=")
+	builder.WriteString(fmt.Sprintf("%v", f.ConsecutiveFailures))
+	builder.WriteString(", ")
+	builder.WriteString("last_error=")
+	builder.WriteString(f.LastError)
+	builder.WriteString(", ")
+	builder.WriteString("last_status=")
+	builder.WriteString(fmt.Sprintf("%v", f.LastStatus))
+	builder.WriteString(", ")
+	if v := f.LastSuccessAt; v != nil {
+		builder.WriteString("last_success_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("disabled=")
+	builder.WriteString(fmt.Sprintf("%v", f.Disabled))
+	builder.WriteString(", ")
+	if v := f.NextPollAt; v != nil {
+		builder.WriteString("next_poll_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("min_interval=")
+	builder.WriteString(fmt.Sprintf("%v", f.MinInterval))
+	builder.WriteString(", ")
+	builder.WriteString("max_interval=")
+	builder.WriteString(fmt.Sprintf("%v", f.MaxInterval))
+	builder.WriteString(", ")
+	builder.WriteString("hub_url=")
+	builder.WriteString(f.HubURL)
+	builder.WriteString(", ")
+	builder.WriteString("topic_url=")
+	builder.WriteString(f.TopicURL)
+	builder.WriteString(", ")
+	builder.WriteString("websub_state=")
+	builder.WriteString(f.WebsubState)
+	builder.WriteString(", ")
+	builder.WriteString("websub_secret=<sensitive>")
+	builder.WriteString(", ")
+	if v := f.WebsubExpiresAt; v != nil {
+		builder.WriteString("websub_expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(f.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -29,6 +29,32 @@ const (
 	FieldIsBookmark = "is_bookmark"
 	// FieldLastCheckedAt holds the string denoting the last_checked_at field in the database.
 	FieldLastCheckedAt = "last_checked_at"
+	// FieldConsecutiveFailures holds the string denoting the consecutive_failures field in the database.
+	FieldConsecutiveFailures = "consecutive_failures"
+	// FieldLastError holds the string denoting the last_error field in the database.
+	FieldLastError = "last_error"
+	// FieldLastStatus holds the string denoting the last_status field in the database.
+	FieldLastStatus = "last_status"
+	// FieldLastSuccessAt holds the string denoting the last_success_at field in the database.
+	FieldLastSuccessAt = "last_success_at"
+	// FieldDisabled holds the string denoting the disabled field in the database.
+	FieldDisabled = "disabled"
+	// FieldNextPollAt holds the string denoting the next_poll_at field in the database.
+	FieldNextPollAt = "next_poll_at"
+	// FieldMinInterval holds the string denoting the min_interval field in the database.
+	FieldMinInterval = "min_interval"
+	// FieldMaxInterval holds the string denoting the max_interval field in the database.
+	FieldMaxInterval = "max_interval"
+	// FieldHubURL holds the string denoting the hub_url field in the database.
+	FieldHubURL = "hub_url"
+	// FieldTopicURL holds the string denoting the topic_url field in the database.
+	FieldTopicURL = "topic_url"
+	// FieldWebsubState holds the string denoting the websub_state field in the database.
+	FieldWebsubState = "websub_state"
+	// FieldWebsubSecret holds the string denoting the websub_secret field in the database.
+	FieldWebsubSecret = "websub_secret"
+	// FieldWebsubExpiresAt holds the string denoting the websub_expires_at field in the database.
+	FieldWebsubExpiresAt = "websub_expires_at"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -65,6 +91,19 @@ var Columns = []string{
 	FieldOrder,
 	FieldIsBookmark,
 	FieldLastCheckedAt,
+	FieldConsecutiveFailures,
+	FieldLastError,
+	FieldLastStatus,
+	FieldLastSuccessAt,
+	FieldDisabled,
+	FieldNextPollAt,
+	FieldMinInterval,
+	FieldMaxInterval,
+	FieldHubURL,
+	FieldTopicURL,
+	FieldWebsubState,
+	FieldWebsubSecret,
+	FieldWebsubExpiresAt,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -88,6 +127,10 @@ var (
 	DefaultOrder int
 	// DefaultIsBookmark holds the default value on creation for the "is_bookmark" field.
 	DefaultIsBookmark bool
+	// DefaultConsecutiveFailures holds the default value on creation for the "consecutive_failures" field.
+	DefaultConsecutiveFailures int
+	// DefaultDisabled holds the default value on creation for the "disabled" field.
+	DefaultDisabled bool
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -141,6 +184,71 @@ func ByLastCheckedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldLastCheckedAt, opts...).ToFunc()
 }
 
+// ByConsecutiveFailures orders the results by the consecutive_failures field.
+func ByConsecutiveFailures(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldConsecutiveFailures, opts...).ToFunc()
+}
+
+// ByLastError orders the results by the last_error field.
+func ByLastError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastError, opts...).ToFunc()
+}
+
+// ByLastStatus orders the results by the last_status field.
+func ByLastStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastStatus, opts...).ToFunc()
+}
+
+// ByLastSuccessAt orders the results by the last_success_at field.
+func ByLastSuccessAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastSuccessAt, opts...).ToFunc()
+}
+
+// ByDisabled orders the results by the disabled field.
+func ByDisabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDisabled, opts...).ToFunc()
+}
+
+// ByNextPollAt orders the results by the next_poll_at field.
+func ByNextPollAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNextPollAt, opts...).ToFunc()
+}
+
+// ByMinInterval orders the results by the min_interval field.
+func ByMinInterval(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMinInterval, opts...).ToFunc()
+}
+
+// ByMaxInterval orders the results by the max_interval field.
+func ByMaxInterval(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxInterval, opts...).ToFunc()
+}
+
+// ByHubURL orders the results by the hub_url field.
+func ByHubURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHubURL, opts...).ToFunc()
+}
+
+// ByTopicURL orders the results by the topic_url field.
+func ByTopicURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTopicURL, opts...).ToFunc()
+}
+
+// ByWebsubState orders the results by the websub_state field.
+func ByWebsubState(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWebsubState, opts...).ToFunc()
+}
+
+// ByWebsubSecret orders the results by the websub_secret field.
+func ByWebsubSecret(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWebsubSecret, opts...).ToFunc()
+}
+
+// ByWebsubExpiresAt orders the results by the websub_expires_at field.
+func ByWebsubExpiresAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWebsubExpiresAt, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
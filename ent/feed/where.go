@@ -91,6 +91,71 @@ func LastCheckedAt(v time.Time) predicate.Feed {
 	return predicate.Feed(sql.FieldEQ(FieldLastCheckedAt, v))
 }
 
+// ConsecutiveFailures applies equality check predicate on the "consecutive_failures" field. It's identical to ConsecutiveFailuresEQ.
+func ConsecutiveFailures(v int) predicate.Feed {
+	return predicate.Feed(sql.FieldEQ(FieldConsecutiveFailures, v))
+}
+
+// LastError applies equality check predicate on the "last_error" field. It's identical to LastErrorEQ.
+func LastError(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldEQ(FieldLastError, v))
+}
+
+// LastStatus applies equality check predicate on the "last_status" field. It's identical to LastStatusEQ.
+func LastStatus(v int) predicate.Feed {
+	return predicate.Feed(sql.FieldEQ(FieldLastStatus, v))
+}
+
+// LastSuccessAt applies equality check predicate on the "last_success_at" field. It's identical to LastSuccessAtEQ.
+func LastSuccessAt(v time.Time) predicate.Feed {
+	return predicate.Feed(sql.FieldEQ(FieldLastSuccessAt, v))
+}
+
+// Disabled applies equality check predicate on the "disabled" field. It's identical to DisabledEQ.
+func Disabled(v bool) predicate.Feed {
+	return predicate.Feed(sql.FieldEQ(FieldDisabled, v))
+}
+
+// NextPollAt applies equality check predicate on the "next_poll_at" field. It's identical to NextPollAtEQ.
+func NextPollAt(v time.Time) predicate.Feed {
+	return predicate.Feed(sql.FieldEQ(FieldNextPollAt, v))
+}
+
+// MinInterval applies equality check predicate on the "min_interval" field. It's identical to MinIntervalEQ.
+func MinInterval(v int) predicate.Feed {
+	return predicate.Feed(sql.FieldEQ(FieldMinInterval, v))
+}
+
+// MaxInterval applies equality check predicate on the "max_interval" field. It's identical to MaxIntervalEQ.
+func MaxInterval(v int) predicate.Feed {
+	return predicate.Feed(sql.FieldEQ(FieldMaxInterval, v))
+}
+
+// HubURL applies equality check predicate on the "hub_url" field. It's identical to HubURLEQ.
+func HubURL(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldEQ(FieldHubURL, v))
+}
+
+// TopicURL applies equality check predicate on the "topic_url" field. It's identical to TopicURLEQ.
+func TopicURL(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldEQ(FieldTopicURL, v))
+}
+
+// WebsubState applies equality check predicate on the "websub_state" field. It's identical to WebsubStateEQ.
+func WebsubState(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldEQ(FieldWebsubState, v))
+}
+
+// WebsubSecret applies equality check predicate on the "websub_secret" field. It's identical to WebsubSecretEQ.
+func WebsubSecret(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldEQ(FieldWebsubSecret, v))
+}
+
+// WebsubExpiresAt applies equality check predicate on the "websub_expires_at" field. It's identical to WebsubExpiresAtEQ.
+func WebsubExpiresAt(v time.Time) predicate.Feed {
+	return predicate.Feed(sql.FieldEQ(FieldWebsubExpiresAt, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Feed {
 	return predicate.Feed(sql.FieldEQ(FieldCreatedAt, v))
@@ -481,6 +546,731 @@ func LastCheckedAtNotNil() predicate.Feed {
 	return predicate.Feed(sql.FieldNotNull(FieldLastCheckedAt))
 }
 
+// ConsecutiveFailuresEQ applies the EQ predicate on the "consecutive_failures" field.
+func ConsecutiveFailuresEQ(v int) predicate.Feed {
+	return predicate.Feed(sql.FieldEQ(FieldConsecutiveFailures, v))
+}
+
+// ConsecutiveFailuresNEQ applies the NEQ predicate on the "consecutive_failures" field.
+func ConsecutiveFailuresNEQ(v int) predicate.Feed {
+	return predicate.Feed(sql.FieldNEQ(FieldConsecutiveFailures, v))
+}
+
+// ConsecutiveFailuresIn applies the In predicate on the "consecutive_failures" field.
+func ConsecutiveFailuresIn(vs ...int) predicate.Feed {
+	return predicate.Feed(sql.FieldIn(FieldConsecutiveFailures, vs...))
+}
+
+// ConsecutiveFailuresNotIn applies the NotIn predicate on the "consecutive_failures" field.
+func ConsecutiveFailuresNotIn(vs ...int) predicate.Feed {
+	return predicate.Feed(sql.FieldNotIn(FieldConsecutiveFailures, vs...))
+}
+
+// ConsecutiveFailuresGT applies the GT predicate on the "consecutive_failures" field.
+func ConsecutiveFailuresGT(v int) predicate.Feed {
+	return predicate.Feed(sql.FieldGT(FieldConsecutiveFailures, v))
+}
+
+// ConsecutiveFailuresGTE applies the GTE predicate on the "consecutive_failures" field.
+func ConsecutiveFailuresGTE(v int) predicate.Feed {
+	return predicate.Feed(sql.FieldGTE(FieldConsecutiveFailures, v))
+}
+
+// ConsecutiveFailuresLT applies the LT predicate on the "consecutive_failures" field.
+func ConsecutiveFailuresLT(v int) predicate.Feed {
+	return predicate.Feed(sql.FieldLT(FieldConsecutiveFailures, v))
+}
+
+// ConsecutiveFailuresLTE applies the LTE predicate on the "consecutive_failures" field.
+func ConsecutiveFailuresLTE(v int) predicate.Feed {
+	return predicate.Feed(sql.FieldLTE(FieldConsecutiveFailures, v))
+}
+
+// LastErrorEQ applies the EQ predicate on the "last_error" field.
+func LastErrorEQ(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldEQ(FieldLastError, v))
+}
+
+// LastErrorNEQ applies the NEQ predicate on the "last_error" field.
+func LastErrorNEQ(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldNEQ(FieldLastError, v))
+}
+
+// LastErrorIn applies the In predicate on the "last_error" field.
+func LastErrorIn(vs ...string) predicate.Feed {
+	return predicate.Feed(sql.FieldIn(FieldLastError, vs...))
+}
+
+// LastErrorNotIn applies the NotIn predicate on the "last_error" field.
+func LastErrorNotIn(vs ...string) predicate.Feed {
+	return predicate.Feed(sql.FieldNotIn(FieldLastError, vs...))
+}
+
+// LastErrorGT applies the GT predicate on the "last_error" field.
+func LastErrorGT(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldGT(FieldLastError, v))
+}
+
+// LastErrorGTE applies the GTE predicate on the "last_error" field.
+func LastErrorGTE(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldGTE(FieldLastError, v))
+}
+
+// LastErrorLT applies the LT predicate on the "last_error" field.
+func LastErrorLT(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldLT(FieldLastError, v))
+}
+
+// LastErrorLTE applies the LTE predicate on the "last_error" field.
+func LastErrorLTE(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldLTE(FieldLastError, v))
+}
+
+// LastErrorContains applies the Contains predicate on the "last_error" field.
+func LastErrorContains(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldContains(FieldLastError, v))
+}
+
+// LastErrorHasPrefix applies the HasPrefix predicate on the "last_error" field.
+func LastErrorHasPrefix(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldHasPrefix(FieldLastError, v))
+}
+
+// LastErrorHasSuffix applies the HasSuffix predicate on the "last_error" field.
+func LastErrorHasSuffix(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldHasSuffix(FieldLastError, v))
+}
+
+// LastErrorIsNil applies the IsNil predicate on the "last_error" field.
+func LastErrorIsNil() predicate.Feed {
+	return predicate.Feed(sql.FieldIsNull(FieldLastError))
+}
+
+// LastErrorNotNil applies the NotNil predicate on the "last_error" field.
+func LastErrorNotNil() predicate.Feed {
+	return predicate.Feed(sql.FieldNotNull(FieldLastError))
+}
+
+// LastErrorEqualFold applies the EqualFold predicate on the "last_error" field.
+func LastErrorEqualFold(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldEqualFold(FieldLastError, v))
+}
+
+// LastErrorContainsFold applies the ContainsFold predicate on the "last_error" field.
+func LastErrorContainsFold(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldContainsFold(FieldLastError, v))
+}
+
+// LastStatusEQ applies the EQ predicate on the "last_status" field.
+func LastStatusEQ(v int) predicate.Feed {
+	return predicate.Feed(sql.FieldEQ(FieldLastStatus, v))
+}
+
+// LastStatusNEQ applies the NEQ predicate on the "last_status" field.
+func LastStatusNEQ(v int) predicate.Feed {
+	return predicate.Feed(sql.FieldNEQ(FieldLastStatus, v))
+}
+
+// LastStatusIn applies the In predicate on the "last_status" field.
+func LastStatusIn(vs ...int) predicate.Feed {
+	return predicate.Feed(sql.FieldIn(FieldLastStatus, vs...))
+}
+
+// LastStatusNotIn applies the NotIn predicate on the "last_status" field.
+func LastStatusNotIn(vs ...int) predicate.Feed {
+	return predicate.Feed(sql.FieldNotIn(FieldLastStatus, vs...))
+}
+
+// LastStatusGT applies the GT predicate on the "last_status" field.
+func LastStatusGT(v int) predicate.Feed {
+	return predicate.Feed(sql.FieldGT(FieldLastStatus, v))
+}
+
+// LastStatusGTE applies the GTE predicate on the "last_status" field.
+func LastStatusGTE(v int) predicate.Feed {
+	return predicate.Feed(sql.FieldGTE(FieldLastStatus, v))
+}
+
+// LastStatusLT applies the LT predicate on the "last_status" field.
+func LastStatusLT(v int) predicate.Feed {
+	return predicate.Feed(sql.FieldLT(FieldLastStatus, v))
+}
+
+// LastStatusLTE applies the LTE predicate on the "last_status" field.
+func LastStatusLTE(v int) predicate.Feed {
+	return predicate.Feed(sql.FieldLTE(FieldLastStatus, v))
+}
+
+// LastStatusIsNil applies the IsNil predicate on the "last_status" field.
+func LastStatusIsNil() predicate.Feed {
+	return predicate.Feed(sql.FieldIsNull(FieldLastStatus))
+}
+
+// LastStatusNotNil applies the NotNil predicate on the "last_status" field.
+func LastStatusNotNil() predicate.Feed {
+	return predicate.Feed(sql.FieldNotNull(FieldLastStatus))
+}
+
+// LastSuccessAtEQ applies the EQ predicate on the "last_success_at" field.
+func LastSuccessAtEQ(v time.Time) predicate.Feed {
+	return predicate.Feed(sql.FieldEQ(FieldLastSuccessAt, v))
+}
+
+// LastSuccessAtNEQ applies the NEQ predicate on the "last_success_at" field.
+func LastSuccessAtNEQ(v time.Time) predicate.Feed {
+	return predicate.Feed(sql.FieldNEQ(FieldLastSuccessAt, v))
+}
+
+// LastSuccessAtIn applies the In predicate on the "last_success_at" field.
+func LastSuccessAtIn(vs ...time.Time) predicate.Feed {
+	return predicate.Feed(sql.FieldIn(FieldLastSuccessAt, vs...))
+}
+
+// LastSuccessAtNotIn applies the NotIn predicate on the "last_success_at" field.
+func LastSuccessAtNotIn(vs ...time.Time) predicate.Feed {
+	return predicate.Feed(sql.FieldNotIn(FieldLastSuccessAt, vs...))
+}
+
+// LastSuccessAtGT applies the GT predicate on the "last_success_at" field.
+func LastSuccessAtGT(v time.Time) predicate.Feed {
+	return predicate.Feed(sql.FieldGT(FieldLastSuccessAt, v))
+}
+
+// LastSuccessAtGTE applies the GTE predicate on the "last_success_at" field.
+func LastSuccessAtGTE(v time.Time) predicate.Feed {
+	return predicate.Feed(sql.FieldGTE(FieldLastSuccessAt, v))
+}
+
+// LastSuccessAtLT applies the LT predicate on the "last_success_at" field.
+func LastSuccessAtLT(v time.Time) predicate.Feed {
+	return predicate.Feed(sql.FieldLT(FieldLastSuccessAt, v))
+}
+
+// LastSuccessAtLTE applies the LTE predicate on the "last_success_at" field.
+func LastSuccessAtLTE(v time.Time) predicate.Feed {
+	return predicate.Feed(sql.FieldLTE(FieldLastSuccessAt, v))
+}
+
+// LastSuccessAtIsNil applies the IsNil predicate on the "last_success_at" field.
+func LastSuccessAtIsNil() predicate.Feed {
+	return predicate.Feed(sql.FieldIsNull(FieldLastSuccessAt))
+}
+
+// LastSuccessAtNotNil applies the NotNil predicate on the "last_success_at" field.
+func LastSuccessAtNotNil() predicate.Feed {
+	return predicate.Feed(sql.FieldNotNull(FieldLastSuccessAt))
+}
+
+// DisabledEQ applies the EQ predicate on the "disabled" field.
+func DisabledEQ(v bool) predicate.Feed {
+	return predicate.Feed(sql.FieldEQ(FieldDisabled, v))
+}
+
+// DisabledNEQ applies the NEQ predicate on the "disabled" field.
+func DisabledNEQ(v bool) predicate.Feed {
+	return predicate.Feed(sql.FieldNEQ(FieldDisabled, v))
+}
+
+// NextPollAtEQ applies the EQ predicate on the "next_poll_at" field.
+func NextPollAtEQ(v time.Time) predicate.Feed {
+	return predicate.Feed(sql.FieldEQ(FieldNextPollAt, v))
+}
+
+// NextPollAtNEQ applies the NEQ predicate on the "next_poll_at" field.
+func NextPollAtNEQ(v time.Time) predicate.Feed {
+	return predicate.Feed(sql.FieldNEQ(FieldNextPollAt, v))
+}
+
+// NextPollAtIn applies the In predicate on the "next_poll_at" field.
+func NextPollAtIn(vs ...time.Time) predicate.Feed {
+	return predicate.Feed(sql.FieldIn(FieldNextPollAt, vs...))
+}
+
+// NextPollAtNotIn applies the NotIn predicate on the "next_poll_at" field.
+func NextPollAtNotIn(vs ...time.Time) predicate.Feed {
+	return predicate.Feed(sql.FieldNotIn(FieldNextPollAt, vs...))
+}
+
+// NextPollAtGT applies the GT predicate on the "next_poll_at" field.
+func NextPollAtGT(v time.Time) predicate.Feed {
+	return predicate.Feed(sql.FieldGT(FieldNextPollAt, v))
+}
+
+// NextPollAtGTE applies the GTE predicate on the "next_poll_at" field.
+func NextPollAtGTE(v time.Time) predicate.Feed {
+	return predicate.Feed(sql.FieldGTE(FieldNextPollAt, v))
+}
+
+// NextPollAtLT applies the LT predicate on the "next_poll_at" field.
+func NextPollAtLT(v time.Time) predicate.Feed {
+	return predicate.Feed(sql.FieldLT(FieldNextPollAt, v))
+}
+
+// NextPollAtLTE applies the LTE predicate on the "next_poll_at" field.
+func NextPollAtLTE(v time.Time) predicate.Feed {
+	return predicate.Feed(sql.FieldLTE(FieldNextPollAt, v))
+}
+
+// NextPollAtIsNil applies the IsNil predicate on the "next_poll_at" field.
+func NextPollAtIsNil() predicate.Feed {
+	return predicate.Feed(sql.FieldIsNull(FieldNextPollAt))
+}
+
+// NextPollAtNotNil applies the NotNil predicate on the "next_poll_at" field.
+func NextPollAtNotNil() predicate.Feed {
+	return predicate.Feed(sql.FieldNotNull(FieldNextPollAt))
+}
+
+// MinIntervalEQ applies the EQ predicate on the "min_interval" field.
+func MinIntervalEQ(v int) predicate.Feed {
+	return predicate.Feed(sql.FieldEQ(FieldMinInterval, v))
+}
+
+// MinIntervalNEQ applies the NEQ predicate on the "min_interval" field.
+func MinIntervalNEQ(v int) predicate.Feed {
+	return predicate.Feed(sql.FieldNEQ(FieldMinInterval, v))
+}
+
+// MinIntervalIn applies the In predicate on the "min_interval" field.
+func MinIntervalIn(vs ...int) predicate.Feed {
+	return predicate.Feed(sql.FieldIn(FieldMinInterval, vs...))
+}
+
+// MinIntervalNotIn applies the NotIn predicate on the "min_interval" field.
+func MinIntervalNotIn(vs ...int) predicate.Feed {
+	return predicate.Feed(sql.FieldNotIn(FieldMinInterval, vs...))
+}
+
+// MinIntervalGT applies the GT predicate on the "min_interval" field.
+func MinIntervalGT(v int) predicate.Feed {
+	return predicate.Feed(sql.FieldGT(FieldMinInterval, v))
+}
+
+// MinIntervalGTE applies the GTE predicate on the "min_interval" field.
+func MinIntervalGTE(v int) predicate.Feed {
+	return predicate.Feed(sql.FieldGTE(FieldMinInterval, v))
+}
+
+// MinIntervalLT applies the LT predicate on the "min_interval" field.
+func MinIntervalLT(v int) predicate.Feed {
+	return predicate.Feed(sql.FieldLT(FieldMinInterval, v))
+}
+
+// MinIntervalLTE applies the LTE predicate on the "min_interval" field.
+func MinIntervalLTE(v int) predicate.Feed {
+	return predicate.Feed(sql.FieldLTE(FieldMinInterval, v))
+}
+
+// MinIntervalIsNil applies the IsNil predicate on the "min_interval" field.
+func MinIntervalIsNil() predicate.Feed {
+	return predicate.Feed(sql.FieldIsNull(FieldMinInterval))
+}
+
+// MinIntervalNotNil applies the NotNil predicate on the "min_interval" field.
+func MinIntervalNotNil() predicate.Feed {
+	return predicate.Feed(sql.FieldNotNull(FieldMinInterval))
+}
+
+// MaxIntervalEQ applies the EQ predicate on the "max_interval" field.
+func MaxIntervalEQ(v int) predicate.Feed {
+	return predicate.Feed(sql.FieldEQ(FieldMaxInterval, v))
+}
+
+// MaxIntervalNEQ applies the NEQ predicate on the "max_interval" field.
+func MaxIntervalNEQ(v int) predicate.Feed {
+	return predicate.Feed(sql.FieldNEQ(FieldMaxInterval, v))
+}
+
+// MaxIntervalIn applies the In predicate on the "max_interval" field.
+func MaxIntervalIn(vs ...int) predicate.Feed {
+	return predicate.Feed(sql.FieldIn(FieldMaxInterval, vs...))
+}
+
+// MaxIntervalNotIn applies the NotIn predicate on the "max_interval" field.
+func MaxIntervalNotIn(vs ...int) predicate.Feed {
+	return predicate.Feed(sql.FieldNotIn(FieldMaxInterval, vs...))
+}
+
+// MaxIntervalGT applies the GT predicate on the "max_interval" field.
+func MaxIntervalGT(v int) predicate.Feed {
+	return predicate.Feed(sql.FieldGT(FieldMaxInterval, v))
+}
+
+// MaxIntervalGTE applies the GTE predicate on the "max_interval" field.
+func MaxIntervalGTE(v int) predicate.Feed {
+	return predicate.Feed(sql.FieldGTE(FieldMaxInterval, v))
+}
+
+// MaxIntervalLT applies the LT predicate on the "max_interval" field.
+func MaxIntervalLT(v int) predicate.Feed {
+	return predicate.Feed(sql.FieldLT(FieldMaxInterval, v))
+}
+
+// MaxIntervalLTE applies the LTE predicate on the "max_interval" field.
+func MaxIntervalLTE(v int) predicate.Feed {
+	return predicate.Feed(sql.FieldLTE(FieldMaxInterval, v))
+}
+
+// MaxIntervalIsNil applies the IsNil predicate on the "max_interval" field.
+func MaxIntervalIsNil() predicate.Feed {
+	return predicate.Feed(sql.FieldIsNull(FieldMaxInterval))
+}
+
+// MaxIntervalNotNil applies the NotNil predicate on the "max_interval" field.
+func MaxIntervalNotNil() predicate.Feed {
+	return predicate.Feed(sql.FieldNotNull(FieldMaxInterval))
+}
+
+// HubURLEQ applies the EQ predicate on the "hub_url" field.
+func HubURLEQ(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldEQ(FieldHubURL, v))
+}
+
+// HubURLNEQ applies the NEQ predicate on the "hub_url" field.
+func HubURLNEQ(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldNEQ(FieldHubURL, v))
+}
+
+// HubURLIn applies the In predicate on the "hub_url" field.
+func HubURLIn(vs ...string) predicate.Feed {
+	return predicate.Feed(sql.FieldIn(FieldHubURL, vs...))
+}
+
+// HubURLNotIn applies the NotIn predicate on the "hub_url" field.
+func HubURLNotIn(vs ...string) predicate.Feed {
+	return predicate.Feed(sql.FieldNotIn(FieldHubURL, vs...))
+}
+
+// HubURLGT applies the GT predicate on the "hub_url" field.
+func HubURLGT(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldGT(FieldHubURL, v))
+}
+
+// HubURLGTE applies the GTE predicate on the "hub_url" field.
+func HubURLGTE(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldGTE(FieldHubURL, v))
+}
+
+// HubURLLT applies the LT predicate on the "hub_url" field.
+func HubURLLT(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldLT(FieldHubURL, v))
+}
+
+// HubURLLTE applies the LTE predicate on the "hub_url" field.
+func HubURLLTE(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldLTE(FieldHubURL, v))
+}
+
+// HubURLContains applies the Contains predicate on the "hub_url" field.
+func HubURLContains(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldContains(FieldHubURL, v))
+}
+
+// HubURLHasPrefix applies the HasPrefix predicate on the "hub_url" field.
+func HubURLHasPrefix(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldHasPrefix(FieldHubURL, v))
+}
+
+// HubURLHasSuffix applies the HasSuffix predicate on the "hub_url" field.
+func HubURLHasSuffix(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldHasSuffix(FieldHubURL, v))
+}
+
+// HubURLIsNil applies the IsNil predicate on the "hub_url" field.
+func HubURLIsNil() predicate.Feed {
+	return predicate.Feed(sql.FieldIsNull(FieldHubURL))
+}
+
+// HubURLNotNil applies the NotNil predicate on the "hub_url" field.
+func HubURLNotNil() predicate.Feed {
+	return predicate.Feed(sql.FieldNotNull(FieldHubURL))
+}
+
+// HubURLEqualFold applies the EqualFold predicate on the "hub_url" field.
+func HubURLEqualFold(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldEqualFold(FieldHubURL, v))
+}
+
+// HubURLContainsFold applies the ContainsFold predicate on the "hub_url" field.
+func HubURLContainsFold(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldContainsFold(FieldHubURL, v))
+}
+
+// TopicURLEQ applies the EQ predicate on the "topic_url" field.
+func TopicURLEQ(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldEQ(FieldTopicURL, v))
+}
+
+// TopicURLNEQ applies the NEQ predicate on the "topic_url" field.
+func TopicURLNEQ(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldNEQ(FieldTopicURL, v))
+}
+
+// TopicURLIn applies the In predicate on the "topic_url" field.
+func TopicURLIn(vs ...string) predicate.Feed {
+	return predicate.Feed(sql.FieldIn(FieldTopicURL, vs...))
+}
+
+// TopicURLNotIn applies the NotIn predicate on the "topic_url" field.
+func TopicURLNotIn(vs ...string) predicate.Feed {
+	return predicate.Feed(sql.FieldNotIn(FieldTopicURL, vs...))
+}
+
+// TopicURLGT applies the GT predicate on the "topic_url" field.
+func TopicURLGT(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldGT(FieldTopicURL, v))
+}
+
+// TopicURLGTE applies the GTE predicate on the "topic_url" field.
+func TopicURLGTE(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldGTE(FieldTopicURL, v))
+}
+
+// TopicURLLT applies the LT predicate on the "topic_url" field.
+func TopicURLLT(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldLT(FieldTopicURL, v))
+}
+
+// TopicURLLTE applies the LTE predicate on the "topic_url" field.
+func TopicURLLTE(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldLTE(FieldTopicURL, v))
+}
+
+// TopicURLContains applies the Contains predicate on the "topic_url" field.
+func TopicURLContains(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldContains(FieldTopicURL, v))
+}
+
+// TopicURLHasPrefix applies the HasPrefix predicate on the "topic_url" field.
+func TopicURLHasPrefix(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldHasPrefix(FieldTopicURL, v))
+}
+
+// TopicURLHasSuffix applies the HasSuffix predicate on the "topic_url" field.
+func TopicURLHasSuffix(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldHasSuffix(FieldTopicURL, v))
+}
+
+// TopicURLIsNil applies the IsNil predicate on the "topic_url" field.
+func TopicURLIsNil() predicate.Feed {
+	return predicate.Feed(sql.FieldIsNull(FieldTopicURL))
+}
+
+// TopicURLNotNil applies the NotNil predicate on the "topic_url" field.
+func TopicURLNotNil() predicate.Feed {
+	return predicate.Feed(sql.FieldNotNull(FieldTopicURL))
+}
+
+// TopicURLEqualFold applies the EqualFold predicate on the "topic_url" field.
+func TopicURLEqualFold(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldEqualFold(FieldTopicURL, v))
+}
+
+// TopicURLContainsFold applies the ContainsFold predicate on the "topic_url" field.
+func TopicURLContainsFold(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldContainsFold(FieldTopicURL, v))
+}
+
+// WebsubStateEQ applies the EQ predicate on the "websub_state" field.
+func WebsubStateEQ(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldEQ(FieldWebsubState, v))
+}
+
+// WebsubStateNEQ applies the NEQ predicate on the "websub_state" field.
+func WebsubStateNEQ(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldNEQ(FieldWebsubState, v))
+}
+
+// WebsubStateIn applies the In predicate on the "websub_state" field.
+func WebsubStateIn(vs ...string) predicate.Feed {
+	return predicate.Feed(sql.FieldIn(FieldWebsubState, vs...))
+}
+
+// WebsubStateNotIn applies the NotIn predicate on the "websub_state" field.
+func WebsubStateNotIn(vs ...string) predicate.Feed {
+	return predicate.Feed(sql.FieldNotIn(FieldWebsubState, vs...))
+}
+
+// WebsubStateGT applies the GT predicate on the "websub_state" field.
+func WebsubStateGT(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldGT(FieldWebsubState, v))
+}
+
+// WebsubStateGTE applies the GTE predicate on the "websub_state" field.
+func WebsubStateGTE(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldGTE(FieldWebsubState, v))
+}
+
+// WebsubStateLT applies the LT predicate on the "websub_state" field.
+func WebsubStateLT(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldLT(FieldWebsubState, v))
+}
+
+// WebsubStateLTE applies the LTE predicate on the "websub_state" field.
+func WebsubStateLTE(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldLTE(FieldWebsubState, v))
+}
+
+// WebsubStateContains applies the Contains predicate on the "websub_state" field.
+func WebsubStateContains(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldContains(FieldWebsubState, v))
+}
+
+// WebsubStateHasPrefix applies the HasPrefix predicate on the "websub_state" field.
+func WebsubStateHasPrefix(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldHasPrefix(FieldWebsubState, v))
+}
+
+// WebsubStateHasSuffix applies the HasSuffix predicate on the "websub_state" field.
+func WebsubStateHasSuffix(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldHasSuffix(FieldWebsubState, v))
+}
+
+// WebsubStateIsNil applies the IsNil predicate on the "websub_state" field.
+func WebsubStateIsNil() predicate.Feed {
+	return predicate.Feed(sql.FieldIsNull(FieldWebsubState))
+}
+
+// WebsubStateNotNil applies the NotNil predicate on the "websub_state" field.
+func WebsubStateNotNil() predicate.Feed {
+	return predicate.Feed(sql.FieldNotNull(FieldWebsubState))
+}
+
+// WebsubStateEqualFold applies the EqualFold predicate on the "websub_state" field.
+func WebsubStateEqualFold(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldEqualFold(FieldWebsubState, v))
+}
+
+// WebsubStateContainsFold applies the ContainsFold predicate on the "websub_state" field.
+func WebsubStateContainsFold(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldContainsFold(FieldWebsubState, v))
+}
+
+// WebsubSecretEQ applies the EQ predicate on the "websub_secret" field.
+func WebsubSecretEQ(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldEQ(FieldWebsubSecret, v))
+}
+
+// WebsubSecretNEQ applies the NEQ predicate on the "websub_secret" field.
+func WebsubSecretNEQ(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldNEQ(FieldWebsubSecret, v))
+}
+
+// WebsubSecretIn applies the In predicate on the "websub_secret" field.
+func WebsubSecretIn(vs ...string) predicate.Feed {
+	return predicate.Feed(sql.FieldIn(FieldWebsubSecret, vs...))
+}
+
+// WebsubSecretNotIn applies the NotIn predicate on the "websub_secret" field.
+func WebsubSecretNotIn(vs ...string) predicate.Feed {
+	return predicate.Feed(sql.FieldNotIn(FieldWebsubSecret, vs...))
+}
+
+// WebsubSecretGT applies the GT predicate on the "websub_secret" field.
+func WebsubSecretGT(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldGT(FieldWebsubSecret, v))
+}
+
+// WebsubSecretGTE applies the GTE predicate on the "websub_secret" field.
+func WebsubSecretGTE(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldGTE(FieldWebsubSecret, v))
+}
+
+// WebsubSecretLT applies the LT predicate on the "websub_secret" field.
+func WebsubSecretLT(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldLT(FieldWebsubSecret, v))
+}
+
+// WebsubSecretLTE applies the LTE predicate on the "websub_secret" field.
+func WebsubSecretLTE(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldLTE(FieldWebsubSecret, v))
+}
+
+// WebsubSecretContains applies the Contains predicate on the "websub_secret" field.
+func WebsubSecretContains(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldContains(FieldWebsubSecret, v))
+}
+
+// WebsubSecretHasPrefix applies the HasPrefix predicate on the "websub_secret" field.
+func WebsubSecretHasPrefix(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldHasPrefix(FieldWebsubSecret, v))
+}
+
+// WebsubSecretHasSuffix applies the HasSuffix predicate on the "websub_secret" field.
+func WebsubSecretHasSuffix(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldHasSuffix(FieldWebsubSecret, v))
+}
+
+// WebsubSecretIsNil applies the IsNil predicate on the "websub_secret" field.
+func WebsubSecretIsNil() predicate.Feed {
+	return predicate.Feed(sql.FieldIsNull(FieldWebsubSecret))
+}
+
+// WebsubSecretNotNil applies the NotNil predicate on the "websub_secret" field.
+func WebsubSecretNotNil() predicate.Feed {
+	return predicate.Feed(sql.FieldNotNull(FieldWebsubSecret))
+}
+
+// WebsubSecretEqualFold applies the EqualFold predicate on the "websub_secret" field.
+func WebsubSecretEqualFold(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldEqualFold(FieldWebsubSecret, v))
+}
+
+// WebsubSecretContainsFold applies the ContainsFold predicate on the "websub_secret" field.
+func WebsubSecretContainsFold(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldContainsFold(FieldWebsubSecret, v))
+}
+
+// WebsubExpiresAtEQ applies the EQ predicate on the "websub_expires_at" field.
+func WebsubExpiresAtEQ(v time.Time) predicate.Feed {
+	return predicate.Feed(sql.FieldEQ(FieldWebsubExpiresAt, v))
+}
+
+// WebsubExpiresAtNEQ applies the NEQ predicate on the "websub_expires_at" field.
+func WebsubExpiresAtNEQ(v time.Time) predicate.Feed {
+	return predicate.Feed(sql.FieldNEQ(FieldWebsubExpiresAt, v))
+}
+
+// WebsubExpiresAtIn applies the In predicate on the "websub_expires_at" field.
+func WebsubExpiresAtIn(vs ...time.Time) predicate.Feed {
+	return predicate.Feed(sql.FieldIn(FieldWebsubExpiresAt, vs...))
+}
+
+// WebsubExpiresAtNotIn applies the NotIn predicate on the "websub_expires_at" field.
+func WebsubExpiresAtNotIn(vs ...time.Time) predicate.Feed {
+	return predicate.Feed(sql.FieldNotIn(FieldWebsubExpiresAt, vs...))
+}
+
+// WebsubExpiresAtGT applies the GT predicate on the "websub_expires_at" field.
+func WebsubExpiresAtGT(v time.Time) predicate.Feed {
+	return predicate.Feed(sql.FieldGT(FieldWebsubExpiresAt, v))
+}
+
+// WebsubExpiresAtGTE applies the GTE predicate on the "websub_expires_at" field.
+func WebsubExpiresAtGTE(v time.Time) predicate.Feed {
+	return predicate.Feed(sql.FieldGTE(FieldWebsubExpiresAt, v))
+}
+
+// WebsubExpiresAtLT applies the LT predicate on the "websub_expires_at" field.
+func WebsubExpiresAtLT(v time.Time) predicate.Feed {
+	return predicate.Feed(sql.FieldLT(FieldWebsubExpiresAt, v))
+}
+
+// WebsubExpiresAtLTE applies the LTE predicate on the "websub_expires_at" field.
+func WebsubExpiresAtLTE(v time.Time) predicate.Feed {
+	return predicate.Feed(sql.FieldLTE(FieldWebsubExpiresAt, v))
+}
+
+// WebsubExpiresAtIsNil applies the IsNil predicate on the "websub_expires_at" field.
+func WebsubExpiresAtIsNil() predicate.Feed {
+	return predicate.Feed(sql.FieldIsNull(FieldWebsubExpiresAt))
+}
+
+// WebsubExpiresAtNotNil applies the NotNil predicate on the "websub_expires_at" field.
+func WebsubExpiresAtNotNil() predicate.Feed {
+	return predicate.Feed(sql.FieldNotNull(FieldWebsubExpiresAt))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Feed {
 	return predicate.Feed(sql.FieldEQ(FieldCreatedAt, v))
@@ -105,6 +105,188 @@ func (fc *FeedCreate) SetNillableLastCheckedAt(t *time.Time) *FeedCreate {
 	return fc
 }
 
+// SetConsecutiveFailures sets the "consecutive_failures" field.
+func (fc *FeedCreate) SetConsecutiveFailures(i int) *FeedCreate {
+	fc.mutation.SetConsecutiveFailures(i)
+	return fc
+}
+
+// SetNillableConsecutiveFailures sets the "consecutive_failures" field if the given value is not nil.
+func (fc *FeedCreate) SetNillableConsecutiveFailures(i *int) *FeedCreate {
+	if i != nil {
+		fc.SetConsecutiveFailures(*i)
+	}
+	return fc
+}
+
+// SetLastError sets the "last_error" field.
+func (fc *FeedCreate) SetLastError(s string) *FeedCreate {
+	fc.mutation.SetLastError(s)
+	return fc
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (fc *FeedCreate) SetNillableLastError(s *string) *FeedCreate {
+	if s != nil {
+		fc.SetLastError(*s)
+	}
+	return fc
+}
+
+// SetLastStatus sets the "last_status" field.
+func (fc *FeedCreate) SetLastStatus(i int) *FeedCreate {
+	fc.mutation.SetLastStatus(i)
+	return fc
+}
+
+// SetNillableLastStatus sets the "last_status" field if the given value is not nil.
+func (fc *FeedCreate) SetNillableLastStatus(i *int) *FeedCreate {
+	if i != nil {
+		fc.SetLastStatus(*i)
+	}
+	return fc
+}
+
+// SetLastSuccessAt sets the "last_success_at" field.
+func (fc *FeedCreate) SetLastSuccessAt(t time.Time) *FeedCreate {
+	fc.mutation.SetLastSuccessAt(t)
+	return fc
+}
+
+// SetNillableLastSuccessAt sets the "last_success_at" field if the given value is not nil.
+func (fc *FeedCreate) SetNillableLastSuccessAt(t *time.Time) *FeedCreate {
+	if t != nil {
+		fc.SetLastSuccessAt(*t)
+	}
+	return fc
+}
+
+// SetDisabled sets the "disabled" field.
+func (fc *FeedCreate) SetDisabled(b bool) *FeedCreate {
+	fc.mutation.SetDisabled(b)
+	return fc
+}
+
+// SetNillableDisabled sets the "disabled" field if the given value is not nil.
+func (fc *FeedCreate) SetNillableDisabled(b *bool) *FeedCreate {
+	if b != nil {
+		fc.SetDisabled(*b)
+	}
+	return fc
+}
+
+// SetNextPollAt sets the "next_poll_at" field.
+func (fc *FeedCreate) SetNextPollAt(t time.Time) *FeedCreate {
+	fc.mutation.SetNextPollAt(t)
+	return fc
+}
+
+// SetNillableNextPollAt sets the "next_poll_at" field if the given value is not nil.
+func (fc *FeedCreate) SetNillableNextPollAt(t *time.Time) *FeedCreate {
+	if t != nil {
+		fc.SetNextPollAt(*t)
+	}
+	return fc
+}
+
+// SetMinInterval sets the "min_interval" field.
+func (fc *FeedCreate) SetMinInterval(i int) *FeedCreate {
+	fc.mutation.SetMinInterval(i)
+	return fc
+}
+
+// SetNillableMinInterval sets the "min_interval" field if the given value is not nil.
+func (fc *FeedCreate) SetNillableMinInterval(i *int) *FeedCreate {
+	if i != nil {
+		fc.SetMinInterval(*i)
+	}
+	return fc
+}
+
+// SetMaxInterval sets the "max_interval" field.
+func (fc *FeedCreate) SetMaxInterval(i int) *FeedCreate {
+	fc.mutation.SetMaxInterval(i)
+	return fc
+}
+
+// SetNillableMaxInterval sets the "max_interval" field if the given value is not nil.
+func (fc *FeedCreate) SetNillableMaxInterval(i *int) *FeedCreate {
+	if i != nil {
+		fc.SetMaxInterval(*i)
+	}
+	return fc
+}
+
+// SetHubURL sets the "hub_url" field.
+func (fc *FeedCreate) SetHubURL(s string) *FeedCreate {
+	fc.mutation.SetHubURL(s)
+	return fc
+}
+
+// SetNillableHubURL sets the "hub_url" field if the given value is not nil.
+func (fc *FeedCreate) SetNillableHubURL(s *string) *FeedCreate {
+	if s != nil {
+		fc.SetHubURL(*s)
+	}
+	return fc
+}
+
+// SetTopicURL sets the "topic_url" field.
+func (fc *FeedCreate) SetTopicURL(s string) *FeedCreate {
+	fc.mutation.SetTopicURL(s)
+	return fc
+}
+
+// SetNillableTopicURL sets the "topic_url" field if the given value is not nil.
+func (fc *FeedCreate) SetNillableTopicURL(s *string) *FeedCreate {
+	if s != nil {
+		fc.SetTopicURL(*s)
+	}
+	return fc
+}
+
+// SetWebsubState sets the "websub_state" field.
+func (fc *FeedCreate) SetWebsubState(s string) *FeedCreate {
+	fc.mutation.SetWebsubState(s)
+	return fc
+}
+
+// SetNillableWebsubState sets the "websub_state" field if the given value is not nil.
+func (fc *FeedCreate) SetNillableWebsubState(s *string) *FeedCreate {
+	if s != nil {
+		fc.SetWebsubState(*s)
+	}
+	return fc
+}
+
+// SetWebsubSecret sets the "websub_secret" field.
+func (fc *FeedCreate) SetWebsubSecret(s string) *FeedCreate {
+	fc.mutation.SetWebsubSecret(s)
+	return fc
+}
+
+// SetNillableWebsubSecret sets the "websub_secret" field if the given value is not nil.
+func (fc *FeedCreate) SetNillableWebsubSecret(s *string) *FeedCreate {
+	if s != nil {
+		fc.SetWebsubSecret(*s)
+	}
+	return fc
+}
+
+// SetWebsubExpiresAt sets the "websub_expires_at" field.
+func (fc *FeedCreate) SetWebsubExpiresAt(t time.Time) *FeedCreate {
+	fc.mutation.SetWebsubExpiresAt(t)
+	return fc
+}
+
+// SetNillableWebsubExpiresAt sets the "websub_expires_at" field if the given value is not nil.
+func (fc *FeedCreate) SetNillableWebsubExpiresAt(t *time.Time) *FeedCreate {
+	if t != nil {
+		fc.SetWebsubExpiresAt(*t)
+	}
+	return fc
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (fc *FeedCreate) SetCreatedAt(t time.Time) *FeedCreate {
 	fc.mutation.SetCreatedAt(t)
@@ -220,6 +402,14 @@ func (fc *FeedCreate) defaults() {
 		v := feed.DefaultIsBookmark
 		fc.mutation.SetIsBookmark(v)
 	}
+	if _, ok := fc.mutation.ConsecutiveFailures(); !ok {
+		v := feed.DefaultConsecutiveFailures
+		fc.mutation.SetConsecutiveFailures(v)
+	}
+	if _, ok := fc.mutation.Disabled(); !ok {
+		v := feed.DefaultDisabled
+		fc.mutation.SetDisabled(v)
+	}
 	if _, ok := fc.mutation.CreatedAt(); !ok {
 		v := feed.DefaultCreatedAt()
 		fc.mutation.SetCreatedAt(v)
@@ -258,6 +448,12 @@ func (fc *FeedCreate) check() error {
 	if _, ok := fc.mutation.IsBookmark(); !ok {
 		return &ValidationError{Name: "is_bookmark", err: errors.New(`ent: missing required field "Feed.is_bookmark"`)}
 	}
+	if _, ok := fc.mutation.ConsecutiveFailures(); !ok {
+		return &ValidationError{Name: "consecutive_failures", err: errors.New(`ent: missing required field "Feed.consecutive_failures"`)}
+	}
+	if _, ok := fc.mutation.Disabled(); !ok {
+		return &ValidationError{Name: "disabled", err: errors.New(`ent: missing required field "Feed.disabled"`)}
+	}
 	if _, ok := fc.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Feed.created_at"`)}
 	}
@@ -327,6 +523,58 @@ func (fc *FeedCreate) createSpec() (*Feed, *sqlgraph.CreateSpec) {
 		_spec.SetField(feed.FieldLastCheckedAt, field.TypeTime, value)
 		_node.LastCheckedAt = value
 	}
+	if value, ok := fc.mutation.ConsecutiveFailures(); ok {
+		_spec.SetField(feed.FieldConsecutiveFailures, field.TypeInt, value)
+		_node.ConsecutiveFailures = value
+	}
+	if value, ok := fc.mutation.LastError(); ok {
+		_spec.SetField(feed.FieldLastError, field.TypeString, value)
+		_node.LastError = value
+	}
+	if value, ok := fc.mutation.LastStatus(); ok {
+		_spec.SetField(feed.FieldLastStatus, field.TypeInt, value)
+		_node.LastStatus = value
+	}
+	if value, ok := fc.mutation.LastSuccessAt(); ok {
+		_spec.SetField(feed.FieldLastSuccessAt, field.TypeTime, value)
+		_node.LastSuccessAt = &value
+	}
+	if value, ok := fc.mutation.Disabled(); ok {
+		_spec.SetField(feed.FieldDisabled, field.TypeBool, value)
+		_node.Disabled = value
+	}
+	if value, ok := fc.mutation.NextPollAt(); ok {
+		_spec.SetField(feed.FieldNextPollAt, field.TypeTime, value)
+		_node.NextPollAt = &value
+	}
+	if value, ok := fc.mutation.MinInterval(); ok {
+		_spec.SetField(feed.FieldMinInterval, field.TypeInt, value)
+		_node.MinInterval = value
+	}
+	if value, ok := fc.mutation.MaxInterval(); ok {
+		_spec.SetField(feed.FieldMaxInterval, field.TypeInt, value)
+		_node.MaxInterval = value
+	}
+	if value, ok := fc.mutation.HubURL(); ok {
+		_spec.SetField(feed.FieldHubURL, field.TypeString, value)
+		_node.HubURL = value
+	}
+	if value, ok := fc.mutation.TopicURL(); ok {
+		_spec.SetField(feed.FieldTopicURL, field.TypeString, value)
+		_node.TopicURL = value
+	}
+	if value, ok := fc.mutation.WebsubState(); ok {
+		_spec.SetField(feed.FieldWebsubState, field.TypeString, value)
+		_node.WebsubState = value
+	}
+	if value, ok := fc.mutation.WebsubSecret(); ok {
+		_spec.SetField(feed.FieldWebsubSecret, field.TypeString, value)
+		_node.WebsubSecret = value
+	}
+	if value, ok := fc.mutation.WebsubExpiresAt(); ok {
+		_spec.SetField(feed.FieldWebsubExpiresAt, field.TypeTime, value)
+		_node.WebsubExpiresAt = &value
+	}
 	if value, ok := fc.mutation.CreatedAt(); ok {
 		_spec.SetField(feed.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -154,6 +154,282 @@ func (fu *FeedUpdate) ClearLastCheckedAt() *FeedUpdate {
 	return fu
 }
 
+// SetConsecutiveFailures sets the "consecutive_failures" field.
+func (fu *FeedUpdate) SetConsecutiveFailures(i int) *FeedUpdate {
+	fu.mutation.ResetConsecutiveFailures()
+	fu.mutation.SetConsecutiveFailures(i)
+	return fu
+}
+
+// SetNillableConsecutiveFailures sets the "consecutive_failures" field if the given value is not nil.
+func (fu *FeedUpdate) SetNillableConsecutiveFailures(i *int) *FeedUpdate {
+	if i != nil {
+		fu.SetConsecutiveFailures(*i)
+	}
+	return fu
+}
+
+// AddConsecutiveFailures adds i to the "consecutive_failures" field.
+func (fu *FeedUpdate) AddConsecutiveFailures(i int) *FeedUpdate {
+	fu.mutation.AddConsecutiveFailures(i)
+	return fu
+}
+
+// SetLastError sets the "last_error" field.
+func (fu *FeedUpdate) SetLastError(s string) *FeedUpdate {
+	fu.mutation.SetLastError(s)
+	return fu
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (fu *FeedUpdate) SetNillableLastError(s *string) *FeedUpdate {
+	if s != nil {
+		fu.SetLastError(*s)
+	}
+	return fu
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (fu *FeedUpdate) ClearLastError() *FeedUpdate {
+	fu.mutation.ClearLastError()
+	return fu
+}
+
+// SetLastStatus sets the "last_status" field.
+func (fu *FeedUpdate) SetLastStatus(i int) *FeedUpdate {
+	fu.mutation.ResetLastStatus()
+	fu.mutation.SetLastStatus(i)
+	return fu
+}
+
+// SetNillableLastStatus sets the "last_status" field if the given value is not nil.
+func (fu *FeedUpdate) SetNillableLastStatus(i *int) *FeedUpdate {
+	if i != nil {
+		fu.SetLastStatus(*i)
+	}
+	return fu
+}
+
+// AddLastStatus adds i to the "last_status" field.
+func (fu *FeedUpdate) AddLastStatus(i int) *FeedUpdate {
+	fu.mutation.AddLastStatus(i)
+	return fu
+}
+
+// ClearLastStatus clears the value of the "last_status" field.
+func (fu *FeedUpdate) ClearLastStatus() *FeedUpdate {
+	fu.mutation.ClearLastStatus()
+	return fu
+}
+
+// SetLastSuccessAt sets the "last_success_at" field.
+func (fu *FeedUpdate) SetLastSuccessAt(t time.Time) *FeedUpdate {
+	fu.mutation.SetLastSuccessAt(t)
+	return fu
+}
+
+// SetNillableLastSuccessAt sets the "last_success_at" field if the given value is not nil.
+func (fu *FeedUpdate) SetNillableLastSuccessAt(t *time.Time) *FeedUpdate {
+	if t != nil {
+		fu.SetLastSuccessAt(*t)
+	}
+	return fu
+}
+
+// ClearLastSuccessAt clears the value of the "last_success_at" field.
+func (fu *FeedUpdate) ClearLastSuccessAt() *FeedUpdate {
+	fu.mutation.ClearLastSuccessAt()
+	return fu
+}
+
+// SetDisabled sets the "disabled" field.
+func (fu *FeedUpdate) SetDisabled(b bool) *FeedUpdate {
+	fu.mutation.SetDisabled(b)
+	return fu
+}
+
+// SetNillableDisabled sets the "disabled" field if the given value is not nil.
+func (fu *FeedUpdate) SetNillableDisabled(b *bool) *FeedUpdate {
+	if b != nil {
+		fu.SetDisabled(*b)
+	}
+	return fu
+}
+
+// SetNextPollAt sets the "next_poll_at" field.
+func (fu *FeedUpdate) SetNextPollAt(t time.Time) *FeedUpdate {
+	fu.mutation.SetNextPollAt(t)
+	return fu
+}
+
+// SetNillableNextPollAt sets the "next_poll_at" field if the given value is not nil.
+func (fu *FeedUpdate) SetNillableNextPollAt(t *time.Time) *FeedUpdate {
+	if t != nil {
+		fu.SetNextPollAt(*t)
+	}
+	return fu
+}
+
+// ClearNextPollAt clears the value of the "next_poll_at" field.
+func (fu *FeedUpdate) ClearNextPollAt() *FeedUpdate {
+	fu.mutation.ClearNextPollAt()
+	return fu
+}
+
+// SetMinInterval sets the "min_interval" field.
+func (fu *FeedUpdate) SetMinInterval(i int) *FeedUpdate {
+	fu.mutation.ResetMinInterval()
+	fu.mutation.SetMinInterval(i)
+	return fu
+}
+
+// SetNillableMinInterval sets the "min_interval" field if the given value is not nil.
+func (fu *FeedUpdate) SetNillableMinInterval(i *int) *FeedUpdate {
+	if i != nil {
+		fu.SetMinInterval(*i)
+	}
+	return fu
+}
+
+// AddMinInterval adds i to the "min_interval" field.
+func (fu *FeedUpdate) AddMinInterval(i int) *FeedUpdate {
+	fu.mutation.AddMinInterval(i)
+	return fu
+}
+
+// ClearMinInterval clears the value of the "min_interval" field.
+func (fu *FeedUpdate) ClearMinInterval() *FeedUpdate {
+	fu.mutation.ClearMinInterval()
+	return fu
+}
+
+// SetMaxInterval sets the "max_interval" field.
+func (fu *FeedUpdate) SetMaxInterval(i int) *FeedUpdate {
+	fu.mutation.ResetMaxInterval()
+	fu.mutation.SetMaxInterval(i)
+	return fu
+}
+
+// SetNillableMaxInterval sets the "max_interval" field if the given value is not nil.
+func (fu *FeedUpdate) SetNillableMaxInterval(i *int) *FeedUpdate {
+	if i != nil {
+		fu.SetMaxInterval(*i)
+	}
+	return fu
+}
+
+// AddMaxInterval adds i to the "max_interval" field.
+func (fu *FeedUpdate) AddMaxInterval(i int) *FeedUpdate {
+	fu.mutation.AddMaxInterval(i)
+	return fu
+}
+
+// ClearMaxInterval clears the value of the "max_interval" field.
+func (fu *FeedUpdate) ClearMaxInterval() *FeedUpdate {
+	fu.mutation.ClearMaxInterval()
+	return fu
+}
+
+// SetHubURL sets the "hub_url" field.
+func (fu *FeedUpdate) SetHubURL(s string) *FeedUpdate {
+	fu.mutation.SetHubURL(s)
+	return fu
+}
+
+// SetNillableHubURL sets the "hub_url" field if the given value is not nil.
+func (fu *FeedUpdate) SetNillableHubURL(s *string) *FeedUpdate {
+	if s != nil {
+		fu.SetHubURL(*s)
+	}
+	return fu
+}
+
+// ClearHubURL clears the value of the "hub_url" field.
+func (fu *FeedUpdate) ClearHubURL() *FeedUpdate {
+	fu.mutation.ClearHubURL()
+	return fu
+}
+
+// SetTopicURL sets the "topic_url" field.
+func (fu *FeedUpdate) SetTopicURL(s string) *FeedUpdate {
+	fu.mutation.SetTopicURL(s)
+	return fu
+}
+
+// SetNillableTopicURL sets the "topic_url" field if the given value is not nil.
+func (fu *FeedUpdate) SetNillableTopicURL(s *string) *FeedUpdate {
+	if s != nil {
+		fu.SetTopicURL(*s)
+	}
+	return fu
+}
+
+// ClearTopicURL clears the value of the "topic_url" field.
+func (fu *FeedUpdate) ClearTopicURL() *FeedUpdate {
+	fu.mutation.ClearTopicURL()
+	return fu
+}
+
+// SetWebsubState sets the "websub_state" field.
+func (fu *FeedUpdate) SetWebsubState(s string) *FeedUpdate {
+	fu.mutation.SetWebsubState(s)
+	return fu
+}
+
+// SetNillableWebsubState sets the "websub_state" field if the given value is not nil.
+func (fu *FeedUpdate) SetNillableWebsubState(s *string) *FeedUpdate {
+	if s != nil {
+		fu.SetWebsubState(*s)
+	}
+	return fu
+}
+
+// ClearWebsubState clears the value of the "websub_state" field.
+func (fu *FeedUpdate) ClearWebsubState() *FeedUpdate {
+	fu.mutation.ClearWebsubState()
+	return fu
+}
+
+// SetWebsubSecret sets the "websub_secret" field.
+func (fu *FeedUpdate) SetWebsubSecret(s string) *FeedUpdate {
+	fu.mutation.SetWebsubSecret(s)
+	return fu
+}
+
+// SetNillableWebsubSecret sets the "websub_secret" field if the given value is not nil.
+func (fu *FeedUpdate) SetNillableWebsubSecret(s *string) *FeedUpdate {
+	if s != nil {
+		fu.SetWebsubSecret(*s)
+	}
+	return fu
+}
+
+// ClearWebsubSecret clears the value of the "websub_secret" field.
+func (fu *FeedUpdate) ClearWebsubSecret() *FeedUpdate {
+	fu.mutation.ClearWebsubSecret()
+	return fu
+}
+
+// SetWebsubExpiresAt sets the "websub_expires_at" field.
+func (fu *FeedUpdate) SetWebsubExpiresAt(t time.Time) *FeedUpdate {
+	fu.mutation.SetWebsubExpiresAt(t)
+	return fu
+}
+
+// SetNillableWebsubExpiresAt sets the "websub_expires_at" field if the given value is not nil.
+func (fu *FeedUpdate) SetNillableWebsubExpiresAt(t *time.Time) *FeedUpdate {
+	if t != nil {
+		fu.SetWebsubExpiresAt(*t)
+	}
+	return fu
+}
+
+// ClearWebsubExpiresAt clears the value of the "websub_expires_at" field.
+func (fu *FeedUpdate) ClearWebsubExpiresAt() *FeedUpdate {
+	fu.mutation.ClearWebsubExpiresAt()
+	return fu
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (fu *FeedUpdate) SetUpdatedAt(t time.Time) *FeedUpdate {
 	fu.mutation.SetUpdatedAt(t)
@@ -333,6 +609,90 @@ func (fu *FeedUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if fu.mutation.LastCheckedAtCleared() {
 		_spec.ClearField(feed.FieldLastCheckedAt, field.TypeTime)
 	}
+	if value, ok := fu.mutation.ConsecutiveFailures(); ok {
+		_spec.SetField(feed.FieldConsecutiveFailures, field.TypeInt, value)
+	}
+	if value, ok := fu.mutation.AddedConsecutiveFailures(); ok {
+		_spec.AddField(feed.FieldConsecutiveFailures, field.TypeInt, value)
+	}
+	if value, ok := fu.mutation.LastError(); ok {
+		_spec.SetField(feed.FieldLastError, field.TypeString, value)
+	}
+	if fu.mutation.LastErrorCleared() {
+		_spec.ClearField(feed.FieldLastError, field.TypeString)
+	}
+	if value, ok := fu.mutation.LastStatus(); ok {
+		_spec.SetField(feed.FieldLastStatus, field.TypeInt, value)
+	}
+	if value, ok := fu.mutation.AddedLastStatus(); ok {
+		_spec.AddField(feed.FieldLastStatus, field.TypeInt, value)
+	}
+	if fu.mutation.LastStatusCleared() {
+		_spec.ClearField(feed.FieldLastStatus, field.TypeInt)
+	}
+	if value, ok := fu.mutation.LastSuccessAt(); ok {
+		_spec.SetField(feed.FieldLastSuccessAt, field.TypeTime, value)
+	}
+	if fu.mutation.LastSuccessAtCleared() {
+		_spec.ClearField(feed.FieldLastSuccessAt, field.TypeTime)
+	}
+	if value, ok := fu.mutation.Disabled(); ok {
+		_spec.SetField(feed.FieldDisabled, field.TypeBool, value)
+	}
+	if value, ok := fu.mutation.NextPollAt(); ok {
+		_spec.SetField(feed.FieldNextPollAt, field.TypeTime, value)
+	}
+	if fu.mutation.NextPollAtCleared() {
+		_spec.ClearField(feed.FieldNextPollAt, field.TypeTime)
+	}
+	if value, ok := fu.mutation.MinInterval(); ok {
+		_spec.SetField(feed.FieldMinInterval, field.TypeInt, value)
+	}
+	if value, ok := fu.mutation.AddedMinInterval(); ok {
+		_spec.AddField(feed.FieldMinInterval, field.TypeInt, value)
+	}
+	if fu.mutation.MinIntervalCleared() {
+		_spec.ClearField(feed.FieldMinInterval, field.TypeInt)
+	}
+	if value, ok := fu.mutation.MaxInterval(); ok {
+		_spec.SetField(feed.FieldMaxInterval, field.TypeInt, value)
+	}
+	if value, ok := fu.mutation.AddedMaxInterval(); ok {
+		_spec.AddField(feed.FieldMaxInterval, field.TypeInt, value)
+	}
+	if fu.mutation.MaxIntervalCleared() {
+		_spec.ClearField(feed.FieldMaxInterval, field.TypeInt)
+	}
+	if value, ok := fu.mutation.HubURL(); ok {
+		_spec.SetField(feed.FieldHubURL, field.TypeString, value)
+	}
+	if fu.mutation.HubURLCleared() {
+		_spec.ClearField(feed.FieldHubURL, field.TypeString)
+	}
+	if value, ok := fu.mutation.TopicURL(); ok {
+		_spec.SetField(feed.FieldTopicURL, field.TypeString, value)
+	}
+	if fu.mutation.TopicURLCleared() {
+		_spec.ClearField(feed.FieldTopicURL, field.TypeString)
+	}
+	if value, ok := fu.mutation.WebsubState(); ok {
+		_spec.SetField(feed.FieldWebsubState, field.TypeString, value)
+	}
+	if fu.mutation.WebsubStateCleared() {
+		_spec.ClearField(feed.FieldWebsubState, field.TypeString)
+	}
+	if value, ok := fu.mutation.WebsubSecret(); ok {
+		_spec.SetField(feed.FieldWebsubSecret, field.TypeString, value)
+	}
+	if fu.mutation.WebsubSecretCleared() {
+		_spec.ClearField(feed.FieldWebsubSecret, field.TypeString)
+	}
+	if value, ok := fu.mutation.WebsubExpiresAt(); ok {
+		_spec.SetField(feed.FieldWebsubExpiresAt, field.TypeTime, value)
+	}
+	if fu.mutation.WebsubExpiresAtCleared() {
+		_spec.ClearField(feed.FieldWebsubExpiresAt, field.TypeTime)
+	}
 	if value, ok := fu.mutation.UpdatedAt(); ok {
 		_spec.SetField(feed.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -569,6 +929,282 @@ func (fuo *FeedUpdateOne) ClearLastCheckedAt() *FeedUpdateOne {
 	return fuo
 }
 
+// SetConsecutiveFailures sets the "consecutive_failures" field.
+func (fuo *FeedUpdateOne) SetConsecutiveFailures(i int) *FeedUpdateOne {
+	fuo.mutation.ResetConsecutiveFailures()
+	fuo.mutation.SetConsecutiveFailures(i)
+	return fuo
+}
+
+// SetNillableConsecutiveFailures sets the "consecutive_failures" field if the given value is not nil.
+func (fuo *FeedUpdateOne) SetNillableConsecutiveFailures(i *int) *FeedUpdateOne {
+	if i != nil {
+		fuo.SetConsecutiveFailures(*i)
+	}
+	return fuo
+}
+
+// AddConsecutiveFailures adds i to the "consecutive_failures" field.
+func (fuo *FeedUpdateOne) AddConsecutiveFailures(i int) *FeedUpdateOne {
+	fuo.mutation.AddConsecutiveFailures(i)
+	return fuo
+}
+
+// SetLastError sets the "last_error" field.
+func (fuo *FeedUpdateOne) SetLastError(s string) *FeedUpdateOne {
+	fuo.mutation.SetLastError(s)
+	return fuo
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (fuo *FeedUpdateOne) SetNillableLastError(s *string) *FeedUpdateOne {
+	if s != nil {
+		fuo.SetLastError(*s)
+	}
+	return fuo
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (fuo *FeedUpdateOne) ClearLastError() *FeedUpdateOne {
+	fuo.mutation.ClearLastError()
+	return fuo
+}
+
+// SetLastStatus sets the "last_status" field.
+func (fuo *FeedUpdateOne) SetLastStatus(i int) *FeedUpdateOne {
+	fuo.mutation.ResetLastStatus()
+	fuo.mutation.SetLastStatus(i)
+	return fuo
+}
+
+// SetNillableLastStatus sets the "last_status" field if the given value is not nil.
+func (fuo *FeedUpdateOne) SetNillableLastStatus(i *int) *FeedUpdateOne {
+	if i != nil {
+		fuo.SetLastStatus(*i)
+	}
+	return fuo
+}
+
+// AddLastStatus adds i to the "last_status" field.
+func (fuo *FeedUpdateOne) AddLastStatus(i int) *FeedUpdateOne {
+	fuo.mutation.AddLastStatus(i)
+	return fuo
+}
+
+// ClearLastStatus clears the value of the "last_status" field.
+func (fuo *FeedUpdateOne) ClearLastStatus() *FeedUpdateOne {
+	fuo.mutation.ClearLastStatus()
+	return fuo
+}
+
+// SetLastSuccessAt sets the "last_success_at" field.
+func (fuo *FeedUpdateOne) SetLastSuccessAt(t time.Time) *FeedUpdateOne {
+	fuo.mutation.SetLastSuccessAt(t)
+	return fuo
+}
+
+// SetNillableLastSuccessAt sets the "last_success_at" field if the given value is not nil.
+func (fuo *FeedUpdateOne) SetNillableLastSuccessAt(t *time.Time) *FeedUpdateOne {
+	if t != nil {
+		fuo.SetLastSuccessAt(*t)
+	}
+	return fuo
+}
+
+// ClearLastSuccessAt clears the value of the "last_success_at" field.
+func (fuo *FeedUpdateOne) ClearLastSuccessAt() *FeedUpdateOne {
+	fuo.mutation.ClearLastSuccessAt()
+	return fuo
+}
+
+// SetDisabled sets the "disabled" field.
+func (fuo *FeedUpdateOne) SetDisabled(b bool) *FeedUpdateOne {
+	fuo.mutation.SetDisabled(b)
+	return fuo
+}
+
+// SetNillableDisabled sets the "disabled" field if the given value is not nil.
+func (fuo *FeedUpdateOne) SetNillableDisabled(b *bool) *FeedUpdateOne {
+	if b != nil {
+		fuo.SetDisabled(*b)
+	}
+	return fuo
+}
+
+// SetNextPollAt sets the "next_poll_at" field.
+func (fuo *FeedUpdateOne) SetNextPollAt(t time.Time) *FeedUpdateOne {
+	fuo.mutation.SetNextPollAt(t)
+	return fuo
+}
+
+// SetNillableNextPollAt sets the "next_poll_at" field if the given value is not nil.
+func (fuo *FeedUpdateOne) SetNillableNextPollAt(t *time.Time) *FeedUpdateOne {
+	if t != nil {
+		fuo.SetNextPollAt(*t)
+	}
+	return fuo
+}
+
+// ClearNextPollAt clears the value of the "next_poll_at" field.
+func (fuo *FeedUpdateOne) ClearNextPollAt() *FeedUpdateOne {
+	fuo.mutation.ClearNextPollAt()
+	return fuo
+}
+
+// SetMinInterval sets the "min_interval" field.
+func (fuo *FeedUpdateOne) SetMinInterval(i int) *FeedUpdateOne {
+	fuo.mutation.ResetMinInterval()
+	fuo.mutation.SetMinInterval(i)
+	return fuo
+}
+
+// SetNillableMinInterval sets the "min_interval" field if the given value is not nil.
+func (fuo *FeedUpdateOne) SetNillableMinInterval(i *int) *FeedUpdateOne {
+	if i != nil {
+		fuo.SetMinInterval(*i)
+	}
+	return fuo
+}
+
+// AddMinInterval adds i to the "min_interval" field.
+func (fuo *FeedUpdateOne) AddMinInterval(i int) *FeedUpdateOne {
+	fuo.mutation.AddMinInterval(i)
+	return fuo
+}
+
+// ClearMinInterval clears the value of the "min_interval" field.
+func (fuo *FeedUpdateOne) ClearMinInterval() *FeedUpdateOne {
+	fuo.mutation.ClearMinInterval()
+	return fuo
+}
+
+// SetMaxInterval sets the "max_interval" field.
+func (fuo *FeedUpdateOne) SetMaxInterval(i int) *FeedUpdateOne {
+	fuo.mutation.ResetMaxInterval()
+	fuo.mutation.SetMaxInterval(i)
+	return fuo
+}
+
+// SetNillableMaxInterval sets the "max_interval" field if the given value is not nil.
+func (fuo *FeedUpdateOne) SetNillableMaxInterval(i *int) *FeedUpdateOne {
+	if i != nil {
+		fuo.SetMaxInterval(*i)
+	}
+	return fuo
+}
+
+// AddMaxInterval adds i to the "max_interval" field.
+func (fuo *FeedUpdateOne) AddMaxInterval(i int) *FeedUpdateOne {
+	fuo.mutation.AddMaxInterval(i)
+	return fuo
+}
+
+// ClearMaxInterval clears the value of the "max_interval" field.
+func (fuo *FeedUpdateOne) ClearMaxInterval() *FeedUpdateOne {
+	fuo.mutation.ClearMaxInterval()
+	return fuo
+}
+
+// SetHubURL sets the "hub_url" field.
+func (fuo *FeedUpdateOne) SetHubURL(s string) *FeedUpdateOne {
+	fuo.mutation.SetHubURL(s)
+	return fuo
+}
+
+// SetNillableHubURL sets the "hub_url" field if the given value is not nil.
+func (fuo *FeedUpdateOne) SetNillableHubURL(s *string) *FeedUpdateOne {
+	if s != nil {
+		fuo.SetHubURL(*s)
+	}
+	return fuo
+}
+
+// ClearHubURL clears the value of the "hub_url" field.
+func (fuo *FeedUpdateOne) ClearHubURL() *FeedUpdateOne {
+	fuo.mutation.ClearHubURL()
+	return fuo
+}
+
+// SetTopicURL sets the "topic_url" field.
+func (fuo *FeedUpdateOne) SetTopicURL(s string) *FeedUpdateOne {
+	fuo.mutation.SetTopicURL(s)
+	return fuo
+}
+
+// SetNillableTopicURL sets the "topic_url" field if the given value is not nil.
+func (fuo *FeedUpdateOne) SetNillableTopicURL(s *string) *FeedUpdateOne {
+	if s != nil {
+		fuo.SetTopicURL(*s)
+	}
+	return fuo
+}
+
+// ClearTopicURL clears the value of the "topic_url" field.
+func (fuo *FeedUpdateOne) ClearTopicURL() *FeedUpdateOne {
+	fuo.mutation.ClearTopicURL()
+	return fuo
+}
+
+// SetWebsubState sets the "websub_state" field.
+func (fuo *FeedUpdateOne) SetWebsubState(s string) *FeedUpdateOne {
+	fuo.mutation.SetWebsubState(s)
+	return fuo
+}
+
+// SetNillableWebsubState sets the "websub_state" field if the given value is not nil.
+func (fuo *FeedUpdateOne) SetNillableWebsubState(s *string) *FeedUpdateOne {
+	if s != nil {
+		fuo.SetWebsubState(*s)
+	}
+	return fuo
+}
+
+// ClearWebsubState clears the value of the "websub_state" field.
+func (fuo *FeedUpdateOne) ClearWebsubState() *FeedUpdateOne {
+	fuo.mutation.ClearWebsubState()
+	return fuo
+}
+
+// SetWebsubSecret sets the "websub_secret" field.
+func (fuo *FeedUpdateOne) SetWebsubSecret(s string) *FeedUpdateOne {
+	fuo.mutation.SetWebsubSecret(s)
+	return fuo
+}
+
+// SetNillableWebsubSecret sets the "websub_secret" field if the given value is not nil.
+func (fuo *FeedUpdateOne) SetNillableWebsubSecret(s *string) *FeedUpdateOne {
+	if s != nil {
+		fuo.SetWebsubSecret(*s)
+	}
+	return fuo
+}
+
+// ClearWebsubSecret clears the value of the "websub_secret" field.
+func (fuo *FeedUpdateOne) ClearWebsubSecret() *FeedUpdateOne {
+	fuo.mutation.ClearWebsubSecret()
+	return fuo
+}
+
+// SetWebsubExpiresAt sets the "websub_expires_at" field.
+func (fuo *FeedUpdateOne) SetWebsubExpiresAt(t time.Time) *FeedUpdateOne {
+	fuo.mutation.SetWebsubExpiresAt(t)
+	return fuo
+}
+
+// SetNillableWebsubExpiresAt sets the "websub_expires_at" field if the given value is not nil.
+func (fuo *FeedUpdateOne) SetNillableWebsubExpiresAt(t *time.Time) *FeedUpdateOne {
+	if t != nil {
+		fuo.SetWebsubExpiresAt(*t)
+	}
+	return fuo
+}
+
+// ClearWebsubExpiresAt clears the value of the "websub_expires_at" field.
+func (fuo *FeedUpdateOne) ClearWebsubExpiresAt() *FeedUpdateOne {
+	fuo.mutation.ClearWebsubExpiresAt()
+	return fuo
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (fuo *FeedUpdateOne) SetUpdatedAt(t time.Time) *FeedUpdateOne {
 	fuo.mutation.SetUpdatedAt(t)
@@ -778,6 +1414,90 @@ func (fuo *FeedUpdateOne) sqlSave(ctx context.Context) (_node *Feed, err error)
 	if fuo.mutation.LastCheckedAtCleared() {
 		_spec.ClearField(feed.FieldLastCheckedAt, field.TypeTime)
 	}
+	if value, ok := fuo.mutation.ConsecutiveFailures(); ok {
+		_spec.SetField(feed.FieldConsecutiveFailures, field.TypeInt, value)
+	}
+	if value, ok := fuo.mutation.AddedConsecutiveFailures(); ok {
+		_spec.AddField(feed.FieldConsecutiveFailures, field.TypeInt, value)
+	}
+	if value, ok := fuo.mutation.LastError(); ok {
+		_spec.SetField(feed.FieldLastError, field.TypeString, value)
+	}
+	if fuo.mutation.LastErrorCleared() {
+		_spec.ClearField(feed.FieldLastError, field.TypeString)
+	}
+	if value, ok := fuo.mutation.LastStatus(); ok {
+		_spec.SetField(feed.FieldLastStatus, field.TypeInt, value)
+	}
+	if value, ok := fuo.mutation.AddedLastStatus(); ok {
+		_spec.AddField(feed.FieldLastStatus, field.TypeInt, value)
+	}
+	if fuo.mutation.LastStatusCleared() {
+		_spec.ClearField(feed.FieldLastStatus, field.TypeInt)
+	}
+	if value, ok := fuo.mutation.LastSuccessAt(); ok {
+		_spec.SetField(feed.FieldLastSuccessAt, field.TypeTime, value)
+	}
+	if fuo.mutation.LastSuccessAtCleared() {
+		_spec.ClearField(feed.FieldLastSuccessAt, field.TypeTime)
+	}
+	if value, ok := fuo.mutation.Disabled(); ok {
+		_spec.SetField(feed.FieldDisabled, field.TypeBool, value)
+	}
+	if value, ok := fuo.mutation.NextPollAt(); ok {
+		_spec.SetField(feed.FieldNextPollAt, field.TypeTime, value)
+	}
+	if fuo.mutation.NextPollAtCleared() {
+		_spec.ClearField(feed.FieldNextPollAt, field.TypeTime)
+	}
+	if value, ok := fuo.mutation.MinInterval(); ok {
+		_spec.SetField(feed.FieldMinInterval, field.TypeInt, value)
+	}
+	if value, ok := fuo.mutation.AddedMinInterval(); ok {
+		_spec.AddField(feed.FieldMinInterval, field.TypeInt, value)
+	}
+	if fuo.mutation.MinIntervalCleared() {
+		_spec.ClearField(feed.FieldMinInterval, field.TypeInt)
+	}
+	if value, ok := fuo.mutation.MaxInterval(); ok {
+		_spec.SetField(feed.FieldMaxInterval, field.TypeInt, value)
+	}
+	if value, ok := fuo.mutation.AddedMaxInterval(); ok {
+		_spec.AddField(feed.FieldMaxInterval, field.TypeInt, value)
+	}
+	if fuo.mutation.MaxIntervalCleared() {
+		_spec.ClearField(feed.FieldMaxInterval, field.TypeInt)
+	}
+	if value, ok := fuo.mutation.HubURL(); ok {
+		_spec.SetField(feed.FieldHubURL, field.TypeString, value)
+	}
+	if fuo.mutation.HubURLCleared() {
+		_spec.ClearField(feed.FieldHubURL, field.TypeString)
+	}
+	if value, ok := fuo.mutation.TopicURL(); ok {
+		_spec.SetField(feed.FieldTopicURL, field.TypeString, value)
+	}
+	if fuo.mutation.TopicURLCleared() {
+		_spec.ClearField(feed.FieldTopicURL, field.TypeString)
+	}
+	if value, ok := fuo.mutation.WebsubState(); ok {
+		_spec.SetField(feed.FieldWebsubState, field.TypeString, value)
+	}
+	if fuo.mutation.WebsubStateCleared() {
+		_spec.ClearField(feed.FieldWebsubState, field.TypeString)
+	}
+	if value, ok := fuo.mutation.WebsubSecret(); ok {
+		_spec.SetField(feed.FieldWebsubSecret, field.TypeString, value)
+	}
+	if fuo.mutation.WebsubSecretCleared() {
+		_spec.ClearField(feed.FieldWebsubSecret, field.TypeString)
+	}
+	if value, ok := fuo.mutation.WebsubExpiresAt(); ok {
+		_spec.SetField(feed.FieldWebsubExpiresAt, field.TypeTime, value)
+	}
+	if fuo.mutation.WebsubExpiresAtCleared() {
+		_spec.ClearField(feed.FieldWebsubExpiresAt, field.TypeTime)
+	}
 	if value, ok := fuo.mutation.UpdatedAt(); ok {
 		_spec.SetField(feed.FieldUpdatedAt, field.TypeTime, value)
 	}
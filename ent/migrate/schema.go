@@ -15,8 +15,13 @@ var (
 		{Name: "url", Type: field.TypeString, Unique: true},
 		{Name: "description", Type: field.TypeString, Nullable: true},
 		{Name: "content", Type: field.TypeString, Nullable: true},
+		{Name: "author", Type: field.TypeString, Nullable: true},
+		{Name: "categories", Type: field.TypeJSON, Nullable: true},
+		{Name: "image_url", Type: field.TypeString, Nullable: true},
+		{Name: "enclosures", Type: field.TypeJSON, Nullable: true},
 		{Name: "published_at", Type: field.TypeTime, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
+		{Name: "article_duplicates", Type: field.TypeUUID, Nullable: true},
 		{Name: "feed_articles", Type: field.TypeUUID},
 	}
 	// ArticlesTable holds the schema information for the "articles" table.
@@ -25,9 +30,15 @@ var (
 		Columns:    ArticlesColumns,
 		PrimaryKey: []*schema.Column{ArticlesColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "articles_articles_duplicates",
+				Columns:    []*schema.Column{ArticlesColumns[11]},
+				RefColumns: []*schema.Column{ArticlesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 			{
 				Symbol:     "articles_feeds_articles",
-				Columns:    []*schema.Column{ArticlesColumns[7]},
+				Columns:    []*schema.Column{ArticlesColumns[12]},
 				RefColumns: []*schema.Column{FeedsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -43,6 +54,19 @@ var (
 		{Name: "order", Type: field.TypeInt, Default: 1},
 		{Name: "is_bookmark", Type: field.TypeBool, Default: false},
 		{Name: "last_checked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "consecutive_failures", Type: field.TypeInt, Default: 0},
+		{Name: "last_error", Type: field.TypeString, Nullable: true},
+		{Name: "last_status", Type: field.TypeInt, Nullable: true},
+		{Name: "last_success_at", Type: field.TypeTime, Nullable: true},
+		{Name: "disabled", Type: field.TypeBool, Default: false},
+		{Name: "next_poll_at", Type: field.TypeTime, Nullable: true},
+		{Name: "min_interval", Type: field.TypeInt, Nullable: true},
+		{Name: "max_interval", Type: field.TypeInt, Nullable: true},
+		{Name: "hub_url", Type: field.TypeString, Nullable: true},
+		{Name: "topic_url", Type: field.TypeString, Nullable: true},
+		{Name: "websub_state", Type: field.TypeString, Nullable: true},
+		{Name: "websub_secret", Type: field.TypeString, Nullable: true},
+		{Name: "websub_expires_at", Type: field.TypeTime, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 	}
@@ -62,6 +86,9 @@ var (
 		{Name: "listened", Type: field.TypeBool, Default: false},
 		{Name: "audio_file", Type: field.TypeString, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
+		{Name: "tags", Type: field.TypeJSON, Nullable: true},
+		{Name: "priority", Type: field.TypeInt, Default: 0},
+		{Name: "mailed_at", Type: field.TypeTime, Nullable: true},
 		{Name: "article_summary", Type: field.TypeUUID, Unique: true, Nullable: true},
 		{Name: "feed_summaries", Type: field.TypeUUID},
 	}
@@ -73,28 +100,42 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "summaries_articles_summary",
-				Columns:    []*schema.Column{SummariesColumns[8]},
+				Columns:    []*schema.Column{SummariesColumns[11]},
 				RefColumns: []*schema.Column{ArticlesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "summaries_feeds_summaries",
-				Columns:    []*schema.Column{SummariesColumns[9]},
+				Columns:    []*schema.Column{SummariesColumns[12]},
 				RefColumns: []*schema.Column{FeedsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
 	}
+	// TombstonesColumns holds the columns for the "tombstones" table.
+	TombstonesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "url", Type: field.TypeString, Unique: true},
+		{Name: "pruned_at", Type: field.TypeTime},
+	}
+	// TombstonesTable holds the schema information for the "tombstones" table.
+	TombstonesTable = &schema.Table{
+		Name:       "tombstones",
+		Columns:    TombstonesColumns,
+		PrimaryKey: []*schema.Column{TombstonesColumns[0]},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		ArticlesTable,
 		FeedsTable,
 		SummariesTable,
+		TombstonesTable,
 	}
 )
 
 func init() {
-	ArticlesTable.ForeignKeys[0].RefTable = FeedsTable
+	ArticlesTable.ForeignKeys[0].RefTable = ArticlesTable
+	ArticlesTable.ForeignKeys[1].RefTable = FeedsTable
 	SummariesTable.ForeignKeys[0].RefTable = ArticlesTable
 	SummariesTable.ForeignKeys[1].RefTable = FeedsTable
 }
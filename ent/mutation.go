@@ -15,7 +15,9 @@ import (
 	"github.com/mopemope/quicknews/ent/article"
 	"github.com/mopemope/quicknews/ent/feed"
 	"github.com/mopemope/quicknews/ent/predicate"
+	"github.com/mopemope/quicknews/ent/schema"
 	"github.com/mopemope/quicknews/ent/summary"
+	"github.com/mopemope/quicknews/ent/tombstone"
 )
 
 const (
@@ -27,31 +29,43 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeArticle = "Article"
-	TypeFeed    = "Feed"
-	TypeSummary = "Summary"
+	TypeArticle   = "Article"
+	TypeFeed      = "Feed"
+	TypeSummary   = "Summary"
+	TypeTombstone = "Tombstone"
 )
 
 // ArticleMutation represents an operation that mutates the Article nodes in the graph.
 type ArticleMutation struct {
 	config
-	op             Op
-	typ            string
-	id             *uuid.UUID
-	title          *string
-	url            *string
-	description    *string
-	content        *string
-	published_at   *time.Time
-	created_at     *time.Time
-	clearedFields  map[string]struct{}
-	feed           *uuid.UUID
-	clearedfeed    bool
-	summary        *uuid.UUID
-	clearedsummary bool
-	done           bool
-	oldValue       func(context.Context) (*Article, error)
-	predicates     []predicate.Article
+	op                  Op
+	typ                 string
+	id                  *uuid.UUID
+	title               *string
+	url                 *string
+	description         *string
+	content             *string
+	author              *string
+	categories          *[]string
+	appendcategories    []string
+	image_url           *string
+	enclosures          *[]schema.Enclosure
+	appendenclosures    []schema.Enclosure
+	published_at        *time.Time
+	created_at          *time.Time
+	clearedFields       map[string]struct{}
+	feed                *uuid.UUID
+	clearedfeed         bool
+	summary             *uuid.UUID
+	clearedsummary      bool
+	duplicate_of        *uuid.UUID
+	clearedduplicate_of bool
+	duplicates          map[uuid.UUID]struct{}
+	removedduplicates   map[uuid.UUID]struct{}
+	clearedduplicates   bool
+	done                bool
+	oldValue            func(context.Context) (*Article, error)
+	predicates          []predicate.Article
 }
 
 var _ ent.Mutation = (*ArticleMutation)(nil)
@@ -328,6 +342,234 @@ func (m *ArticleMutation) ResetContent() {
 	delete(m.clearedFields, article.FieldContent)
 }
 
+// SetAuthor sets the "author" field.
+func (m *ArticleMutation) SetAuthor(s string) {
+	m.author = &s
+}
+
+// Author returns the value of the "author" field in the mutation.
+func (m *ArticleMutation) Author() (r string, exists bool) {
+	v := m.author
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAuthor returns the old "author" field's value of the Article entity.
+// If the Article object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ArticleMutation) OldAuthor(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAuthor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAuthor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAuthor: %w", err)
+	}
+	return oldValue.Author, nil
+}
+
+// ClearAuthor clears the value of the "author" field.
+func (m *ArticleMutation) ClearAuthor() {
+	m.author = nil
+	m.clearedFields[article.FieldAuthor] = struct{}{}
+}
+
+// AuthorCleared returns if the "author" field was cleared in this mutation.
+func (m *ArticleMutation) AuthorCleared() bool {
+	_, ok := m.clearedFields[article.FieldAuthor]
+	return ok
+}
+
+// ResetAuthor resets all changes to the "author" field.
+func (m *ArticleMutation) ResetAuthor() {
+	m.author = nil
+	delete(m.clearedFields, article.FieldAuthor)
+}
+
+// SetCategories sets the "categories" field.
+func (m *ArticleMutation) SetCategories(s []string) {
+	m.categories = &s
+	m.appendcategories = nil
+}
+
+// Categories returns the value of the "categories" field in the mutation.
+func (m *ArticleMutation) Categories() (r []string, exists bool) {
+	v := m.categories
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCategories returns the old "categories" field's value of the Article entity.
+// If the Article object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ArticleMutation) OldCategories(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCategories is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCategories requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCategories: %w", err)
+	}
+	return oldValue.Categories, nil
+}
+
+// AppendCategories adds s to the "categories" field.
+func (m *ArticleMutation) AppendCategories(s []string) {
+	m.appendcategories = append(m.appendcategories, s...)
+}
+
+// AppendedCategories returns the list of values that were appended to the "categories" field in this mutation.
+func (m *ArticleMutation) AppendedCategories() ([]string, bool) {
+	if len(m.appendcategories) == 0 {
+		return nil, false
+	}
+	return m.appendcategories, true
+}
+
+// ClearCategories clears the value of the "categories" field.
+func (m *ArticleMutation) ClearCategories() {
+	m.categories = nil
+	m.appendcategories = nil
+	m.clearedFields[article.FieldCategories] = struct{}{}
+}
+
+// CategoriesCleared returns if the "categories" field was cleared in this mutation.
+func (m *ArticleMutation) CategoriesCleared() bool {
+	_, ok := m.clearedFields[article.FieldCategories]
+	return ok
+}
+
+// ResetCategories resets all changes to the "categories" field.
+func (m *ArticleMutation) ResetCategories() {
+	m.categories = nil
+	m.appendcategories = nil
+	delete(m.clearedFields, article.FieldCategories)
+}
+
+// SetImageURL sets the "image_url" field.
+func (m *ArticleMutation) SetImageURL(s string) {
+	m.image_url = &s
+}
+
+// ImageURL returns the value of the "image_url" field in the mutation.
+func (m *ArticleMutation) ImageURL() (r string, exists bool) {
+	v := m.image_url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldImageURL returns the old "image_url" field's value of the Article entity.
+// If the Article object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ArticleMutation) OldImageURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldImageURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldImageURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldImageURL: %w", err)
+	}
+	return oldValue.ImageURL, nil
+}
+
+// ClearImageURL clears the value of the "image_url" field.
+func (m *ArticleMutation) ClearImageURL() {
+	m.image_url = nil
+	m.clearedFields[article.FieldImageURL] = struct{}{}
+}
+
+// ImageURLCleared returns if the "image_url" field was cleared in this mutation.
+func (m *ArticleMutation) ImageURLCleared() bool {
+	_, ok := m.clearedFields[article.FieldImageURL]
+	return ok
+}
+
+// ResetImageURL resets all changes to the "image_url" field.
+func (m *ArticleMutation) ResetImageURL() {
+	m.image_url = nil
+	delete(m.clearedFields, article.FieldImageURL)
+}
+
+// SetEnclosures sets the "enclosures" field.
+func (m *ArticleMutation) SetEnclosures(s []schema.Enclosure) {
+	m.enclosures = &s
+	m.appendenclosures = nil
+}
+
+// Enclosures returns the value of the "enclosures" field in the mutation.
+func (m *ArticleMutation) Enclosures() (r []schema.Enclosure, exists bool) {
+	v := m.enclosures
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnclosures returns the old "enclosures" field's value of the Article entity.
+// If the Article object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ArticleMutation) OldEnclosures(ctx context.Context) (v []schema.Enclosure, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnclosures is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnclosures requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnclosures: %w", err)
+	}
+	return oldValue.Enclosures, nil
+}
+
+// AppendEnclosures adds s to the "enclosures" field.
+func (m *ArticleMutation) AppendEnclosures(s []schema.Enclosure) {
+	m.appendenclosures = append(m.appendenclosures, s...)
+}
+
+// AppendedEnclosures returns the list of values that were appended to the "enclosures" field in this mutation.
+func (m *ArticleMutation) AppendedEnclosures() ([]schema.Enclosure, bool) {
+	if len(m.appendenclosures) == 0 {
+		return nil, false
+	}
+	return m.appendenclosures, true
+}
+
+// ClearEnclosures clears the value of the "enclosures" field.
+func (m *ArticleMutation) ClearEnclosures() {
+	m.enclosures = nil
+	m.appendenclosures = nil
+	m.clearedFields[article.FieldEnclosures] = struct{}{}
+}
+
+// EnclosuresCleared returns if the "enclosures" field was cleared in this mutation.
+func (m *ArticleMutation) EnclosuresCleared() bool {
+	_, ok := m.clearedFields[article.FieldEnclosures]
+	return ok
+}
+
+// ResetEnclosures resets all changes to the "enclosures" field.
+func (m *ArticleMutation) ResetEnclosures() {
+	m.enclosures = nil
+	m.appendenclosures = nil
+	delete(m.clearedFields, article.FieldEnclosures)
+}
+
 // SetPublishedAt sets the "published_at" field.
 func (m *ArticleMutation) SetPublishedAt(t time.Time) {
 	m.published_at = &t
@@ -491,6 +733,99 @@ func (m *ArticleMutation) ResetSummary() {
 	m.clearedsummary = false
 }
 
+// SetDuplicateOfID sets the "duplicate_of" edge to the Article entity by id.
+func (m *ArticleMutation) SetDuplicateOfID(id uuid.UUID) {
+	m.duplicate_of = &id
+}
+
+// ClearDuplicateOf clears the "duplicate_of" edge to the Article entity.
+func (m *ArticleMutation) ClearDuplicateOf() {
+	m.clearedduplicate_of = true
+}
+
+// DuplicateOfCleared reports if the "duplicate_of" edge to the Article entity was cleared.
+func (m *ArticleMutation) DuplicateOfCleared() bool {
+	return m.clearedduplicate_of
+}
+
+// DuplicateOfID returns the "duplicate_of" edge ID in the mutation.
+func (m *ArticleMutation) DuplicateOfID() (id uuid.UUID, exists bool) {
+	if m.duplicate_of != nil {
+		return *m.duplicate_of, true
+	}
+	return
+}
+
+// DuplicateOfIDs returns the "duplicate_of" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// DuplicateOfID instead. It exists only for internal usage by the builders.
+func (m *ArticleMutation) DuplicateOfIDs() (ids []uuid.UUID) {
+	if id := m.duplicate_of; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetDuplicateOf resets all changes to the "duplicate_of" edge.
+func (m *ArticleMutation) ResetDuplicateOf() {
+	m.duplicate_of = nil
+	m.clearedduplicate_of = false
+}
+
+// AddDuplicateIDs adds the "duplicates" edge to the Article entity by ids.
+func (m *ArticleMutation) AddDuplicateIDs(ids ...uuid.UUID) {
+	if m.duplicates == nil {
+		m.duplicates = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.duplicates[ids[i]] = struct{}{}
+	}
+}
+
+// ClearDuplicates clears the "duplicates" edge to the Article entity.
+func (m *ArticleMutation) ClearDuplicates() {
+	m.clearedduplicates = true
+}
+
+// DuplicatesCleared reports if the "duplicates" edge to the Article entity was cleared.
+func (m *ArticleMutation) DuplicatesCleared() bool {
+	return m.clearedduplicates
+}
+
+// RemoveDuplicateIDs removes the "duplicates" edge to the Article entity by IDs.
+func (m *ArticleMutation) RemoveDuplicateIDs(ids ...uuid.UUID) {
+	if m.removedduplicates == nil {
+		m.removedduplicates = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.duplicates, ids[i])
+		m.removedduplicates[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedDuplicates returns the removed IDs of the "duplicates" edge to the Article entity.
+func (m *ArticleMutation) RemovedDuplicatesIDs() (ids []uuid.UUID) {
+	for id := range m.removedduplicates {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// DuplicatesIDs returns the "duplicates" edge IDs in the mutation.
+func (m *ArticleMutation) DuplicatesIDs() (ids []uuid.UUID) {
+	for id := range m.duplicates {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetDuplicates resets all changes to the "duplicates" edge.
+func (m *ArticleMutation) ResetDuplicates() {
+	m.duplicates = nil
+	m.clearedduplicates = false
+	m.removedduplicates = nil
+}
+
 // Where appends a list predicates to the ArticleMutation builder.
 func (m *ArticleMutation) Where(ps ...predicate.Article) {
 	m.predicates = append(m.predicates, ps...)
@@ -525,7 +860,7 @@ func (m *ArticleMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *ArticleMutation) Fields() []string {
-	fields := make([]string, 0, 6)
+	fields := make([]string, 0, 10)
 	if m.title != nil {
 		fields = append(fields, article.FieldTitle)
 	}
@@ -538,6 +873,18 @@ func (m *ArticleMutation) Fields() []string {
 	if m.content != nil {
 		fields = append(fields, article.FieldContent)
 	}
+	if m.author != nil {
+		fields = append(fields, article.FieldAuthor)
+	}
+	if m.categories != nil {
+		fields = append(fields, article.FieldCategories)
+	}
+	if m.image_url != nil {
+		fields = append(fields, article.FieldImageURL)
+	}
+	if m.enclosures != nil {
+		fields = append(fields, article.FieldEnclosures)
+	}
 	if m.published_at != nil {
 		fields = append(fields, article.FieldPublishedAt)
 	}
@@ -560,6 +907,14 @@ func (m *ArticleMutation) Field(name string) (ent.Value, bool) {
 		return m.Description()
 	case article.FieldContent:
 		return m.Content()
+	case article.FieldAuthor:
+		return m.Author()
+	case article.FieldCategories:
+		return m.Categories()
+	case article.FieldImageURL:
+		return m.ImageURL()
+	case article.FieldEnclosures:
+		return m.Enclosures()
 	case article.FieldPublishedAt:
 		return m.PublishedAt()
 	case article.FieldCreatedAt:
@@ -581,6 +936,14 @@ func (m *ArticleMutation) OldField(ctx context.Context, name string) (ent.Value,
 		return m.OldDescription(ctx)
 	case article.FieldContent:
 		return m.OldContent(ctx)
+	case article.FieldAuthor:
+		return m.OldAuthor(ctx)
+	case article.FieldCategories:
+		return m.OldCategories(ctx)
+	case article.FieldImageURL:
+		return m.OldImageURL(ctx)
+	case article.FieldEnclosures:
+		return m.OldEnclosures(ctx)
 	case article.FieldPublishedAt:
 		return m.OldPublishedAt(ctx)
 	case article.FieldCreatedAt:
@@ -622,6 +985,34 @@ func (m *ArticleMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetContent(v)
 		return nil
+	case article.FieldAuthor:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAuthor(v)
+		return nil
+	case article.FieldCategories:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCategories(v)
+		return nil
+	case article.FieldImageURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetImageURL(v)
+		return nil
+	case article.FieldEnclosures:
+		v, ok := value.([]schema.Enclosure)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnclosures(v)
+		return nil
 	case article.FieldPublishedAt:
 		v, ok := value.(time.Time)
 		if !ok {
@@ -672,6 +1063,18 @@ func (m *ArticleMutation) ClearedFields() []string {
 	if m.FieldCleared(article.FieldContent) {
 		fields = append(fields, article.FieldContent)
 	}
+	if m.FieldCleared(article.FieldAuthor) {
+		fields = append(fields, article.FieldAuthor)
+	}
+	if m.FieldCleared(article.FieldCategories) {
+		fields = append(fields, article.FieldCategories)
+	}
+	if m.FieldCleared(article.FieldImageURL) {
+		fields = append(fields, article.FieldImageURL)
+	}
+	if m.FieldCleared(article.FieldEnclosures) {
+		fields = append(fields, article.FieldEnclosures)
+	}
 	if m.FieldCleared(article.FieldPublishedAt) {
 		fields = append(fields, article.FieldPublishedAt)
 	}
@@ -695,6 +1098,18 @@ func (m *ArticleMutation) ClearField(name string) error {
 	case article.FieldContent:
 		m.ClearContent()
 		return nil
+	case article.FieldAuthor:
+		m.ClearAuthor()
+		return nil
+	case article.FieldCategories:
+		m.ClearCategories()
+		return nil
+	case article.FieldImageURL:
+		m.ClearImageURL()
+		return nil
+	case article.FieldEnclosures:
+		m.ClearEnclosures()
+		return nil
 	case article.FieldPublishedAt:
 		m.ClearPublishedAt()
 		return nil
@@ -718,6 +1133,18 @@ func (m *ArticleMutation) ResetField(name string) error {
 	case article.FieldContent:
 		m.ResetContent()
 		return nil
+	case article.FieldAuthor:
+		m.ResetAuthor()
+		return nil
+	case article.FieldCategories:
+		m.ResetCategories()
+		return nil
+	case article.FieldImageURL:
+		m.ResetImageURL()
+		return nil
+	case article.FieldEnclosures:
+		m.ResetEnclosures()
+		return nil
 	case article.FieldPublishedAt:
 		m.ResetPublishedAt()
 		return nil
@@ -730,13 +1157,19 @@ func (m *ArticleMutation) ResetField(name string) error {
 
 // AddedEdges returns all edge names that were set/added in this mutation.
 func (m *ArticleMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
+	edges := make([]string, 0, 4)
 	if m.feed != nil {
 		edges = append(edges, article.EdgeFeed)
 	}
 	if m.summary != nil {
 		edges = append(edges, article.EdgeSummary)
 	}
+	if m.duplicate_of != nil {
+		edges = append(edges, article.EdgeDuplicateOf)
+	}
+	if m.duplicates != nil {
+		edges = append(edges, article.EdgeDuplicates)
+	}
 	return edges
 }
 
@@ -752,31 +1185,58 @@ func (m *ArticleMutation) AddedIDs(name string) []ent.Value {
 		if id := m.summary; id != nil {
 			return []ent.Value{*id}
 		}
+	case article.EdgeDuplicateOf:
+		if id := m.duplicate_of; id != nil {
+			return []ent.Value{*id}
+		}
+	case article.EdgeDuplicates:
+		ids := make([]ent.Value, 0, len(m.duplicates))
+		for id := range m.duplicates {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
 func (m *ArticleMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+	edges := make([]string, 0, 4)
+	if m.removedduplicates != nil {
+		edges = append(edges, article.EdgeDuplicates)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
 func (m *ArticleMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case article.EdgeDuplicates:
+		ids := make([]ent.Value, 0, len(m.removedduplicates))
+		for id := range m.removedduplicates {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
 func (m *ArticleMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
+	edges := make([]string, 0, 4)
 	if m.clearedfeed {
 		edges = append(edges, article.EdgeFeed)
 	}
 	if m.clearedsummary {
 		edges = append(edges, article.EdgeSummary)
 	}
+	if m.clearedduplicate_of {
+		edges = append(edges, article.EdgeDuplicateOf)
+	}
+	if m.clearedduplicates {
+		edges = append(edges, article.EdgeDuplicates)
+	}
 	return edges
 }
 
@@ -788,6 +1248,10 @@ func (m *ArticleMutation) EdgeCleared(name string) bool {
 		return m.clearedfeed
 	case article.EdgeSummary:
 		return m.clearedsummary
+	case article.EdgeDuplicateOf:
+		return m.clearedduplicate_of
+	case article.EdgeDuplicates:
+		return m.clearedduplicates
 	}
 	return false
 }
@@ -802,6 +1266,9 @@ func (m *ArticleMutation) ClearEdge(name string) error {
 	case article.EdgeSummary:
 		m.ClearSummary()
 		return nil
+	case article.EdgeDuplicateOf:
+		m.ClearDuplicateOf()
+		return nil
 	}
 	return fmt.Errorf("unknown Article unique edge %s", name)
 }
@@ -816,6 +1283,12 @@ func (m *ArticleMutation) ResetEdge(name string) error {
 	case article.EdgeSummary:
 		m.ResetSummary()
 		return nil
+	case article.EdgeDuplicateOf:
+		m.ResetDuplicateOf()
+		return nil
+	case article.EdgeDuplicates:
+		m.ResetDuplicates()
+		return nil
 	}
 	return fmt.Errorf("unknown Article edge %s", name)
 }
@@ -823,29 +1296,46 @@ func (m *ArticleMutation) ResetEdge(name string) error {
 // FeedMutation represents an operation that mutates the Feed nodes in the graph.
 type FeedMutation struct {
 	config
-	op               Op
-	typ              string
-	id               *uuid.UUID
-	url              *string
-	title            *string
-	description      *string
-	link             *string
-	_order           *int
-	add_order        *int
-	is_bookmark      *bool
-	last_checked_at  *time.Time
-	created_at       *time.Time
-	updated_at       *time.Time
-	clearedFields    map[string]struct{}
-	articles         map[uuid.UUID]struct{}
-	removedarticles  map[uuid.UUID]struct{}
-	clearedarticles  bool
-	summaries        map[uuid.UUID]struct{}
-	removedsummaries map[uuid.UUID]struct{}
-	clearedsummaries bool
-	done             bool
-	oldValue         func(context.Context) (*Feed, error)
-	predicates       []predicate.Feed
+	op                      Op
+	typ                     string
+	id                      *uuid.UUID
+	url                     *string
+	title                   *string
+	description             *string
+	link                    *string
+	_order                  *int
+	add_order               *int
+	is_bookmark             *bool
+	last_checked_at         *time.Time
+	consecutive_failures    *int
+	addconsecutive_failures *int
+	last_error              *string
+	last_status             *int
+	addlast_status          *int
+	last_success_at         *time.Time
+	disabled                *bool
+	next_poll_at            *time.Time
+	min_interval            *int
+	addmin_interval         *int
+	max_interval            *int
+	addmax_interval         *int
+	hub_url                 *string
+	topic_url               *string
+	websub_state            *string
+	websub_secret           *string
+	websub_expires_at       *time.Time
+	created_at              *time.Time
+	updated_at              *time.Time
+	clearedFields           map[string]struct{}
+	articles                map[uuid.UUID]struct{}
+	removedarticles         map[uuid.UUID]struct{}
+	clearedarticles         bool
+	summaries               map[uuid.UUID]struct{}
+	removedsummaries        map[uuid.UUID]struct{}
+	clearedsummaries        bool
+	done                    bool
+	oldValue                func(context.Context) (*Feed, error)
+	predicates              []predicate.Feed
 }
 
 var _ ent.Mutation = (*FeedMutation)(nil)
@@ -1263,1104 +1753,2922 @@ func (m *FeedMutation) ResetLastCheckedAt() {
 	delete(m.clearedFields, feed.FieldLastCheckedAt)
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *FeedMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetConsecutiveFailures sets the "consecutive_failures" field.
+func (m *FeedMutation) SetConsecutiveFailures(i int) {
+	m.consecutive_failures = &i
+	m.addconsecutive_failures = nil
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *FeedMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// ConsecutiveFailures returns the value of the "consecutive_failures" field in the mutation.
+func (m *FeedMutation) ConsecutiveFailures() (r int, exists bool) {
+	v := m.consecutive_failures
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Feed entity.
+// OldConsecutiveFailures returns the old "consecutive_failures" field's value of the Feed entity.
 // If the Feed object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FeedMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *FeedMutation) OldConsecutiveFailures(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldConsecutiveFailures is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldConsecutiveFailures requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldConsecutiveFailures: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.ConsecutiveFailures, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *FeedMutation) ResetCreatedAt() {
-	m.created_at = nil
+// AddConsecutiveFailures adds i to the "consecutive_failures" field.
+func (m *FeedMutation) AddConsecutiveFailures(i int) {
+	if m.addconsecutive_failures != nil {
+		*m.addconsecutive_failures += i
+	} else {
+		m.addconsecutive_failures = &i
+	}
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *FeedMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// AddedConsecutiveFailures returns the value that was added to the "consecutive_failures" field in this mutation.
+func (m *FeedMutation) AddedConsecutiveFailures() (r int, exists bool) {
+	v := m.addconsecutive_failures
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *FeedMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// ResetConsecutiveFailures resets all changes to the "consecutive_failures" field.
+func (m *FeedMutation) ResetConsecutiveFailures() {
+	m.consecutive_failures = nil
+	m.addconsecutive_failures = nil
+}
+
+// SetLastError sets the "last_error" field.
+func (m *FeedMutation) SetLastError(s string) {
+	m.last_error = &s
+}
+
+// LastError returns the value of the "last_error" field in the mutation.
+func (m *FeedMutation) LastError() (r string, exists bool) {
+	v := m.last_error
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Feed entity.
+// OldLastError returns the old "last_error" field's value of the Feed entity.
 // If the Feed object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FeedMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *FeedMutation) OldLastError(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldLastError is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldLastError requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldLastError: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.LastError, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *FeedMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ClearLastError clears the value of the "last_error" field.
+func (m *FeedMutation) ClearLastError() {
+	m.last_error = nil
+	m.clearedFields[feed.FieldLastError] = struct{}{}
 }
 
-// AddArticleIDs adds the "articles" edge to the Article entity by ids.
-func (m *FeedMutation) AddArticleIDs(ids ...uuid.UUID) {
-	if m.articles == nil {
-		m.articles = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.articles[ids[i]] = struct{}{}
-	}
+// LastErrorCleared returns if the "last_error" field was cleared in this mutation.
+func (m *FeedMutation) LastErrorCleared() bool {
+	_, ok := m.clearedFields[feed.FieldLastError]
+	return ok
 }
 
-// ClearArticles clears the "articles" edge to the Article entity.
-func (m *FeedMutation) ClearArticles() {
-	m.clearedarticles = true
+// ResetLastError resets all changes to the "last_error" field.
+func (m *FeedMutation) ResetLastError() {
+	m.last_error = nil
+	delete(m.clearedFields, feed.FieldLastError)
 }
 
-// ArticlesCleared reports if the "articles" edge to the Article entity was cleared.
-func (m *FeedMutation) ArticlesCleared() bool {
-	return m.clearedarticles
+// SetLastStatus sets the "last_status" field.
+func (m *FeedMutation) SetLastStatus(i int) {
+	m.last_status = &i
+	m.addlast_status = nil
 }
 
-// RemoveArticleIDs removes the "articles" edge to the Article entity by IDs.
-func (m *FeedMutation) RemoveArticleIDs(ids ...uuid.UUID) {
-	if m.removedarticles == nil {
-		m.removedarticles = make(map[uuid.UUID]struct{})
+// LastStatus returns the value of the "last_status" field in the mutation.
+func (m *FeedMutation) LastStatus() (r int, exists bool) {
+	v := m.last_status
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		delete(m.articles, ids[i])
-		m.removedarticles[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldLastStatus returns the old "last_status" field's value of the Feed entity.
+// If the Feed object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FeedMutation) OldLastStatus(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastStatus: %w", err)
 	}
+	return oldValue.LastStatus, nil
 }
 
-// RemovedArticles returns the removed IDs of the "articles" edge to the Article entity.
-func (m *FeedMutation) RemovedArticlesIDs() (ids []uuid.UUID) {
-	for id := range m.removedarticles {
-		ids = append(ids, id)
+// AddLastStatus adds i to the "last_status" field.
+func (m *FeedMutation) AddLastStatus(i int) {
+	if m.addlast_status != nil {
+		*m.addlast_status += i
+	} else {
+		m.addlast_status = &i
 	}
-	return
 }
 
-// ArticlesIDs returns the "articles" edge IDs in the mutation.
-func (m *FeedMutation) ArticlesIDs() (ids []uuid.UUID) {
-	for id := range m.articles {
-		ids = append(ids, id)
+// AddedLastStatus returns the value that was added to the "last_status" field in this mutation.
+func (m *FeedMutation) AddedLastStatus() (r int, exists bool) {
+	v := m.addlast_status
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetArticles resets all changes to the "articles" edge.
-func (m *FeedMutation) ResetArticles() {
-	m.articles = nil
-	m.clearedarticles = false
-	m.removedarticles = nil
+// ClearLastStatus clears the value of the "last_status" field.
+func (m *FeedMutation) ClearLastStatus() {
+	m.last_status = nil
+	m.addlast_status = nil
+	m.clearedFields[feed.FieldLastStatus] = struct{}{}
 }
 
-// AddSummaryIDs adds the "summaries" edge to the Summary entity by ids.
-func (m *FeedMutation) AddSummaryIDs(ids ...uuid.UUID) {
-	if m.summaries == nil {
-		m.summaries = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.summaries[ids[i]] = struct{}{}
-	}
+// LastStatusCleared returns if the "last_status" field was cleared in this mutation.
+func (m *FeedMutation) LastStatusCleared() bool {
+	_, ok := m.clearedFields[feed.FieldLastStatus]
+	return ok
 }
 
-// ClearSummaries clears the "summaries" edge to the Summary entity.
-func (m *FeedMutation) ClearSummaries() {
-	m.clearedsummaries = true
+// ResetLastStatus resets all changes to the "last_status" field.
+func (m *FeedMutation) ResetLastStatus() {
+	m.last_status = nil
+	m.addlast_status = nil
+	delete(m.clearedFields, feed.FieldLastStatus)
 }
 
-// SummariesCleared reports if the "summaries" edge to the Summary entity was cleared.
-func (m *FeedMutation) SummariesCleared() bool {
-	return m.clearedsummaries
+// SetLastSuccessAt sets the "last_success_at" field.
+func (m *FeedMutation) SetLastSuccessAt(t time.Time) {
+	m.last_success_at = &t
 }
 
-// RemoveSummaryIDs removes the "summaries" edge to the Summary entity by IDs.
-func (m *FeedMutation) RemoveSummaryIDs(ids ...uuid.UUID) {
-	if m.removedsummaries == nil {
-		m.removedsummaries = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.summaries, ids[i])
-		m.removedsummaries[ids[i]] = struct{}{}
+// LastSuccessAt returns the value of the "last_success_at" field in the mutation.
+func (m *FeedMutation) LastSuccessAt() (r time.Time, exists bool) {
+	v := m.last_success_at
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedSummaries returns the removed IDs of the "summaries" edge to the Summary entity.
-func (m *FeedMutation) RemovedSummariesIDs() (ids []uuid.UUID) {
-	for id := range m.removedsummaries {
-		ids = append(ids, id)
+// OldLastSuccessAt returns the old "last_success_at" field's value of the Feed entity.
+// If the Feed object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FeedMutation) OldLastSuccessAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastSuccessAt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastSuccessAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastSuccessAt: %w", err)
+	}
+	return oldValue.LastSuccessAt, nil
 }
 
-// SummariesIDs returns the "summaries" edge IDs in the mutation.
-func (m *FeedMutation) SummariesIDs() (ids []uuid.UUID) {
-	for id := range m.summaries {
-		ids = append(ids, id)
-	}
-	return
+// ClearLastSuccessAt clears the value of the "last_success_at" field.
+func (m *FeedMutation) ClearLastSuccessAt() {
+	m.last_success_at = nil
+	m.clearedFields[feed.FieldLastSuccessAt] = struct{}{}
 }
 
-// ResetSummaries resets all changes to the "summaries" edge.
-func (m *FeedMutation) ResetSummaries() {
-	m.summaries = nil
-	m.clearedsummaries = false
-	m.removedsummaries = nil
+// LastSuccessAtCleared returns if the "last_success_at" field was cleared in this mutation.
+func (m *FeedMutation) LastSuccessAtCleared() bool {
+	_, ok := m.clearedFields[feed.FieldLastSuccessAt]
+	return ok
 }
 
-// Where appends a list predicates to the FeedMutation builder.
-func (m *FeedMutation) Where(ps ...predicate.Feed) {
-	m.predicates = append(m.predicates, ps...)
+// ResetLastSuccessAt resets all changes to the "last_success_at" field.
+func (m *FeedMutation) ResetLastSuccessAt() {
+	m.last_success_at = nil
+	delete(m.clearedFields, feed.FieldLastSuccessAt)
 }
 
-// WhereP appends storage-level predicates to the FeedMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *FeedMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Feed, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// SetDisabled sets the "disabled" field.
+func (m *FeedMutation) SetDisabled(b bool) {
+	m.disabled = &b
+}
+
+// Disabled returns the value of the "disabled" field in the mutation.
+func (m *FeedMutation) Disabled() (r bool, exists bool) {
+	v := m.disabled
+	if v == nil {
+		return
 	}
-	m.Where(p...)
+	return *v, true
 }
 
-// Op returns the operation name.
-func (m *FeedMutation) Op() Op {
-	return m.op
+// OldDisabled returns the old "disabled" field's value of the Feed entity.
+// If the Feed object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FeedMutation) OldDisabled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDisabled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDisabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDisabled: %w", err)
+	}
+	return oldValue.Disabled, nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *FeedMutation) SetOp(op Op) {
-	m.op = op
+// ResetDisabled resets all changes to the "disabled" field.
+func (m *FeedMutation) ResetDisabled() {
+	m.disabled = nil
 }
 
-// Type returns the node type of this mutation (Feed).
-func (m *FeedMutation) Type() string {
-	return m.typ
+// SetNextPollAt sets the "next_poll_at" field.
+func (m *FeedMutation) SetNextPollAt(t time.Time) {
+	m.next_poll_at = &t
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *FeedMutation) Fields() []string {
-	fields := make([]string, 0, 9)
-	if m.url != nil {
-		fields = append(fields, feed.FieldURL)
-	}
-	if m.title != nil {
-		fields = append(fields, feed.FieldTitle)
+// NextPollAt returns the value of the "next_poll_at" field in the mutation.
+func (m *FeedMutation) NextPollAt() (r time.Time, exists bool) {
+	v := m.next_poll_at
+	if v == nil {
+		return
 	}
-	if m.description != nil {
-		fields = append(fields, feed.FieldDescription)
+	return *v, true
+}
+
+// OldNextPollAt returns the old "next_poll_at" field's value of the Feed entity.
+// If the Feed object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FeedMutation) OldNextPollAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNextPollAt is only allowed on UpdateOne operations")
 	}
-	if m.link != nil {
-		fields = append(fields, feed.FieldLink)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNextPollAt requires an ID field in the mutation")
 	}
-	if m._order != nil {
-		fields = append(fields, feed.FieldOrder)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNextPollAt: %w", err)
 	}
-	if m.is_bookmark != nil {
-		fields = append(fields, feed.FieldIsBookmark)
+	return oldValue.NextPollAt, nil
+}
+
+// ClearNextPollAt clears the value of the "next_poll_at" field.
+func (m *FeedMutation) ClearNextPollAt() {
+	m.next_poll_at = nil
+	m.clearedFields[feed.FieldNextPollAt] = struct{}{}
+}
+
+// NextPollAtCleared returns if the "next_poll_at" field was cleared in this mutation.
+func (m *FeedMutation) NextPollAtCleared() bool {
+	_, ok := m.clearedFields[feed.FieldNextPollAt]
+	return ok
+}
+
+// ResetNextPollAt resets all changes to the "next_poll_at" field.
+func (m *FeedMutation) ResetNextPollAt() {
+	m.next_poll_at = nil
+	delete(m.clearedFields, feed.FieldNextPollAt)
+}
+
+// SetMinInterval sets the "min_interval" field.
+func (m *FeedMutation) SetMinInterval(i int) {
+	m.min_interval = &i
+	m.addmin_interval = nil
+}
+
+// MinInterval returns the value of the "min_interval" field in the mutation.
+func (m *FeedMutation) MinInterval() (r int, exists bool) {
+	v := m.min_interval
+	if v == nil {
+		return
 	}
-	if m.last_checked_at != nil {
-		fields = append(fields, feed.FieldLastCheckedAt)
+	return *v, true
+}
+
+// OldMinInterval returns the old "min_interval" field's value of the Feed entity.
+// If the Feed object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FeedMutation) OldMinInterval(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMinInterval is only allowed on UpdateOne operations")
 	}
-	if m.created_at != nil {
-		fields = append(fields, feed.FieldCreatedAt)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMinInterval requires an ID field in the mutation")
 	}
-	if m.updated_at != nil {
-		fields = append(fields, feed.FieldUpdatedAt)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMinInterval: %w", err)
 	}
-	return fields
+	return oldValue.MinInterval, nil
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *FeedMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case feed.FieldURL:
-		return m.URL()
-	case feed.FieldTitle:
-		return m.Title()
-	case feed.FieldDescription:
-		return m.Description()
-	case feed.FieldLink:
-		return m.Link()
-	case feed.FieldOrder:
-		return m.Order()
-	case feed.FieldIsBookmark:
-		return m.IsBookmark()
-	case feed.FieldLastCheckedAt:
-		return m.LastCheckedAt()
-	case feed.FieldCreatedAt:
-		return m.CreatedAt()
-	case feed.FieldUpdatedAt:
-		return m.UpdatedAt()
+// AddMinInterval adds i to the "min_interval" field.
+func (m *FeedMutation) AddMinInterval(i int) {
+	if m.addmin_interval != nil {
+		*m.addmin_interval += i
+	} else {
+		m.addmin_interval = &i
 	}
-	return nil, false
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *FeedMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case feed.FieldURL:
-		return m.OldURL(ctx)
-	case feed.FieldTitle:
-		return m.OldTitle(ctx)
-	case feed.FieldDescription:
-		return m.OldDescription(ctx)
-	case feed.FieldLink:
-		return m.OldLink(ctx)
-	case feed.FieldOrder:
-		return m.OldOrder(ctx)
-	case feed.FieldIsBookmark:
-		return m.OldIsBookmark(ctx)
-	case feed.FieldLastCheckedAt:
-		return m.OldLastCheckedAt(ctx)
-	case feed.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case feed.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
+// AddedMinInterval returns the value that was added to the "min_interval" field in this mutation.
+func (m *FeedMutation) AddedMinInterval() (r int, exists bool) {
+	v := m.addmin_interval
+	if v == nil {
+		return
 	}
-	return nil, fmt.Errorf("unknown Feed field %s", name)
+	return *v, true
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *FeedMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case feed.FieldURL:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetURL(v)
-		return nil
-	case feed.FieldTitle:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTitle(v)
-		return nil
-	case feed.FieldDescription:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDescription(v)
-		return nil
-	case feed.FieldLink:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLink(v)
-		return nil
-	case feed.FieldOrder:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetOrder(v)
-		return nil
-	case feed.FieldIsBookmark:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIsBookmark(v)
-		return nil
-	case feed.FieldLastCheckedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLastCheckedAt(v)
-		return nil
-	case feed.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case feed.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
-	}
-	return fmt.Errorf("unknown Feed field %s", name)
+// ClearMinInterval clears the value of the "min_interval" field.
+func (m *FeedMutation) ClearMinInterval() {
+	m.min_interval = nil
+	m.addmin_interval = nil
+	m.clearedFields[feed.FieldMinInterval] = struct{}{}
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *FeedMutation) AddedFields() []string {
-	var fields []string
-	if m.add_order != nil {
-		fields = append(fields, feed.FieldOrder)
-	}
-	return fields
+// MinIntervalCleared returns if the "min_interval" field was cleared in this mutation.
+func (m *FeedMutation) MinIntervalCleared() bool {
+	_, ok := m.clearedFields[feed.FieldMinInterval]
+	return ok
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *FeedMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case feed.FieldOrder:
-		return m.AddedOrder()
-	}
-	return nil, false
+// ResetMinInterval resets all changes to the "min_interval" field.
+func (m *FeedMutation) ResetMinInterval() {
+	m.min_interval = nil
+	m.addmin_interval = nil
+	delete(m.clearedFields, feed.FieldMinInterval)
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *FeedMutation) AddField(name string, value ent.Value) error {
-	switch name {
-	case feed.FieldOrder:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddOrder(v)
-		return nil
+// SetMaxInterval sets the "max_interval" field.
+func (m *FeedMutation) SetMaxInterval(i int) {
+	m.max_interval = &i
+	m.addmax_interval = nil
+}
+
+// MaxInterval returns the value of the "max_interval" field in the mutation.
+func (m *FeedMutation) MaxInterval() (r int, exists bool) {
+	v := m.max_interval
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Feed numeric field %s", name)
+	return *v, true
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *FeedMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(feed.FieldDescription) {
-		fields = append(fields, feed.FieldDescription)
+// OldMaxInterval returns the old "max_interval" field's value of the Feed entity.
+// If the Feed object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FeedMutation) OldMaxInterval(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaxInterval is only allowed on UpdateOne operations")
 	}
-	if m.FieldCleared(feed.FieldLink) {
-		fields = append(fields, feed.FieldLink)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaxInterval requires an ID field in the mutation")
 	}
-	if m.FieldCleared(feed.FieldLastCheckedAt) {
-		fields = append(fields, feed.FieldLastCheckedAt)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxInterval: %w", err)
 	}
-	return fields
+	return oldValue.MaxInterval, nil
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *FeedMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
+// AddMaxInterval adds i to the "max_interval" field.
+func (m *FeedMutation) AddMaxInterval(i int) {
+	if m.addmax_interval != nil {
+		*m.addmax_interval += i
+	} else {
+		m.addmax_interval = &i
+	}
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *FeedMutation) ClearField(name string) error {
-	switch name {
-	case feed.FieldDescription:
-		m.ClearDescription()
-		return nil
-	case feed.FieldLink:
-		m.ClearLink()
-		return nil
-	case feed.FieldLastCheckedAt:
-		m.ClearLastCheckedAt()
-		return nil
+// AddedMaxInterval returns the value that was added to the "max_interval" field in this mutation.
+func (m *FeedMutation) AddedMaxInterval() (r int, exists bool) {
+	v := m.addmax_interval
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Feed nullable field %s", name)
+	return *v, true
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *FeedMutation) ResetField(name string) error {
-	switch name {
-	case feed.FieldURL:
-		m.ResetURL()
-		return nil
-	case feed.FieldTitle:
-		m.ResetTitle()
-		return nil
-	case feed.FieldDescription:
-		m.ResetDescription()
-		return nil
-	case feed.FieldLink:
-		m.ResetLink()
-		return nil
-	case feed.FieldOrder:
-		m.ResetOrder()
-		return nil
-	case feed.FieldIsBookmark:
-		m.ResetIsBookmark()
-		return nil
-	case feed.FieldLastCheckedAt:
-		m.ResetLastCheckedAt()
-		return nil
-	case feed.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case feed.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
-	}
-	return fmt.Errorf("unknown Feed field %s", name)
+// ClearMaxInterval clears the value of the "max_interval" field.
+func (m *FeedMutation) ClearMaxInterval() {
+	m.max_interval = nil
+	m.addmax_interval = nil
+	m.clearedFields[feed.FieldMaxInterval] = struct{}{}
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *FeedMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.articles != nil {
-		edges = append(edges, feed.EdgeArticles)
-	}
-	if m.summaries != nil {
-		edges = append(edges, feed.EdgeSummaries)
-	}
-	return edges
+// MaxIntervalCleared returns if the "max_interval" field was cleared in this mutation.
+func (m *FeedMutation) MaxIntervalCleared() bool {
+	_, ok := m.clearedFields[feed.FieldMaxInterval]
+	return ok
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *FeedMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case feed.EdgeArticles:
-		ids := make([]ent.Value, 0, len(m.articles))
-		for id := range m.articles {
-			ids = append(ids, id)
-		}
-		return ids
-	case feed.EdgeSummaries:
-		ids := make([]ent.Value, 0, len(m.summaries))
-		for id := range m.summaries {
-			ids = append(ids, id)
-		}
-		return ids
-	}
-	return nil
+// ResetMaxInterval resets all changes to the "max_interval" field.
+func (m *FeedMutation) ResetMaxInterval() {
+	m.max_interval = nil
+	m.addmax_interval = nil
+	delete(m.clearedFields, feed.FieldMaxInterval)
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *FeedMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removedarticles != nil {
-		edges = append(edges, feed.EdgeArticles)
-	}
-	if m.removedsummaries != nil {
-		edges = append(edges, feed.EdgeSummaries)
-	}
-	return edges
+// SetHubURL sets the "hub_url" field.
+func (m *FeedMutation) SetHubURL(s string) {
+	m.hub_url = &s
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *FeedMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case feed.EdgeArticles:
-		ids := make([]ent.Value, 0, len(m.removedarticles))
-		for id := range m.removedarticles {
-			ids = append(ids, id)
-		}
-		return ids
-	case feed.EdgeSummaries:
-		ids := make([]ent.Value, 0, len(m.removedsummaries))
-		for id := range m.removedsummaries {
-			ids = append(ids, id)
-		}
-		return ids
+// HubURL returns the value of the "hub_url" field in the mutation.
+func (m *FeedMutation) HubURL() (r string, exists bool) {
+	v := m.hub_url
+	if v == nil {
+		return
 	}
-	return nil
+	return *v, true
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *FeedMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedarticles {
-		edges = append(edges, feed.EdgeArticles)
+// OldHubURL returns the old "hub_url" field's value of the Feed entity.
+// If the Feed object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FeedMutation) OldHubURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHubURL is only allowed on UpdateOne operations")
 	}
-	if m.clearedsummaries {
-		edges = append(edges, feed.EdgeSummaries)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHubURL requires an ID field in the mutation")
 	}
-	return edges
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHubURL: %w", err)
+	}
+	return oldValue.HubURL, nil
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *FeedMutation) EdgeCleared(name string) bool {
-	switch name {
-	case feed.EdgeArticles:
-		return m.clearedarticles
-	case feed.EdgeSummaries:
-		return m.clearedsummaries
-	}
-	return false
+// ClearHubURL clears the value of the "hub_url" field.
+func (m *FeedMutation) ClearHubURL() {
+	m.hub_url = nil
+	m.clearedFields[feed.FieldHubURL] = struct{}{}
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *FeedMutation) ClearEdge(name string) error {
-	switch name {
-	}
-	return fmt.Errorf("unknown Feed unique edge %s", name)
+// HubURLCleared returns if the "hub_url" field was cleared in this mutation.
+func (m *FeedMutation) HubURLCleared() bool {
+	_, ok := m.clearedFields[feed.FieldHubURL]
+	return ok
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *FeedMutation) ResetEdge(name string) error {
-	switch name {
-	case feed.EdgeArticles:
-		m.ResetArticles()
-		return nil
-	case feed.EdgeSummaries:
-		m.ResetSummaries()
-		return nil
-	}
-	return fmt.Errorf("unknown Feed edge %s", name)
+// ResetHubURL resets all changes to the "hub_url" field.
+func (m *FeedMutation) ResetHubURL() {
+	m.hub_url = nil
+	delete(m.clearedFields, feed.FieldHubURL)
 }
 
-// SummaryMutation represents an operation that mutates the Summary nodes in the graph.
-type SummaryMutation struct {
-	config
-	op             Op
-	typ            string
-	id             *uuid.UUID
-	url            *string
-	title          *string
-	summary        *string
-	readed         *bool
-	listened       *bool
-	audio_file     *string
-	created_at     *time.Time
-	clearedFields  map[string]struct{}
-	article        *uuid.UUID
-	clearedarticle bool
-	feed           *uuid.UUID
-	clearedfeed    bool
-	done           bool
-	oldValue       func(context.Context) (*Summary, error)
-	predicates     []predicate.Summary
+// SetTopicURL sets the "topic_url" field.
+func (m *FeedMutation) SetTopicURL(s string) {
+	m.topic_url = &s
 }
 
-var _ ent.Mutation = (*SummaryMutation)(nil)
-
-// summaryOption allows management of the mutation configuration using functional options.
-type summaryOption func(*SummaryMutation)
-
-// newSummaryMutation creates new mutation for the Summary entity.
-func newSummaryMutation(c config, op Op, opts ...summaryOption) *SummaryMutation {
-	m := &SummaryMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeSummary,
-		clearedFields: make(map[string]struct{}),
-	}
-	for _, opt := range opts {
-		opt(m)
+// TopicURL returns the value of the "topic_url" field in the mutation.
+func (m *FeedMutation) TopicURL() (r string, exists bool) {
+	v := m.topic_url
+	if v == nil {
+		return
 	}
-	return m
+	return *v, true
 }
 
-// withSummaryID sets the ID field of the mutation.
-func withSummaryID(id uuid.UUID) summaryOption {
-	return func(m *SummaryMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *Summary
-		)
-		m.oldValue = func(ctx context.Context) (*Summary, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().Summary.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+// OldTopicURL returns the old "topic_url" field's value of the Feed entity.
+// If the Feed object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FeedMutation) OldTopicURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTopicURL is only allowed on UpdateOne operations")
 	}
-}
-
-// withSummary sets the old Summary of the mutation.
-func withSummary(node *Summary) summaryOption {
-	return func(m *SummaryMutation) {
-		m.oldValue = func(context.Context) (*Summary, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTopicURL requires an ID field in the mutation")
 	}
-}
-
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m SummaryMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
-}
-
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m SummaryMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTopicURL: %w", err)
 	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+	return oldValue.TopicURL, nil
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Summary entities.
-func (m *SummaryMutation) SetID(id uuid.UUID) {
-	m.id = &id
+// ClearTopicURL clears the value of the "topic_url" field.
+func (m *FeedMutation) ClearTopicURL() {
+	m.topic_url = nil
+	m.clearedFields[feed.FieldTopicURL] = struct{}{}
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *SummaryMutation) ID() (id uuid.UUID, exists bool) {
-	if m.id == nil {
-		return
-	}
-	return *m.id, true
+// TopicURLCleared returns if the "topic_url" field was cleared in this mutation.
+func (m *FeedMutation) TopicURLCleared() bool {
+	_, ok := m.clearedFields[feed.FieldTopicURL]
+	return ok
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *SummaryMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []uuid.UUID{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Summary.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
+// ResetTopicURL resets all changes to the "topic_url" field.
+func (m *FeedMutation) ResetTopicURL() {
+	m.topic_url = nil
+	delete(m.clearedFields, feed.FieldTopicURL)
 }
 
-// SetURL sets the "url" field.
-func (m *SummaryMutation) SetURL(s string) {
-	m.url = &s
+// SetWebsubState sets the "websub_state" field.
+func (m *FeedMutation) SetWebsubState(s string) {
+	m.websub_state = &s
 }
 
-// URL returns the value of the "url" field in the mutation.
-func (m *SummaryMutation) URL() (r string, exists bool) {
-	v := m.url
+// WebsubState returns the value of the "websub_state" field in the mutation.
+func (m *FeedMutation) WebsubState() (r string, exists bool) {
+	v := m.websub_state
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldURL returns the old "url" field's value of the Summary entity.
-// If the Summary object wasn't provided to the builder, the object is fetched from the database.
+// OldWebsubState returns the old "websub_state" field's value of the Feed entity.
+// If the Feed object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SummaryMutation) OldURL(ctx context.Context) (v string, err error) {
+func (m *FeedMutation) OldWebsubState(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldURL is only allowed on UpdateOne operations")
+		return v, errors.New("OldWebsubState is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldURL requires an ID field in the mutation")
+		return v, errors.New("OldWebsubState requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldURL: %w", err)
+		return v, fmt.Errorf("querying old value for OldWebsubState: %w", err)
 	}
-	return oldValue.URL, nil
+	return oldValue.WebsubState, nil
 }
 
-// ResetURL resets all changes to the "url" field.
-func (m *SummaryMutation) ResetURL() {
-	m.url = nil
+// ClearWebsubState clears the value of the "websub_state" field.
+func (m *FeedMutation) ClearWebsubState() {
+	m.websub_state = nil
+	m.clearedFields[feed.FieldWebsubState] = struct{}{}
 }
 
-// SetTitle sets the "title" field.
-func (m *SummaryMutation) SetTitle(s string) {
-	m.title = &s
+// WebsubStateCleared returns if the "websub_state" field was cleared in this mutation.
+func (m *FeedMutation) WebsubStateCleared() bool {
+	_, ok := m.clearedFields[feed.FieldWebsubState]
+	return ok
 }
 
-// Title returns the value of the "title" field in the mutation.
-func (m *SummaryMutation) Title() (r string, exists bool) {
-	v := m.title
+// ResetWebsubState resets all changes to the "websub_state" field.
+func (m *FeedMutation) ResetWebsubState() {
+	m.websub_state = nil
+	delete(m.clearedFields, feed.FieldWebsubState)
+}
+
+// SetWebsubSecret sets the "websub_secret" field.
+func (m *FeedMutation) SetWebsubSecret(s string) {
+	m.websub_secret = &s
+}
+
+// WebsubSecret returns the value of the "websub_secret" field in the mutation.
+func (m *FeedMutation) WebsubSecret() (r string, exists bool) {
+	v := m.websub_secret
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTitle returns the old "title" field's value of the Summary entity.
-// If the Summary object wasn't provided to the builder, the object is fetched from the database.
+// OldWebsubSecret returns the old "websub_secret" field's value of the Feed entity.
+// If the Feed object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SummaryMutation) OldTitle(ctx context.Context) (v string, err error) {
+func (m *FeedMutation) OldWebsubSecret(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+		return v, errors.New("OldWebsubSecret is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTitle requires an ID field in the mutation")
+		return v, errors.New("OldWebsubSecret requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+		return v, fmt.Errorf("querying old value for OldWebsubSecret: %w", err)
 	}
-	return oldValue.Title, nil
+	return oldValue.WebsubSecret, nil
 }
 
-// ClearTitle clears the value of the "title" field.
-func (m *SummaryMutation) ClearTitle() {
-	m.title = nil
-	m.clearedFields[summary.FieldTitle] = struct{}{}
+// ClearWebsubSecret clears the value of the "websub_secret" field.
+func (m *FeedMutation) ClearWebsubSecret() {
+	m.websub_secret = nil
+	m.clearedFields[feed.FieldWebsubSecret] = struct{}{}
 }
 
-// TitleCleared returns if the "title" field was cleared in this mutation.
-func (m *SummaryMutation) TitleCleared() bool {
-	_, ok := m.clearedFields[summary.FieldTitle]
+// WebsubSecretCleared returns if the "websub_secret" field was cleared in this mutation.
+func (m *FeedMutation) WebsubSecretCleared() bool {
+	_, ok := m.clearedFields[feed.FieldWebsubSecret]
 	return ok
 }
 
-// ResetTitle resets all changes to the "title" field.
-func (m *SummaryMutation) ResetTitle() {
-	m.title = nil
-	delete(m.clearedFields, summary.FieldTitle)
+// ResetWebsubSecret resets all changes to the "websub_secret" field.
+func (m *FeedMutation) ResetWebsubSecret() {
+	m.websub_secret = nil
+	delete(m.clearedFields, feed.FieldWebsubSecret)
 }
 
-// SetSummary sets the "summary" field.
-func (m *SummaryMutation) SetSummary(s string) {
-	m.summary = &s
+// SetWebsubExpiresAt sets the "websub_expires_at" field.
+func (m *FeedMutation) SetWebsubExpiresAt(t time.Time) {
+	m.websub_expires_at = &t
 }
 
-// Summary returns the value of the "summary" field in the mutation.
-func (m *SummaryMutation) Summary() (r string, exists bool) {
-	v := m.summary
+// WebsubExpiresAt returns the value of the "websub_expires_at" field in the mutation.
+func (m *FeedMutation) WebsubExpiresAt() (r time.Time, exists bool) {
+	v := m.websub_expires_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSummary returns the old "summary" field's value of the Summary entity.
-// If the Summary object wasn't provided to the builder, the object is fetched from the database.
+// OldWebsubExpiresAt returns the old "websub_expires_at" field's value of the Feed entity.
+// If the Feed object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SummaryMutation) OldSummary(ctx context.Context) (v string, err error) {
+func (m *FeedMutation) OldWebsubExpiresAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSummary is only allowed on UpdateOne operations")
+		return v, errors.New("OldWebsubExpiresAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSummary requires an ID field in the mutation")
+		return v, errors.New("OldWebsubExpiresAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSummary: %w", err)
+		return v, fmt.Errorf("querying old value for OldWebsubExpiresAt: %w", err)
 	}
-	return oldValue.Summary, nil
+	return oldValue.WebsubExpiresAt, nil
 }
 
-// ClearSummary clears the value of the "summary" field.
-func (m *SummaryMutation) ClearSummary() {
-	m.summary = nil
-	m.clearedFields[summary.FieldSummary] = struct{}{}
+// ClearWebsubExpiresAt clears the value of the "websub_expires_at" field.
+func (m *FeedMutation) ClearWebsubExpiresAt() {
+	m.websub_expires_at = nil
+	m.clearedFields[feed.FieldWebsubExpiresAt] = struct{}{}
 }
 
-// SummaryCleared returns if the "summary" field was cleared in this mutation.
-func (m *SummaryMutation) SummaryCleared() bool {
-	_, ok := m.clearedFields[summary.FieldSummary]
+// WebsubExpiresAtCleared returns if the "websub_expires_at" field was cleared in this mutation.
+func (m *FeedMutation) WebsubExpiresAtCleared() bool {
+	_, ok := m.clearedFields[feed.FieldWebsubExpiresAt]
 	return ok
 }
 
-// ResetSummary resets all changes to the "summary" field.
-func (m *SummaryMutation) ResetSummary() {
-	m.summary = nil
-	delete(m.clearedFields, summary.FieldSummary)
+// ResetWebsubExpiresAt resets all changes to the "websub_expires_at" field.
+func (m *FeedMutation) ResetWebsubExpiresAt() {
+	m.websub_expires_at = nil
+	delete(m.clearedFields, feed.FieldWebsubExpiresAt)
 }
 
-// SetReaded sets the "readed" field.
-func (m *SummaryMutation) SetReaded(b bool) {
-	m.readed = &b
+// SetCreatedAt sets the "created_at" field.
+func (m *FeedMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// Readed returns the value of the "readed" field in the mutation.
-func (m *SummaryMutation) Readed() (r bool, exists bool) {
-	v := m.readed
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *FeedMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldReaded returns the old "readed" field's value of the Summary entity.
-// If the Summary object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Feed entity.
+// If the Feed object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SummaryMutation) OldReaded(ctx context.Context) (v bool, err error) {
+func (m *FeedMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldReaded is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldReaded requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *FeedMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *FeedMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *FeedMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Feed entity.
+// If the Feed object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FeedMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *FeedMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// AddArticleIDs adds the "articles" edge to the Article entity by ids.
+func (m *FeedMutation) AddArticleIDs(ids ...uuid.UUID) {
+	if m.articles == nil {
+		m.articles = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.articles[ids[i]] = struct{}{}
+	}
+}
+
+// ClearArticles clears the "articles" edge to the Article entity.
+func (m *FeedMutation) ClearArticles() {
+	m.clearedarticles = true
+}
+
+// ArticlesCleared reports if the "articles" edge to the Article entity was cleared.
+func (m *FeedMutation) ArticlesCleared() bool {
+	return m.clearedarticles
+}
+
+// RemoveArticleIDs removes the "articles" edge to the Article entity by IDs.
+func (m *FeedMutation) RemoveArticleIDs(ids ...uuid.UUID) {
+	if m.removedarticles == nil {
+		m.removedarticles = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.articles, ids[i])
+		m.removedarticles[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedArticles returns the removed IDs of the "articles" edge to the Article entity.
+func (m *FeedMutation) RemovedArticlesIDs() (ids []uuid.UUID) {
+	for id := range m.removedarticles {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ArticlesIDs returns the "articles" edge IDs in the mutation.
+func (m *FeedMutation) ArticlesIDs() (ids []uuid.UUID) {
+	for id := range m.articles {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetArticles resets all changes to the "articles" edge.
+func (m *FeedMutation) ResetArticles() {
+	m.articles = nil
+	m.clearedarticles = false
+	m.removedarticles = nil
+}
+
+// AddSummaryIDs adds the "summaries" edge to the Summary entity by ids.
+func (m *FeedMutation) AddSummaryIDs(ids ...uuid.UUID) {
+	if m.summaries == nil {
+		m.summaries = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.summaries[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSummaries clears the "summaries" edge to the Summary entity.
+func (m *FeedMutation) ClearSummaries() {
+	m.clearedsummaries = true
+}
+
+// SummariesCleared reports if the "summaries" edge to the Summary entity was cleared.
+func (m *FeedMutation) SummariesCleared() bool {
+	return m.clearedsummaries
+}
+
+// RemoveSummaryIDs removes the "summaries" edge to the Summary entity by IDs.
+func (m *FeedMutation) RemoveSummaryIDs(ids ...uuid.UUID) {
+	if m.removedsummaries == nil {
+		m.removedsummaries = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.summaries, ids[i])
+		m.removedsummaries[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSummaries returns the removed IDs of the "summaries" edge to the Summary entity.
+func (m *FeedMutation) RemovedSummariesIDs() (ids []uuid.UUID) {
+	for id := range m.removedsummaries {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SummariesIDs returns the "summaries" edge IDs in the mutation.
+func (m *FeedMutation) SummariesIDs() (ids []uuid.UUID) {
+	for id := range m.summaries {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSummaries resets all changes to the "summaries" edge.
+func (m *FeedMutation) ResetSummaries() {
+	m.summaries = nil
+	m.clearedsummaries = false
+	m.removedsummaries = nil
+}
+
+// Where appends a list predicates to the FeedMutation builder.
+func (m *FeedMutation) Where(ps ...predicate.Feed) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the FeedMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *FeedMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Feed, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *FeedMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *FeedMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Feed).
+func (m *FeedMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *FeedMutation) Fields() []string {
+	fields := make([]string, 0, 22)
+	if m.url != nil {
+		fields = append(fields, feed.FieldURL)
+	}
+	if m.title != nil {
+		fields = append(fields, feed.FieldTitle)
+	}
+	if m.description != nil {
+		fields = append(fields, feed.FieldDescription)
+	}
+	if m.link != nil {
+		fields = append(fields, feed.FieldLink)
+	}
+	if m._order != nil {
+		fields = append(fields, feed.FieldOrder)
+	}
+	if m.is_bookmark != nil {
+		fields = append(fields, feed.FieldIsBookmark)
+	}
+	if m.last_checked_at != nil {
+		fields = append(fields, feed.FieldLastCheckedAt)
+	}
+	if m.consecutive_failures != nil {
+		fields = append(fields, feed.FieldConsecutiveFailures)
+	}
+	if m.last_error != nil {
+		fields = append(fields, feed.FieldLastError)
+	}
+	if m.last_status != nil {
+		fields = append(fields, feed.FieldLastStatus)
+	}
+	if m.last_success_at != nil {
+		fields = append(fields, feed.FieldLastSuccessAt)
+	}
+	if m.disabled != nil {
+		fields = append(fields, feed.FieldDisabled)
+	}
+	if m.next_poll_at != nil {
+		fields = append(fields, feed.FieldNextPollAt)
+	}
+	if m.min_interval != nil {
+		fields = append(fields, feed.FieldMinInterval)
+	}
+	if m.max_interval != nil {
+		fields = append(fields, feed.FieldMaxInterval)
+	}
+	if m.hub_url != nil {
+		fields = append(fields, feed.FieldHubURL)
+	}
+	if m.topic_url != nil {
+		fields = append(fields, feed.FieldTopicURL)
+	}
+	if m.websub_state != nil {
+		fields = append(fields, feed.FieldWebsubState)
+	}
+	if m.websub_secret != nil {
+		fields = append(fields, feed.FieldWebsubSecret)
+	}
+	if m.websub_expires_at != nil {
+		fields = append(fields, feed.FieldWebsubExpiresAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, feed.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, feed.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *FeedMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case feed.FieldURL:
+		return m.URL()
+	case feed.FieldTitle:
+		return m.Title()
+	case feed.FieldDescription:
+		return m.Description()
+	case feed.FieldLink:
+		return m.Link()
+	case feed.FieldOrder:
+		return m.Order()
+	case feed.FieldIsBookmark:
+		return m.IsBookmark()
+	case feed.FieldLastCheckedAt:
+		return m.LastCheckedAt()
+	case feed.FieldConsecutiveFailures:
+		return m.ConsecutiveFailures()
+	case feed.FieldLastError:
+		return m.LastError()
+	case feed.FieldLastStatus:
+		return m.LastStatus()
+	case feed.FieldLastSuccessAt:
+		return m.LastSuccessAt()
+	case feed.FieldDisabled:
+		return m.Disabled()
+	case feed.FieldNextPollAt:
+		return m.NextPollAt()
+	case feed.FieldMinInterval:
+		return m.MinInterval()
+	case feed.FieldMaxInterval:
+		return m.MaxInterval()
+	case feed.FieldHubURL:
+		return m.HubURL()
+	case feed.FieldTopicURL:
+		return m.TopicURL()
+	case feed.FieldWebsubState:
+		return m.WebsubState()
+	case feed.FieldWebsubSecret:
+		return m.WebsubSecret()
+	case feed.FieldWebsubExpiresAt:
+		return m.WebsubExpiresAt()
+	case feed.FieldCreatedAt:
+		return m.CreatedAt()
+	case feed.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *FeedMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case feed.FieldURL:
+		return m.OldURL(ctx)
+	case feed.FieldTitle:
+		return m.OldTitle(ctx)
+	case feed.FieldDescription:
+		return m.OldDescription(ctx)
+	case feed.FieldLink:
+		return m.OldLink(ctx)
+	case feed.FieldOrder:
+		return m.OldOrder(ctx)
+	case feed.FieldIsBookmark:
+		return m.OldIsBookmark(ctx)
+	case feed.FieldLastCheckedAt:
+		return m.OldLastCheckedAt(ctx)
+	case feed.FieldConsecutiveFailures:
+		return m.OldConsecutiveFailures(ctx)
+	case feed.FieldLastError:
+		return m.OldLastError(ctx)
+	case feed.FieldLastStatus:
+		return m.OldLastStatus(ctx)
+	case feed.FieldLastSuccessAt:
+		return m.OldLastSuccessAt(ctx)
+	case feed.FieldDisabled:
+		return m.OldDisabled(ctx)
+	case feed.FieldNextPollAt:
+		return m.OldNextPollAt(ctx)
+	case feed.FieldMinInterval:
+		return m.OldMinInterval(ctx)
+	case feed.FieldMaxInterval:
+		return m.OldMaxInterval(ctx)
+	case feed.FieldHubURL:
+		return m.OldHubURL(ctx)
+	case feed.FieldTopicURL:
+		return m.OldTopicURL(ctx)
+	case feed.FieldWebsubState:
+		return m.OldWebsubState(ctx)
+	case feed.FieldWebsubSecret:
+		return m.OldWebsubSecret(ctx)
+	case feed.FieldWebsubExpiresAt:
+		return m.OldWebsubExpiresAt(ctx)
+	case feed.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case feed.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Feed field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FeedMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case feed.FieldURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetURL(v)
+		return nil
+	case feed.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case feed.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case feed.FieldLink:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLink(v)
+		return nil
+	case feed.FieldOrder:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOrder(v)
+		return nil
+	case feed.FieldIsBookmark:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsBookmark(v)
+		return nil
+	case feed.FieldLastCheckedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastCheckedAt(v)
+		return nil
+	case feed.FieldConsecutiveFailures:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetConsecutiveFailures(v)
+		return nil
+	case feed.FieldLastError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastError(v)
+		return nil
+	case feed.FieldLastStatus:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastStatus(v)
+		return nil
+	case feed.FieldLastSuccessAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastSuccessAt(v)
+		return nil
+	case feed.FieldDisabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDisabled(v)
+		return nil
+	case feed.FieldNextPollAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNextPollAt(v)
+		return nil
+	case feed.FieldMinInterval:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMinInterval(v)
+		return nil
+	case feed.FieldMaxInterval:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxInterval(v)
+		return nil
+	case feed.FieldHubURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHubURL(v)
+		return nil
+	case feed.FieldTopicURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTopicURL(v)
+		return nil
+	case feed.FieldWebsubState:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWebsubState(v)
+		return nil
+	case feed.FieldWebsubSecret:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWebsubSecret(v)
+		return nil
+	case feed.FieldWebsubExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWebsubExpiresAt(v)
+		return nil
+	case feed.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case feed.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Feed field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *FeedMutation) AddedFields() []string {
+	var fields []string
+	if m.add_order != nil {
+		fields = append(fields, feed.FieldOrder)
+	}
+	if m.addconsecutive_failures != nil {
+		fields = append(fields, feed.FieldConsecutiveFailures)
+	}
+	if m.addlast_status != nil {
+		fields = append(fields, feed.FieldLastStatus)
+	}
+	if m.addmin_interval != nil {
+		fields = append(fields, feed.FieldMinInterval)
+	}
+	if m.addmax_interval != nil {
+		fields = append(fields, feed.FieldMaxInterval)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *FeedMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case feed.FieldOrder:
+		return m.AddedOrder()
+	case feed.FieldConsecutiveFailures:
+		return m.AddedConsecutiveFailures()
+	case feed.FieldLastStatus:
+		return m.AddedLastStatus()
+	case feed.FieldMinInterval:
+		return m.AddedMinInterval()
+	case feed.FieldMaxInterval:
+		return m.AddedMaxInterval()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FeedMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case feed.FieldOrder:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddOrder(v)
+		return nil
+	case feed.FieldConsecutiveFailures:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddConsecutiveFailures(v)
+		return nil
+	case feed.FieldLastStatus:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLastStatus(v)
+		return nil
+	case feed.FieldMinInterval:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMinInterval(v)
+		return nil
+	case feed.FieldMaxInterval:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxInterval(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Feed numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *FeedMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(feed.FieldDescription) {
+		fields = append(fields, feed.FieldDescription)
+	}
+	if m.FieldCleared(feed.FieldLink) {
+		fields = append(fields, feed.FieldLink)
+	}
+	if m.FieldCleared(feed.FieldLastCheckedAt) {
+		fields = append(fields, feed.FieldLastCheckedAt)
+	}
+	if m.FieldCleared(feed.FieldLastError) {
+		fields = append(fields, feed.FieldLastError)
+	}
+	if m.FieldCleared(feed.FieldLastStatus) {
+		fields = append(fields, feed.FieldLastStatus)
+	}
+	if m.FieldCleared(feed.FieldLastSuccessAt) {
+		fields = append(fields, feed.FieldLastSuccessAt)
+	}
+	if m.FieldCleared(feed.FieldNextPollAt) {
+		fields = append(fields, feed.FieldNextPollAt)
+	}
+	if m.FieldCleared(feed.FieldMinInterval) {
+		fields = append(fields, feed.FieldMinInterval)
+	}
+	if m.FieldCleared(feed.FieldMaxInterval) {
+		fields = append(fields, feed.FieldMaxInterval)
+	}
+	if m.FieldCleared(feed.FieldHubURL) {
+		fields = append(fields, feed.FieldHubURL)
+	}
+	if m.FieldCleared(feed.FieldTopicURL) {
+		fields = append(fields, feed.FieldTopicURL)
+	}
+	if m.FieldCleared(feed.FieldWebsubState) {
+		fields = append(fields, feed.FieldWebsubState)
+	}
+	if m.FieldCleared(feed.FieldWebsubSecret) {
+		fields = append(fields, feed.FieldWebsubSecret)
+	}
+	if m.FieldCleared(feed.FieldWebsubExpiresAt) {
+		fields = append(fields, feed.FieldWebsubExpiresAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *FeedMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *FeedMutation) ClearField(name string) error {
+	switch name {
+	case feed.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case feed.FieldLink:
+		m.ClearLink()
+		return nil
+	case feed.FieldLastCheckedAt:
+		m.ClearLastCheckedAt()
+		return nil
+	case feed.FieldLastError:
+		m.ClearLastError()
+		return nil
+	case feed.FieldLastStatus:
+		m.ClearLastStatus()
+		return nil
+	case feed.FieldLastSuccessAt:
+		m.ClearLastSuccessAt()
+		return nil
+	case feed.FieldNextPollAt:
+		m.ClearNextPollAt()
+		return nil
+	case feed.FieldMinInterval:
+		m.ClearMinInterval()
+		return nil
+	case feed.FieldMaxInterval:
+		m.ClearMaxInterval()
+		return nil
+	case feed.FieldHubURL:
+		m.ClearHubURL()
+		return nil
+	case feed.FieldTopicURL:
+		m.ClearTopicURL()
+		return nil
+	case feed.FieldWebsubState:
+		m.ClearWebsubState()
+		return nil
+	case feed.FieldWebsubSecret:
+		m.ClearWebsubSecret()
+		return nil
+	case feed.FieldWebsubExpiresAt:
+		m.ClearWebsubExpiresAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Feed nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *FeedMutation) ResetField(name string) error {
+	switch name {
+	case feed.FieldURL:
+		m.ResetURL()
+		return nil
+	case feed.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case feed.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case feed.FieldLink:
+		m.ResetLink()
+		return nil
+	case feed.FieldOrder:
+		m.ResetOrder()
+		return nil
+	case feed.FieldIsBookmark:
+		m.ResetIsBookmark()
+		return nil
+	case feed.FieldLastCheckedAt:
+		m.ResetLastCheckedAt()
+		return nil
+	case feed.FieldConsecutiveFailures:
+		m.ResetConsecutiveFailures()
+		return nil
+	case feed.FieldLastError:
+		m.ResetLastError()
+		return nil
+	case feed.FieldLastStatus:
+		m.ResetLastStatus()
+		return nil
+	case feed.FieldLastSuccessAt:
+		m.ResetLastSuccessAt()
+		return nil
+	case feed.FieldDisabled:
+		m.ResetDisabled()
+		return nil
+	case feed.FieldNextPollAt:
+		m.ResetNextPollAt()
+		return nil
+	case feed.FieldMinInterval:
+		m.ResetMinInterval()
+		return nil
+	case feed.FieldMaxInterval:
+		m.ResetMaxInterval()
+		return nil
+	case feed.FieldHubURL:
+		m.ResetHubURL()
+		return nil
+	case feed.FieldTopicURL:
+		m.ResetTopicURL()
+		return nil
+	case feed.FieldWebsubState:
+		m.ResetWebsubState()
+		return nil
+	case feed.FieldWebsubSecret:
+		m.ResetWebsubSecret()
+		return nil
+	case feed.FieldWebsubExpiresAt:
+		m.ResetWebsubExpiresAt()
+		return nil
+	case feed.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case feed.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Feed field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *FeedMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.articles != nil {
+		edges = append(edges, feed.EdgeArticles)
+	}
+	if m.summaries != nil {
+		edges = append(edges, feed.EdgeSummaries)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *FeedMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case feed.EdgeArticles:
+		ids := make([]ent.Value, 0, len(m.articles))
+		for id := range m.articles {
+			ids = append(ids, id)
+		}
+		return ids
+	case feed.EdgeSummaries:
+		ids := make([]ent.Value, 0, len(m.summaries))
+		for id := range m.summaries {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *FeedMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedarticles != nil {
+		edges = append(edges, feed.EdgeArticles)
+	}
+	if m.removedsummaries != nil {
+		edges = append(edges, feed.EdgeSummaries)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *FeedMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case feed.EdgeArticles:
+		ids := make([]ent.Value, 0, len(m.removedarticles))
+		for id := range m.removedarticles {
+			ids = append(ids, id)
+		}
+		return ids
+	case feed.EdgeSummaries:
+		ids := make([]ent.Value, 0, len(m.removedsummaries))
+		for id := range m.removedsummaries {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *FeedMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedarticles {
+		edges = append(edges, feed.EdgeArticles)
+	}
+	if m.clearedsummaries {
+		edges = append(edges, feed.EdgeSummaries)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *FeedMutation) EdgeCleared(name string) bool {
+	switch name {
+	case feed.EdgeArticles:
+		return m.clearedarticles
+	case feed.EdgeSummaries:
+		return m.clearedsummaries
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *FeedMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Feed unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *FeedMutation) ResetEdge(name string) error {
+	switch name {
+	case feed.EdgeArticles:
+		m.ResetArticles()
+		return nil
+	case feed.EdgeSummaries:
+		m.ResetSummaries()
+		return nil
+	}
+	return fmt.Errorf("unknown Feed edge %s", name)
+}
+
+// SummaryMutation represents an operation that mutates the Summary nodes in the graph.
+type SummaryMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *uuid.UUID
+	url            *string
+	title          *string
+	summary        *string
+	readed         *bool
+	listened       *bool
+	audio_file     *string
+	created_at     *time.Time
+	tags           *[]string
+	appendtags     []string
+	priority       *int
+	addpriority    *int
+	mailed_at      *time.Time
+	clearedFields  map[string]struct{}
+	article        *uuid.UUID
+	clearedarticle bool
+	feed           *uuid.UUID
+	clearedfeed    bool
+	done           bool
+	oldValue       func(context.Context) (*Summary, error)
+	predicates     []predicate.Summary
+}
+
+var _ ent.Mutation = (*SummaryMutation)(nil)
+
+// summaryOption allows management of the mutation configuration using functional options.
+type summaryOption func(*SummaryMutation)
+
+// newSummaryMutation creates new mutation for the Summary entity.
+func newSummaryMutation(c config, op Op, opts ...summaryOption) *SummaryMutation {
+	m := &SummaryMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSummary,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSummaryID sets the ID field of the mutation.
+func withSummaryID(id uuid.UUID) summaryOption {
+	return func(m *SummaryMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Summary
+		)
+		m.oldValue = func(ctx context.Context) (*Summary, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Summary.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSummary sets the old Summary of the mutation.
+func withSummary(node *Summary) summaryOption {
+	return func(m *SummaryMutation) {
+		m.oldValue = func(context.Context) (*Summary, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SummaryMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SummaryMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Summary entities.
+func (m *SummaryMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SummaryMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SummaryMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Summary.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetURL sets the "url" field.
+func (m *SummaryMutation) SetURL(s string) {
+	m.url = &s
+}
+
+// URL returns the value of the "url" field in the mutation.
+func (m *SummaryMutation) URL() (r string, exists bool) {
+	v := m.url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldURL returns the old "url" field's value of the Summary entity.
+// If the Summary object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SummaryMutation) OldURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldURL: %w", err)
+	}
+	return oldValue.URL, nil
+}
+
+// ResetURL resets all changes to the "url" field.
+func (m *SummaryMutation) ResetURL() {
+	m.url = nil
+}
+
+// SetTitle sets the "title" field.
+func (m *SummaryMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *SummaryMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the Summary entity.
+// If the Summary object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SummaryMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ClearTitle clears the value of the "title" field.
+func (m *SummaryMutation) ClearTitle() {
+	m.title = nil
+	m.clearedFields[summary.FieldTitle] = struct{}{}
+}
+
+// TitleCleared returns if the "title" field was cleared in this mutation.
+func (m *SummaryMutation) TitleCleared() bool {
+	_, ok := m.clearedFields[summary.FieldTitle]
+	return ok
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *SummaryMutation) ResetTitle() {
+	m.title = nil
+	delete(m.clearedFields, summary.FieldTitle)
+}
+
+// SetSummary sets the "summary" field.
+func (m *SummaryMutation) SetSummary(s string) {
+	m.summary = &s
+}
+
+// Summary returns the value of the "summary" field in the mutation.
+func (m *SummaryMutation) Summary() (r string, exists bool) {
+	v := m.summary
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSummary returns the old "summary" field's value of the Summary entity.
+// If the Summary object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SummaryMutation) OldSummary(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSummary is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSummary requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSummary: %w", err)
+	}
+	return oldValue.Summary, nil
+}
+
+// ClearSummary clears the value of the "summary" field.
+func (m *SummaryMutation) ClearSummary() {
+	m.summary = nil
+	m.clearedFields[summary.FieldSummary] = struct{}{}
+}
+
+// SummaryCleared returns if the "summary" field was cleared in this mutation.
+func (m *SummaryMutation) SummaryCleared() bool {
+	_, ok := m.clearedFields[summary.FieldSummary]
+	return ok
+}
+
+// ResetSummary resets all changes to the "summary" field.
+func (m *SummaryMutation) ResetSummary() {
+	m.summary = nil
+	delete(m.clearedFields, summary.FieldSummary)
+}
+
+// SetReaded sets the "readed" field.
+func (m *SummaryMutation) SetReaded(b bool) {
+	m.readed = &b
+}
+
+// Readed returns the value of the "readed" field in the mutation.
+func (m *SummaryMutation) Readed() (r bool, exists bool) {
+	v := m.readed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReaded returns the old "readed" field's value of the Summary entity.
+// If the Summary object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SummaryMutation) OldReaded(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReaded is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReaded requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReaded: %w", err)
+	}
+	return oldValue.Readed, nil
+}
+
+// ResetReaded resets all changes to the "readed" field.
+func (m *SummaryMutation) ResetReaded() {
+	m.readed = nil
+}
+
+// SetListened sets the "listened" field.
+func (m *SummaryMutation) SetListened(b bool) {
+	m.listened = &b
+}
+
+// Listened returns the value of the "listened" field in the mutation.
+func (m *SummaryMutation) Listened() (r bool, exists bool) {
+	v := m.listened
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldListened returns the old "listened" field's value of the Summary entity.
+// If the Summary object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SummaryMutation) OldListened(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldListened is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldListened requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldListened: %w", err)
+	}
+	return oldValue.Listened, nil
+}
+
+// ResetListened resets all changes to the "listened" field.
+func (m *SummaryMutation) ResetListened() {
+	m.listened = nil
+}
+
+// SetAudioFile sets the "audio_file" field.
+func (m *SummaryMutation) SetAudioFile(s string) {
+	m.audio_file = &s
+}
+
+// AudioFile returns the value of the "audio_file" field in the mutation.
+func (m *SummaryMutation) AudioFile() (r string, exists bool) {
+	v := m.audio_file
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAudioFile returns the old "audio_file" field's value of the Summary entity.
+// If the Summary object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SummaryMutation) OldAudioFile(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAudioFile is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAudioFile requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAudioFile: %w", err)
+	}
+	return oldValue.AudioFile, nil
+}
+
+// ClearAudioFile clears the value of the "audio_file" field.
+func (m *SummaryMutation) ClearAudioFile() {
+	m.audio_file = nil
+	m.clearedFields[summary.FieldAudioFile] = struct{}{}
+}
+
+// AudioFileCleared returns if the "audio_file" field was cleared in this mutation.
+func (m *SummaryMutation) AudioFileCleared() bool {
+	_, ok := m.clearedFields[summary.FieldAudioFile]
+	return ok
+}
+
+// ResetAudioFile resets all changes to the "audio_file" field.
+func (m *SummaryMutation) ResetAudioFile() {
+	m.audio_file = nil
+	delete(m.clearedFields, summary.FieldAudioFile)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *SummaryMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SummaryMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Summary entity.
+// If the Summary object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SummaryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SummaryMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetTags sets the "tags" field.
+func (m *SummaryMutation) SetTags(s []string) {
+	m.tags = &s
+	m.appendtags = nil
+}
+
+// Tags returns the value of the "tags" field in the mutation.
+func (m *SummaryMutation) Tags() (r []string, exists bool) {
+	v := m.tags
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTags returns the old "tags" field's value of the Summary entity.
+// If the Summary object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SummaryMutation) OldTags(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTags is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTags requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTags: %w", err)
+	}
+	return oldValue.Tags, nil
+}
+
+// AppendTags adds s to the "tags" field.
+func (m *SummaryMutation) AppendTags(s []string) {
+	m.appendtags = append(m.appendtags, s...)
+}
+
+// AppendedTags returns the list of values that were appended to the "tags" field in this mutation.
+func (m *SummaryMutation) AppendedTags() ([]string, bool) {
+	if len(m.appendtags) == 0 {
+		return nil, false
+	}
+	return m.appendtags, true
+}
+
+// ClearTags clears the value of the "tags" field.
+func (m *SummaryMutation) ClearTags() {
+	m.tags = nil
+	m.appendtags = nil
+	m.clearedFields[summary.FieldTags] = struct{}{}
+}
+
+// TagsCleared returns if the "tags" field was cleared in this mutation.
+func (m *SummaryMutation) TagsCleared() bool {
+	_, ok := m.clearedFields[summary.FieldTags]
+	return ok
+}
+
+// ResetTags resets all changes to the "tags" field.
+func (m *SummaryMutation) ResetTags() {
+	m.tags = nil
+	m.appendtags = nil
+	delete(m.clearedFields, summary.FieldTags)
+}
+
+// SetPriority sets the "priority" field.
+func (m *SummaryMutation) SetPriority(i int) {
+	m.priority = &i
+	m.addpriority = nil
+}
+
+// Priority returns the value of the "priority" field in the mutation.
+func (m *SummaryMutation) Priority() (r int, exists bool) {
+	v := m.priority
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPriority returns the old "priority" field's value of the Summary entity.
+// If the Summary object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SummaryMutation) OldPriority(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPriority is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPriority requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPriority: %w", err)
+	}
+	return oldValue.Priority, nil
+}
+
+// AddPriority adds i to the "priority" field.
+func (m *SummaryMutation) AddPriority(i int) {
+	if m.addpriority != nil {
+		*m.addpriority += i
+	} else {
+		m.addpriority = &i
+	}
+}
+
+// AddedPriority returns the value that was added to the "priority" field in this mutation.
+func (m *SummaryMutation) AddedPriority() (r int, exists bool) {
+	v := m.addpriority
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPriority resets all changes to the "priority" field.
+func (m *SummaryMutation) ResetPriority() {
+	m.priority = nil
+	m.addpriority = nil
+}
+
+// SetMailedAt sets the "mailed_at" field.
+func (m *SummaryMutation) SetMailedAt(t time.Time) {
+	m.mailed_at = &t
+}
+
+// MailedAt returns the value of the "mailed_at" field in the mutation.
+func (m *SummaryMutation) MailedAt() (r time.Time, exists bool) {
+	v := m.mailed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMailedAt returns the old "mailed_at" field's value of the Summary entity.
+// If the Summary object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SummaryMutation) OldMailedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMailedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMailedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMailedAt: %w", err)
+	}
+	return oldValue.MailedAt, nil
+}
+
+// ClearMailedAt clears the value of the "mailed_at" field.
+func (m *SummaryMutation) ClearMailedAt() {
+	m.mailed_at = nil
+	m.clearedFields[summary.FieldMailedAt] = struct{}{}
+}
+
+// MailedAtCleared returns if the "mailed_at" field was cleared in this mutation.
+func (m *SummaryMutation) MailedAtCleared() bool {
+	_, ok := m.clearedFields[summary.FieldMailedAt]
+	return ok
+}
+
+// ResetMailedAt resets all changes to the "mailed_at" field.
+func (m *SummaryMutation) ResetMailedAt() {
+	m.mailed_at = nil
+	delete(m.clearedFields, summary.FieldMailedAt)
+}
+
+// SetArticleID sets the "article" edge to the Article entity by id.
+func (m *SummaryMutation) SetArticleID(id uuid.UUID) {
+	m.article = &id
+}
+
+// ClearArticle clears the "article" edge to the Article entity.
+func (m *SummaryMutation) ClearArticle() {
+	m.clearedarticle = true
+}
+
+// ArticleCleared reports if the "article" edge to the Article entity was cleared.
+func (m *SummaryMutation) ArticleCleared() bool {
+	return m.clearedarticle
+}
+
+// ArticleID returns the "article" edge ID in the mutation.
+func (m *SummaryMutation) ArticleID() (id uuid.UUID, exists bool) {
+	if m.article != nil {
+		return *m.article, true
+	}
+	return
+}
+
+// ArticleIDs returns the "article" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ArticleID instead. It exists only for internal usage by the builders.
+func (m *SummaryMutation) ArticleIDs() (ids []uuid.UUID) {
+	if id := m.article; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetArticle resets all changes to the "article" edge.
+func (m *SummaryMutation) ResetArticle() {
+	m.article = nil
+	m.clearedarticle = false
+}
+
+// SetFeedID sets the "feed" edge to the Feed entity by id.
+func (m *SummaryMutation) SetFeedID(id uuid.UUID) {
+	m.feed = &id
+}
+
+// ClearFeed clears the "feed" edge to the Feed entity.
+func (m *SummaryMutation) ClearFeed() {
+	m.clearedfeed = true
+}
+
+// FeedCleared reports if the "feed" edge to the Feed entity was cleared.
+func (m *SummaryMutation) FeedCleared() bool {
+	return m.clearedfeed
+}
+
+// FeedID returns the "feed" edge ID in the mutation.
+func (m *SummaryMutation) FeedID() (id uuid.UUID, exists bool) {
+	if m.feed != nil {
+		return *m.feed, true
+	}
+	return
+}
+
+// FeedIDs returns the "feed" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// FeedID instead. It exists only for internal usage by the builders.
+func (m *SummaryMutation) FeedIDs() (ids []uuid.UUID) {
+	if id := m.feed; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetFeed resets all changes to the "feed" edge.
+func (m *SummaryMutation) ResetFeed() {
+	m.feed = nil
+	m.clearedfeed = false
+}
+
+// Where appends a list predicates to the SummaryMutation builder.
+func (m *SummaryMutation) Where(ps ...predicate.Summary) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SummaryMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SummaryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Summary, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SummaryMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SummaryMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Summary).
+func (m *SummaryMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SummaryMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.url != nil {
+		fields = append(fields, summary.FieldURL)
+	}
+	if m.title != nil {
+		fields = append(fields, summary.FieldTitle)
+	}
+	if m.summary != nil {
+		fields = append(fields, summary.FieldSummary)
+	}
+	if m.readed != nil {
+		fields = append(fields, summary.FieldReaded)
+	}
+	if m.listened != nil {
+		fields = append(fields, summary.FieldListened)
+	}
+	if m.audio_file != nil {
+		fields = append(fields, summary.FieldAudioFile)
+	}
+	if m.created_at != nil {
+		fields = append(fields, summary.FieldCreatedAt)
+	}
+	if m.tags != nil {
+		fields = append(fields, summary.FieldTags)
+	}
+	if m.priority != nil {
+		fields = append(fields, summary.FieldPriority)
+	}
+	if m.mailed_at != nil {
+		fields = append(fields, summary.FieldMailedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SummaryMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case summary.FieldURL:
+		return m.URL()
+	case summary.FieldTitle:
+		return m.Title()
+	case summary.FieldSummary:
+		return m.Summary()
+	case summary.FieldReaded:
+		return m.Readed()
+	case summary.FieldListened:
+		return m.Listened()
+	case summary.FieldAudioFile:
+		return m.AudioFile()
+	case summary.FieldCreatedAt:
+		return m.CreatedAt()
+	case summary.FieldTags:
+		return m.Tags()
+	case summary.FieldPriority:
+		return m.Priority()
+	case summary.FieldMailedAt:
+		return m.MailedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SummaryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case summary.FieldURL:
+		return m.OldURL(ctx)
+	case summary.FieldTitle:
+		return m.OldTitle(ctx)
+	case summary.FieldSummary:
+		return m.OldSummary(ctx)
+	case summary.FieldReaded:
+		return m.OldReaded(ctx)
+	case summary.FieldListened:
+		return m.OldListened(ctx)
+	case summary.FieldAudioFile:
+		return m.OldAudioFile(ctx)
+	case summary.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case summary.FieldTags:
+		return m.OldTags(ctx)
+	case summary.FieldPriority:
+		return m.OldPriority(ctx)
+	case summary.FieldMailedAt:
+		return m.OldMailedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Summary field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SummaryMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case summary.FieldURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetURL(v)
+		return nil
+	case summary.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case summary.FieldSummary:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSummary(v)
+		return nil
+	case summary.FieldReaded:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReaded(v)
+		return nil
+	case summary.FieldListened:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetListened(v)
+		return nil
+	case summary.FieldAudioFile:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAudioFile(v)
+		return nil
+	case summary.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case summary.FieldTags:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTags(v)
+		return nil
+	case summary.FieldPriority:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPriority(v)
+		return nil
+	case summary.FieldMailedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMailedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Summary field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SummaryMutation) AddedFields() []string {
+	var fields []string
+	if m.addpriority != nil {
+		fields = append(fields, summary.FieldPriority)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SummaryMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case summary.FieldPriority:
+		return m.AddedPriority()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SummaryMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case summary.FieldPriority:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPriority(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Summary numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SummaryMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(summary.FieldTitle) {
+		fields = append(fields, summary.FieldTitle)
+	}
+	if m.FieldCleared(summary.FieldSummary) {
+		fields = append(fields, summary.FieldSummary)
+	}
+	if m.FieldCleared(summary.FieldAudioFile) {
+		fields = append(fields, summary.FieldAudioFile)
+	}
+	if m.FieldCleared(summary.FieldTags) {
+		fields = append(fields, summary.FieldTags)
+	}
+	if m.FieldCleared(summary.FieldMailedAt) {
+		fields = append(fields, summary.FieldMailedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SummaryMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SummaryMutation) ClearField(name string) error {
+	switch name {
+	case summary.FieldTitle:
+		m.ClearTitle()
+		return nil
+	case summary.FieldSummary:
+		m.ClearSummary()
+		return nil
+	case summary.FieldAudioFile:
+		m.ClearAudioFile()
+		return nil
+	case summary.FieldTags:
+		m.ClearTags()
+		return nil
+	case summary.FieldMailedAt:
+		m.ClearMailedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Summary nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SummaryMutation) ResetField(name string) error {
+	switch name {
+	case summary.FieldURL:
+		m.ResetURL()
+		return nil
+	case summary.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case summary.FieldSummary:
+		m.ResetSummary()
+		return nil
+	case summary.FieldReaded:
+		m.ResetReaded()
+		return nil
+	case summary.FieldListened:
+		m.ResetListened()
+		return nil
+	case summary.FieldAudioFile:
+		m.ResetAudioFile()
+		return nil
+	case summary.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case summary.FieldTags:
+		m.ResetTags()
+		return nil
+	case summary.FieldPriority:
+		m.ResetPriority()
+		return nil
+	case summary.FieldMailedAt:
+		m.ResetMailedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Summary field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SummaryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.article != nil {
+		edges = append(edges, summary.EdgeArticle)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldReaded: %w", err)
+	if m.feed != nil {
+		edges = append(edges, summary.EdgeFeed)
 	}
-	return oldValue.Readed, nil
+	return edges
 }
 
-// ResetReaded resets all changes to the "readed" field.
-func (m *SummaryMutation) ResetReaded() {
-	m.readed = nil
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SummaryMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case summary.EdgeArticle:
+		if id := m.article; id != nil {
+			return []ent.Value{*id}
+		}
+	case summary.EdgeFeed:
+		if id := m.feed; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
 }
 
-// SetListened sets the "listened" field.
-func (m *SummaryMutation) SetListened(b bool) {
-	m.listened = &b
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SummaryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
 }
 
-// Listened returns the value of the "listened" field in the mutation.
-func (m *SummaryMutation) Listened() (r bool, exists bool) {
-	v := m.listened
-	if v == nil {
-		return
-	}
-	return *v, true
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SummaryMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// OldListened returns the old "listened" field's value of the Summary entity.
-// If the Summary object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SummaryMutation) OldListened(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldListened is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldListened requires an ID field in the mutation")
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SummaryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedarticle {
+		edges = append(edges, summary.EdgeArticle)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldListened: %w", err)
+	if m.clearedfeed {
+		edges = append(edges, summary.EdgeFeed)
 	}
-	return oldValue.Listened, nil
-}
-
-// ResetListened resets all changes to the "listened" field.
-func (m *SummaryMutation) ResetListened() {
-	m.listened = nil
+	return edges
 }
 
-// SetAudioFile sets the "audio_file" field.
-func (m *SummaryMutation) SetAudioFile(s string) {
-	m.audio_file = &s
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SummaryMutation) EdgeCleared(name string) bool {
+	switch name {
+	case summary.EdgeArticle:
+		return m.clearedarticle
+	case summary.EdgeFeed:
+		return m.clearedfeed
+	}
+	return false
 }
 
-// AudioFile returns the value of the "audio_file" field in the mutation.
-func (m *SummaryMutation) AudioFile() (r string, exists bool) {
-	v := m.audio_file
-	if v == nil {
-		return
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SummaryMutation) ClearEdge(name string) error {
+	switch name {
+	case summary.EdgeArticle:
+		m.ClearArticle()
+		return nil
+	case summary.EdgeFeed:
+		m.ClearFeed()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown Summary unique edge %s", name)
 }
 
-// OldAudioFile returns the old "audio_file" field's value of the Summary entity.
-// If the Summary object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SummaryMutation) OldAudioFile(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAudioFile is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAudioFile requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAudioFile: %w", err)
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SummaryMutation) ResetEdge(name string) error {
+	switch name {
+	case summary.EdgeArticle:
+		m.ResetArticle()
+		return nil
+	case summary.EdgeFeed:
+		m.ResetFeed()
+		return nil
 	}
-	return oldValue.AudioFile, nil
+	return fmt.Errorf("unknown Summary edge %s", name)
 }
 
-// ClearAudioFile clears the value of the "audio_file" field.
-func (m *SummaryMutation) ClearAudioFile() {
-	m.audio_file = nil
-	m.clearedFields[summary.FieldAudioFile] = struct{}{}
+// TombstoneMutation represents an operation that mutates the Tombstone nodes in the graph.
+type TombstoneMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	url           *string
+	pruned_at     *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*Tombstone, error)
+	predicates    []predicate.Tombstone
 }
 
-// AudioFileCleared returns if the "audio_file" field was cleared in this mutation.
-func (m *SummaryMutation) AudioFileCleared() bool {
-	_, ok := m.clearedFields[summary.FieldAudioFile]
-	return ok
-}
+var _ ent.Mutation = (*TombstoneMutation)(nil)
 
-// ResetAudioFile resets all changes to the "audio_file" field.
-func (m *SummaryMutation) ResetAudioFile() {
-	m.audio_file = nil
-	delete(m.clearedFields, summary.FieldAudioFile)
-}
+// tombstoneOption allows management of the mutation configuration using functional options.
+type tombstoneOption func(*TombstoneMutation)
 
-// SetCreatedAt sets the "created_at" field.
-func (m *SummaryMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// newTombstoneMutation creates new mutation for the Tombstone entity.
+func newTombstoneMutation(c config, op Op, opts ...tombstoneOption) *TombstoneMutation {
+	m := &TombstoneMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTombstone,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *SummaryMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
-	if v == nil {
-		return
+// withTombstoneID sets the ID field of the mutation.
+func withTombstoneID(id uuid.UUID) tombstoneOption {
+	return func(m *TombstoneMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Tombstone
+		)
+		m.oldValue = func(ctx context.Context) (*Tombstone, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Tombstone.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Summary entity.
-// If the Summary object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SummaryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+// withTombstone sets the old Tombstone of the mutation.
+func withTombstone(node *Tombstone) tombstoneOption {
+	return func(m *TombstoneMutation) {
+		m.oldValue = func(context.Context) (*Tombstone, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
-	return oldValue.CreatedAt, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *SummaryMutation) ResetCreatedAt() {
-	m.created_at = nil
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TombstoneMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// SetArticleID sets the "article" edge to the Article entity by id.
-func (m *SummaryMutation) SetArticleID(id uuid.UUID) {
-	m.article = &id
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TombstoneMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// ClearArticle clears the "article" edge to the Article entity.
-func (m *SummaryMutation) ClearArticle() {
-	m.clearedarticle = true
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Tombstone entities.
+func (m *TombstoneMutation) SetID(id uuid.UUID) {
+	m.id = &id
 }
 
-// ArticleCleared reports if the "article" edge to the Article entity was cleared.
-func (m *SummaryMutation) ArticleCleared() bool {
-	return m.clearedarticle
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TombstoneMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
 }
 
-// ArticleID returns the "article" edge ID in the mutation.
-func (m *SummaryMutation) ArticleID() (id uuid.UUID, exists bool) {
-	if m.article != nil {
-		return *m.article, true
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TombstoneMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Tombstone.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
-	return
 }
 
-// ArticleIDs returns the "article" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ArticleID instead. It exists only for internal usage by the builders.
-func (m *SummaryMutation) ArticleIDs() (ids []uuid.UUID) {
-	if id := m.article; id != nil {
-		ids = append(ids, *id)
-	}
-	return
+// SetURL sets the "url" field.
+func (m *TombstoneMutation) SetURL(s string) {
+	m.url = &s
 }
 
-// ResetArticle resets all changes to the "article" edge.
-func (m *SummaryMutation) ResetArticle() {
-	m.article = nil
-	m.clearedarticle = false
+// URL returns the value of the "url" field in the mutation.
+func (m *TombstoneMutation) URL() (r string, exists bool) {
+	v := m.url
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// SetFeedID sets the "feed" edge to the Feed entity by id.
-func (m *SummaryMutation) SetFeedID(id uuid.UUID) {
-	m.feed = &id
+// OldURL returns the old "url" field's value of the Tombstone entity.
+// If the Tombstone object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TombstoneMutation) OldURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldURL: %w", err)
+	}
+	return oldValue.URL, nil
 }
 
-// ClearFeed clears the "feed" edge to the Feed entity.
-func (m *SummaryMutation) ClearFeed() {
-	m.clearedfeed = true
+// ResetURL resets all changes to the "url" field.
+func (m *TombstoneMutation) ResetURL() {
+	m.url = nil
 }
 
-// FeedCleared reports if the "feed" edge to the Feed entity was cleared.
-func (m *SummaryMutation) FeedCleared() bool {
-	return m.clearedfeed
+// SetPrunedAt sets the "pruned_at" field.
+func (m *TombstoneMutation) SetPrunedAt(t time.Time) {
+	m.pruned_at = &t
 }
 
-// FeedID returns the "feed" edge ID in the mutation.
-func (m *SummaryMutation) FeedID() (id uuid.UUID, exists bool) {
-	if m.feed != nil {
-		return *m.feed, true
+// PrunedAt returns the value of the "pruned_at" field in the mutation.
+func (m *TombstoneMutation) PrunedAt() (r time.Time, exists bool) {
+	v := m.pruned_at
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// FeedIDs returns the "feed" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// FeedID instead. It exists only for internal usage by the builders.
-func (m *SummaryMutation) FeedIDs() (ids []uuid.UUID) {
-	if id := m.feed; id != nil {
-		ids = append(ids, *id)
+// OldPrunedAt returns the old "pruned_at" field's value of the Tombstone entity.
+// If the Tombstone object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TombstoneMutation) OldPrunedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPrunedAt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPrunedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPrunedAt: %w", err)
+	}
+	return oldValue.PrunedAt, nil
 }
 
-// ResetFeed resets all changes to the "feed" edge.
-func (m *SummaryMutation) ResetFeed() {
-	m.feed = nil
-	m.clearedfeed = false
+// ResetPrunedAt resets all changes to the "pruned_at" field.
+func (m *TombstoneMutation) ResetPrunedAt() {
+	m.pruned_at = nil
 }
 
-// Where appends a list predicates to the SummaryMutation builder.
-func (m *SummaryMutation) Where(ps ...predicate.Summary) {
+// Where appends a list predicates to the TombstoneMutation builder.
+func (m *TombstoneMutation) Where(ps ...predicate.Tombstone) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the SummaryMutation builder. Using this method,
+// WhereP appends storage-level predicates to the TombstoneMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *SummaryMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Summary, len(ps))
+func (m *TombstoneMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Tombstone, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2368,45 +4676,30 @@ func (m *SummaryMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *SummaryMutation) Op() Op {
+func (m *TombstoneMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *SummaryMutation) SetOp(op Op) {
+func (m *TombstoneMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Summary).
-func (m *SummaryMutation) Type() string {
+// Type returns the node type of this mutation (Tombstone).
+func (m *TombstoneMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *SummaryMutation) Fields() []string {
-	fields := make([]string, 0, 7)
+func (m *TombstoneMutation) Fields() []string {
+	fields := make([]string, 0, 2)
 	if m.url != nil {
-		fields = append(fields, summary.FieldURL)
-	}
-	if m.title != nil {
-		fields = append(fields, summary.FieldTitle)
-	}
-	if m.summary != nil {
-		fields = append(fields, summary.FieldSummary)
-	}
-	if m.readed != nil {
-		fields = append(fields, summary.FieldReaded)
-	}
-	if m.listened != nil {
-		fields = append(fields, summary.FieldListened)
-	}
-	if m.audio_file != nil {
-		fields = append(fields, summary.FieldAudioFile)
+		fields = append(fields, tombstone.FieldURL)
 	}
-	if m.created_at != nil {
-		fields = append(fields, summary.FieldCreatedAt)
+	if m.pruned_at != nil {
+		fields = append(fields, tombstone.FieldPrunedAt)
 	}
 	return fields
 }
@@ -2414,22 +4707,12 @@ func (m *SummaryMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *SummaryMutation) Field(name string) (ent.Value, bool) {
+func (m *TombstoneMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case summary.FieldURL:
+	case tombstone.FieldURL:
 		return m.URL()
-	case summary.FieldTitle:
-		return m.Title()
-	case summary.FieldSummary:
-		return m.Summary()
-	case summary.FieldReaded:
-		return m.Readed()
-	case summary.FieldListened:
-		return m.Listened()
-	case summary.FieldAudioFile:
-		return m.AudioFile()
-	case summary.FieldCreatedAt:
-		return m.CreatedAt()
+	case tombstone.FieldPrunedAt:
+		return m.PrunedAt()
 	}
 	return nil, false
 }
@@ -2437,263 +4720,138 @@ func (m *SummaryMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *SummaryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *TombstoneMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case summary.FieldURL:
+	case tombstone.FieldURL:
 		return m.OldURL(ctx)
-	case summary.FieldTitle:
-		return m.OldTitle(ctx)
-	case summary.FieldSummary:
-		return m.OldSummary(ctx)
-	case summary.FieldReaded:
-		return m.OldReaded(ctx)
-	case summary.FieldListened:
-		return m.OldListened(ctx)
-	case summary.FieldAudioFile:
-		return m.OldAudioFile(ctx)
-	case summary.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
+	case tombstone.FieldPrunedAt:
+		return m.OldPrunedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Summary field %s", name)
+	return nil, fmt.Errorf("unknown Tombstone field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SummaryMutation) SetField(name string, value ent.Value) error {
+func (m *TombstoneMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case summary.FieldURL:
+	case tombstone.FieldURL:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetURL(v)
 		return nil
-	case summary.FieldTitle:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTitle(v)
-		return nil
-	case summary.FieldSummary:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSummary(v)
-		return nil
-	case summary.FieldReaded:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetReaded(v)
-		return nil
-	case summary.FieldListened:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetListened(v)
-		return nil
-	case summary.FieldAudioFile:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAudioFile(v)
-		return nil
-	case summary.FieldCreatedAt:
+	case tombstone.FieldPrunedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetPrunedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Summary field %s", name)
+	return fmt.Errorf("unknown Tombstone field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *SummaryMutation) AddedFields() []string {
+func (m *TombstoneMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *SummaryMutation) AddedField(name string) (ent.Value, bool) {
+func (m *TombstoneMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SummaryMutation) AddField(name string, value ent.Value) error {
+func (m *TombstoneMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Summary numeric field %s", name)
+	return fmt.Errorf("unknown Tombstone numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *SummaryMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(summary.FieldTitle) {
-		fields = append(fields, summary.FieldTitle)
-	}
-	if m.FieldCleared(summary.FieldSummary) {
-		fields = append(fields, summary.FieldSummary)
-	}
-	if m.FieldCleared(summary.FieldAudioFile) {
-		fields = append(fields, summary.FieldAudioFile)
-	}
-	return fields
+func (m *TombstoneMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *SummaryMutation) FieldCleared(name string) bool {
+func (m *TombstoneMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *SummaryMutation) ClearField(name string) error {
-	switch name {
-	case summary.FieldTitle:
-		m.ClearTitle()
-		return nil
-	case summary.FieldSummary:
-		m.ClearSummary()
-		return nil
-	case summary.FieldAudioFile:
-		m.ClearAudioFile()
-		return nil
-	}
-	return fmt.Errorf("unknown Summary nullable field %s", name)
+func (m *TombstoneMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Tombstone nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *SummaryMutation) ResetField(name string) error {
+func (m *TombstoneMutation) ResetField(name string) error {
 	switch name {
-	case summary.FieldURL:
+	case tombstone.FieldURL:
 		m.ResetURL()
 		return nil
-	case summary.FieldTitle:
-		m.ResetTitle()
-		return nil
-	case summary.FieldSummary:
-		m.ResetSummary()
-		return nil
-	case summary.FieldReaded:
-		m.ResetReaded()
-		return nil
-	case summary.FieldListened:
-		m.ResetListened()
-		return nil
-	case summary.FieldAudioFile:
-		m.ResetAudioFile()
-		return nil
-	case summary.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case tombstone.FieldPrunedAt:
+		m.ResetPrunedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Summary field %s", name)
+	return fmt.Errorf("unknown Tombstone field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *SummaryMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.article != nil {
-		edges = append(edges, summary.EdgeArticle)
-	}
-	if m.feed != nil {
-		edges = append(edges, summary.EdgeFeed)
-	}
+func (m *TombstoneMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *SummaryMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case summary.EdgeArticle:
-		if id := m.article; id != nil {
-			return []ent.Value{*id}
-		}
-	case summary.EdgeFeed:
-		if id := m.feed; id != nil {
-			return []ent.Value{*id}
-		}
-	}
+func (m *TombstoneMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *SummaryMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *TombstoneMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *SummaryMutation) RemovedIDs(name string) []ent.Value {
+func (m *TombstoneMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *SummaryMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedarticle {
-		edges = append(edges, summary.EdgeArticle)
-	}
-	if m.clearedfeed {
-		edges = append(edges, summary.EdgeFeed)
-	}
+func (m *TombstoneMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *SummaryMutation) EdgeCleared(name string) bool {
-	switch name {
-	case summary.EdgeArticle:
-		return m.clearedarticle
-	case summary.EdgeFeed:
-		return m.clearedfeed
-	}
+func (m *TombstoneMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *SummaryMutation) ClearEdge(name string) error {
-	switch name {
-	case summary.EdgeArticle:
-		m.ClearArticle()
-		return nil
-	case summary.EdgeFeed:
-		m.ClearFeed()
-		return nil
-	}
-	return fmt.Errorf("unknown Summary unique edge %s", name)
+func (m *TombstoneMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Tombstone unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *SummaryMutation) ResetEdge(name string) error {
-	switch name {
-	case summary.EdgeArticle:
-		m.ResetArticle()
-		return nil
-	case summary.EdgeFeed:
-		m.ResetFeed()
-		return nil
-	}
-	return fmt.Errorf("unknown Summary edge %s", name)
+func (m *TombstoneMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Tombstone edge %s", name)
 }
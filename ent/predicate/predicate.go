@@ -14,3 +14,6 @@ type Feed func(*sql.Selector)
 
 // Summary is the predicate function for summary builders.
 type Summary func(*sql.Selector)
+
+// Tombstone is the predicate function for tombstone builders.
+type Tombstone func(*sql.Selector)
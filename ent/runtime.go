@@ -10,6 +10,7 @@ import (
 	"github.com/mopemope/quicknews/ent/feed"
 	"github.com/mopemope/quicknews/ent/schema"
 	"github.com/mopemope/quicknews/ent/summary"
+	"github.com/mopemope/quicknews/ent/tombstone"
 )
 
 // The init function reads all schema descriptors with runtime code
@@ -27,7 +28,7 @@ func init() {
 	// article.URLValidator is a validator for the "url" field. It is called by the builders before save.
 	article.URLValidator = articleDescURL.Validators[0].(func(string) error)
 	// articleDescCreatedAt is the schema descriptor for created_at field.
-	articleDescCreatedAt := articleFields[6].Descriptor()
+	articleDescCreatedAt := articleFields[10].Descriptor()
 	// article.DefaultCreatedAt holds the default value on creation for the created_at field.
 	article.DefaultCreatedAt = articleDescCreatedAt.Default.(func() time.Time)
 	// articleDescID is the schema descriptor for id field.
@@ -52,12 +53,20 @@ func init() {
 	feedDescIsBookmark := feedFields[6].Descriptor()
 	// feed.DefaultIsBookmark holds the default value on creation for the is_bookmark field.
 	feed.DefaultIsBookmark = feedDescIsBookmark.Default.(bool)
+	// feedDescConsecutiveFailures is the schema descriptor for consecutive_failures field.
+	feedDescConsecutiveFailures := feedFields[8].Descriptor()
+	// feed.DefaultConsecutiveFailures holds the default value on creation for the consecutive_failures field.
+	feed.DefaultConsecutiveFailures = feedDescConsecutiveFailures.Default.(int)
+	// feedDescDisabled is the schema descriptor for disabled field.
+	feedDescDisabled := feedFields[12].Descriptor()
+	// feed.DefaultDisabled holds the default value on creation for the disabled field.
+	feed.DefaultDisabled = feedDescDisabled.Default.(bool)
 	// feedDescCreatedAt is the schema descriptor for created_at field.
-	feedDescCreatedAt := feedFields[8].Descriptor()
+	feedDescCreatedAt := feedFields[21].Descriptor()
 	// feed.DefaultCreatedAt holds the default value on creation for the created_at field.
 	feed.DefaultCreatedAt = feedDescCreatedAt.Default.(func() time.Time)
 	// feedDescUpdatedAt is the schema descriptor for updated_at field.
-	feedDescUpdatedAt := feedFields[9].Descriptor()
+	feedDescUpdatedAt := feedFields[22].Descriptor()
 	// feed.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	feed.DefaultUpdatedAt = feedDescUpdatedAt.Default.(func() time.Time)
 	// feed.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -84,8 +93,26 @@ func init() {
 	summaryDescCreatedAt := summaryFields[7].Descriptor()
 	// summary.DefaultCreatedAt holds the default value on creation for the created_at field.
 	summary.DefaultCreatedAt = summaryDescCreatedAt.Default.(func() time.Time)
+	// summaryDescPriority is the schema descriptor for priority field.
+	summaryDescPriority := summaryFields[9].Descriptor()
+	// summary.DefaultPriority holds the default value on creation for the priority field.
+	summary.DefaultPriority = summaryDescPriority.Default.(int)
 	// summaryDescID is the schema descriptor for id field.
 	summaryDescID := summaryFields[0].Descriptor()
 	// summary.DefaultID holds the default value on creation for the id field.
 	summary.DefaultID = summaryDescID.Default.(func() uuid.UUID)
+	tombstoneFields := schema.Tombstone{}.Fields()
+	_ = tombstoneFields
+	// tombstoneDescURL is the schema descriptor for url field.
+	tombstoneDescURL := tombstoneFields[1].Descriptor()
+	// tombstone.URLValidator is a validator for the "url" field. It is called by the builders before save.
+	tombstone.URLValidator = tombstoneDescURL.Validators[0].(func(string) error)
+	// tombstoneDescPrunedAt is the schema descriptor for pruned_at field.
+	tombstoneDescPrunedAt := tombstoneFields[2].Descriptor()
+	// tombstone.DefaultPrunedAt holds the default value on creation for the pruned_at field.
+	tombstone.DefaultPrunedAt = tombstoneDescPrunedAt.Default.(func() time.Time)
+	// tombstoneDescID is the schema descriptor for id field.
+	tombstoneDescID := tombstoneFields[0].Descriptor()
+	// tombstone.DefaultID holds the default value on creation for the id field.
+	tombstone.DefaultID = tombstoneDescID.Default.(func() uuid.UUID)
 }
@@ -14,6 +14,13 @@ type Article struct {
 	ent.Schema
 }
 
+// Enclosure is a media file attached to an article, such as a podcast episode.
+type Enclosure struct {
+	URL    string `json:"url"`
+	Type   string `json:"type,omitempty"`
+	Length int64  `json:"length,omitempty"` // Size in bytes, 0 when unknown
+}
+
 // Fields of the Article.
 func (Article) Fields() []ent.Field {
 	return []ent.Field{
@@ -33,6 +40,18 @@ func (Article) Fields() []ent.Field {
 		field.String("content").
 			Optional().
 			Comment("Full content of the article"),
+		field.String("author").
+			Optional().
+			Comment("Author of the article"),
+		field.Strings("categories").
+			Optional().
+			Comment("Categories of the article given by the feed"),
+		field.String("image_url").
+			Optional().
+			Comment("URL of the image of the article"),
+		field.JSON("enclosures", []Enclosure{}).
+			Optional(). // ポッドキャストや動画のフィードではメディアファイルが添付される
+			Comment("Media files attached to the article"),
 		field.Time("published_at").
 			Optional(). // 公開日時はフィードに含まれていない場合がある
 			Comment("Time the article was published"),
@@ -53,5 +72,9 @@ func (Article) Edges() []ent.Edge {
 			Unique().        // Article は一つの Feed にしか属さない
 			Required(),      // Feed は必須
 		edge.To("summary", Summary.Type).Unique(),
+		// 別のフィードから届いた同じ記事は最初の記事に紐付け、要約しない
+		edge.To("duplicates", Article.Type).
+			From("duplicate_of").
+			Unique(),
 	}
 }
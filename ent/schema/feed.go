@@ -42,6 +42,49 @@ func (Feed) Fields() []ent.Field {
 		field.Time("last_checked_at").
 			Optional().
 			Comment("Time the feed was checked"),
+		field.Int("consecutive_failures").
+			Default(0).
+			Comment("Number of fetches that failed in a row"),
+		field.String("last_error").
+			Optional().
+			Comment("Error of the last failed fetch, cleared by a successful fetch"),
+		field.Int("last_status").
+			Optional().
+			Comment("HTTP status of the last fetch, 0 when no response was received"),
+		field.Time("last_success_at").
+			Optional().
+			Nillable().
+			Comment("Time the feed was last fetched successfully"),
+		field.Bool("disabled").
+			Default(false). // 無効なフィードは取得しない
+			Comment("Disabled feeds are not fetched"),
+		field.Time("next_poll_at").
+			Optional().
+			Nillable().
+			Comment("Time the feed is due to be polled, nil when due now"),
+		field.Int("min_interval").
+			Optional().
+			Comment("Minimum polling interval in minutes, 0 for the configured one"),
+		field.Int("max_interval").
+			Optional().
+			Comment("Maximum polling interval in minutes, 0 for the configured one"),
+		field.String("hub_url").
+			Optional(). // ハブを通知しないフィードはポーリングのみ
+			Comment("WebSub hub advertised by the feed"),
+		field.String("topic_url").
+			Optional().
+			Comment("Topic URL of the feed at its hub, the feed URL when empty"),
+		field.String("websub_state").
+			Optional().
+			Comment("State of the WebSub subscription: pending, subscribed, denied, or empty when not subscribed"),
+		field.String("websub_secret").
+			Optional().
+			Sensitive().
+			Comment("Secret the hub signs the pushed content with"),
+		field.Time("websub_expires_at").
+			Optional().
+			Nillable().
+			Comment("Time the WebSub subscription lease expires"),
 		field.Time("created_at").
 			Default(time.Now). // デフォルトで現在時刻を設定
 			Immutable().       // 作成後は変更不可
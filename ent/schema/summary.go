@@ -41,6 +41,16 @@ func (Summary) Fields() []ent.Field {
 			Default(time.Now).
 			Immutable().
 			Comment("Time the feed was added"),
+		field.Strings("tags").
+			Optional().
+			Comment("Tags added by rules"),
+		field.Int("priority").
+			Default(0).
+			Comment("Priority raised by rules, higher comes first"),
+		field.Time("mailed_at").
+			Optional().
+			Nillable().
+			Comment("Time the summary was sent in a mail digest"),
 	}
 }
 
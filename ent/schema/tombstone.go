@@ -0,0 +1,32 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// Tombstone records the URL of a pruned article, so that the item is not fetched and
+// summarized again while its feed still lists it.
+type Tombstone struct {
+	ent.Schema
+}
+
+// Fields of the Tombstone.
+func (Tombstone) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Comment("Unique identifier"),
+		field.String("url").
+			Unique().
+			NotEmpty().
+			Comment("URL of the pruned article"),
+		field.Time("pruned_at").
+			Default(time.Now).
+			Immutable().
+			Comment("Time the article was pruned"),
+	}
+}
@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -35,6 +36,12 @@ type Summary struct {
 	AudioFile string `json:"audio_file,omitempty"`
 	// Time the feed was added
 	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Tags added by rules
+	Tags []string `json:"tags,omitempty"`
+	// Priority raised by rules, higher comes first
+	Priority int `json:"priority,omitempty"`
+	// Time the summary was sent in a mail digest
+	MailedAt *time.Time `json:"mailed_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the SummaryQuery when eager-loading is set.
 	Edges           SummaryEdges `json:"edges"`
@@ -81,11 +88,15 @@ func (*Summary) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case summary.FieldTags:
+			values[i] = new([]byte)
 		case summary.FieldReaded, summary.FieldListened:
 			values[i] = new(sql.NullBool)
+		case summary.FieldPriority:
+			values[i] = new(sql.NullInt64)
 		case summary.FieldURL, summary.FieldTitle, summary.FieldSummary, summary.FieldAudioFile:
 			values[i] = new(sql.NullString)
-		case summary.FieldCreatedAt:
+		case summary.FieldCreatedAt, summary.FieldMailedAt:
 			values[i] = new(sql.NullTime)
 		case summary.FieldID:
 			values[i] = new(uuid.UUID)
@@ -156,6 +167,27 @@ func (s *Summary) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				s.CreatedAt = value.Time
 			}
+		case summary.FieldTags:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field tags", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &s.Tags); err != nil {
+					return fmt.Errorf("unmarshal field tags: %w", err)
+				}
+			}
+		case summary.FieldPriority:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field priority", values[i])
+			} else if value.Valid {
+				s.Priority = int(value.Int64)
+			}
+		case summary.FieldMailedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field mailed_at", values[i])
+			} else if value.Valid {
+				s.MailedAt = new(time.Time)
+				*s.MailedAt = value.Time
+			}
 		case summary.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field article_summary", values[i])
@@ -236,6 +268,17 @@ func (s *Summary) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(s.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("tags=")
+	builder.WriteString(fmt.Sprintf("%v", s.Tags))
+	builder.WriteString(", ")
+	builder.WriteString("priority=")
+	builder.WriteString(fmt.Sprintf("%v", s.Priority))
+	builder.WriteString(", ")
+	if v := s.MailedAt; v != nil {
+		builder.WriteString("mailed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -113,6 +113,40 @@ func (sc *SummaryCreate) SetNillableCreatedAt(t *time.Time) *SummaryCreate {
 	return sc
 }
 
+// SetTags sets the "tags" field.
+func (sc *SummaryCreate) SetTags(s []string) *SummaryCreate {
+	sc.mutation.SetTags(s)
+	return sc
+}
+
+// SetPriority sets the "priority" field.
+func (sc *SummaryCreate) SetPriority(i int) *SummaryCreate {
+	sc.mutation.SetPriority(i)
+	return sc
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (sc *SummaryCreate) SetNillablePriority(i *int) *SummaryCreate {
+	if i != nil {
+		sc.SetPriority(*i)
+	}
+	return sc
+}
+
+// SetMailedAt sets the "mailed_at" field.
+func (sc *SummaryCreate) SetMailedAt(t time.Time) *SummaryCreate {
+	sc.mutation.SetMailedAt(t)
+	return sc
+}
+
+// SetNillableMailedAt sets the "mailed_at" field if the given value is not nil.
+func (sc *SummaryCreate) SetNillableMailedAt(t *time.Time) *SummaryCreate {
+	if t != nil {
+		sc.SetMailedAt(*t)
+	}
+	return sc
+}
+
 // SetID sets the "id" field.
 func (sc *SummaryCreate) SetID(u uuid.UUID) *SummaryCreate {
 	sc.mutation.SetID(u)
@@ -204,6 +238,10 @@ func (sc *SummaryCreate) defaults() {
 		v := summary.DefaultCreatedAt()
 		sc.mutation.SetCreatedAt(v)
 	}
+	if _, ok := sc.mutation.Priority(); !ok {
+		v := summary.DefaultPriority
+		sc.mutation.SetPriority(v)
+	}
 	if _, ok := sc.mutation.ID(); !ok {
 		v := summary.DefaultID()
 		sc.mutation.SetID(v)
@@ -229,6 +267,9 @@ func (sc *SummaryCreate) check() error {
 	if _, ok := sc.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Summary.created_at"`)}
 	}
+	if _, ok := sc.mutation.Priority(); !ok {
+		return &ValidationError{Name: "priority", err: errors.New(`ent: missing required field "Summary.priority"`)}
+	}
 	if len(sc.mutation.FeedIDs()) == 0 {
 		return &ValidationError{Name: "feed", err: errors.New(`ent: missing required edge "Summary.feed"`)}
 	}
@@ -295,6 +336,18 @@ func (sc *SummaryCreate) createSpec() (*Summary, *sqlgraph.CreateSpec) {
 		_spec.SetField(summary.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
 	}
+	if value, ok := sc.mutation.Tags(); ok {
+		_spec.SetField(summary.FieldTags, field.TypeJSON, value)
+		_node.Tags = value
+	}
+	if value, ok := sc.mutation.Priority(); ok {
+		_spec.SetField(summary.FieldPriority, field.TypeInt, value)
+		_node.Priority = value
+	}
+	if value, ok := sc.mutation.MailedAt(); ok {
+		_spec.SetField(summary.FieldMailedAt, field.TypeTime, value)
+		_node.MailedAt = &value
+	}
 	if nodes := sc.mutation.ArticleIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
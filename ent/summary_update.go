@@ -6,9 +6,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 	"github.com/mopemope/quicknews/ent/article"
@@ -132,6 +134,65 @@ func (su *SummaryUpdate) ClearAudioFile() *SummaryUpdate {
 	return su
 }
 
+// SetTags sets the "tags" field.
+func (su *SummaryUpdate) SetTags(s []string) *SummaryUpdate {
+	su.mutation.SetTags(s)
+	return su
+}
+
+// AppendTags appends s to the "tags" field.
+func (su *SummaryUpdate) AppendTags(s []string) *SummaryUpdate {
+	su.mutation.AppendTags(s)
+	return su
+}
+
+// ClearTags clears the value of the "tags" field.
+func (su *SummaryUpdate) ClearTags() *SummaryUpdate {
+	su.mutation.ClearTags()
+	return su
+}
+
+// SetPriority sets the "priority" field.
+func (su *SummaryUpdate) SetPriority(i int) *SummaryUpdate {
+	su.mutation.ResetPriority()
+	su.mutation.SetPriority(i)
+	return su
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (su *SummaryUpdate) SetNillablePriority(i *int) *SummaryUpdate {
+	if i != nil {
+		su.SetPriority(*i)
+	}
+	return su
+}
+
+// AddPriority adds i to the "priority" field.
+func (su *SummaryUpdate) AddPriority(i int) *SummaryUpdate {
+	su.mutation.AddPriority(i)
+	return su
+}
+
+// SetMailedAt sets the "mailed_at" field.
+func (su *SummaryUpdate) SetMailedAt(t time.Time) *SummaryUpdate {
+	su.mutation.SetMailedAt(t)
+	return su
+}
+
+// SetNillableMailedAt sets the "mailed_at" field if the given value is not nil.
+func (su *SummaryUpdate) SetNillableMailedAt(t *time.Time) *SummaryUpdate {
+	if t != nil {
+		su.SetMailedAt(*t)
+	}
+	return su
+}
+
+// ClearMailedAt clears the value of the "mailed_at" field.
+func (su *SummaryUpdate) ClearMailedAt() *SummaryUpdate {
+	su.mutation.ClearMailedAt()
+	return su
+}
+
 // SetArticleID sets the "article" edge to the Article entity by ID.
 func (su *SummaryUpdate) SetArticleID(id uuid.UUID) *SummaryUpdate {
 	su.mutation.SetArticleID(id)
@@ -258,6 +319,29 @@ func (su *SummaryUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if su.mutation.AudioFileCleared() {
 		_spec.ClearField(summary.FieldAudioFile, field.TypeString)
 	}
+	if value, ok := su.mutation.Tags(); ok {
+		_spec.SetField(summary.FieldTags, field.TypeJSON, value)
+	}
+	if value, ok := su.mutation.AppendedTags(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, summary.FieldTags, value)
+		})
+	}
+	if su.mutation.TagsCleared() {
+		_spec.ClearField(summary.FieldTags, field.TypeJSON)
+	}
+	if value, ok := su.mutation.Priority(); ok {
+		_spec.SetField(summary.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := su.mutation.AddedPriority(); ok {
+		_spec.AddField(summary.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := su.mutation.MailedAt(); ok {
+		_spec.SetField(summary.FieldMailedAt, field.TypeTime, value)
+	}
+	if su.mutation.MailedAtCleared() {
+		_spec.ClearField(summary.FieldMailedAt, field.TypeTime)
+	}
 	if su.mutation.ArticleCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
@@ -438,6 +522,65 @@ func (suo *SummaryUpdateOne) ClearAudioFile() *SummaryUpdateOne {
 	return suo
 }
 
+// SetTags sets the "tags" field.
+func (suo *SummaryUpdateOne) SetTags(s []string) *SummaryUpdateOne {
+	suo.mutation.SetTags(s)
+	return suo
+}
+
+// AppendTags appends s to the "tags" field.
+func (suo *SummaryUpdateOne) AppendTags(s []string) *SummaryUpdateOne {
+	suo.mutation.AppendTags(s)
+	return suo
+}
+
+// ClearTags clears the value of the "tags" field.
+func (suo *SummaryUpdateOne) ClearTags() *SummaryUpdateOne {
+	suo.mutation.ClearTags()
+	return suo
+}
+
+// SetPriority sets the "priority" field.
+func (suo *SummaryUpdateOne) SetPriority(i int) *SummaryUpdateOne {
+	suo.mutation.ResetPriority()
+	suo.mutation.SetPriority(i)
+	return suo
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (suo *SummaryUpdateOne) SetNillablePriority(i *int) *SummaryUpdateOne {
+	if i != nil {
+		suo.SetPriority(*i)
+	}
+	return suo
+}
+
+// AddPriority adds i to the "priority" field.
+func (suo *SummaryUpdateOne) AddPriority(i int) *SummaryUpdateOne {
+	suo.mutation.AddPriority(i)
+	return suo
+}
+
+// SetMailedAt sets the "mailed_at" field.
+func (suo *SummaryUpdateOne) SetMailedAt(t time.Time) *SummaryUpdateOne {
+	suo.mutation.SetMailedAt(t)
+	return suo
+}
+
+// SetNillableMailedAt sets the "mailed_at" field if the given value is not nil.
+func (suo *SummaryUpdateOne) SetNillableMailedAt(t *time.Time) *SummaryUpdateOne {
+	if t != nil {
+		suo.SetMailedAt(*t)
+	}
+	return suo
+}
+
+// ClearMailedAt clears the value of the "mailed_at" field.
+func (suo *SummaryUpdateOne) ClearMailedAt() *SummaryUpdateOne {
+	suo.mutation.ClearMailedAt()
+	return suo
+}
+
 // SetArticleID sets the "article" edge to the Article entity by ID.
 func (suo *SummaryUpdateOne) SetArticleID(id uuid.UUID) *SummaryUpdateOne {
 	suo.mutation.SetArticleID(id)
@@ -594,6 +737,29 @@ func (suo *SummaryUpdateOne) sqlSave(ctx context.Context) (_node *Summary, err e
 	if suo.mutation.AudioFileCleared() {
 		_spec.ClearField(summary.FieldAudioFile, field.TypeString)
 	}
+	if value, ok := suo.mutation.Tags(); ok {
+		_spec.SetField(summary.FieldTags, field.TypeJSON, value)
+	}
+	if value, ok := suo.mutation.AppendedTags(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, summary.FieldTags, value)
+		})
+	}
+	if suo.mutation.TagsCleared() {
+		_spec.ClearField(summary.FieldTags, field.TypeJSON)
+	}
+	if value, ok := suo.mutation.Priority(); ok {
+		_spec.SetField(summary.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := suo.mutation.AddedPriority(); ok {
+		_spec.AddField(summary.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := suo.mutation.MailedAt(); ok {
+		_spec.SetField(summary.FieldMailedAt, field.TypeTime, value)
+	}
+	if suo.mutation.MailedAtCleared() {
+		_spec.ClearField(summary.FieldMailedAt, field.TypeTime)
+	}
 	if suo.mutation.ArticleCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
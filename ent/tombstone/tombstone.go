@@ -0,0 +1,67 @@
+// Code generated by ent, DO NOT EDIT.
+
+package tombstone
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the tombstone type in the database.
+	Label = "tombstone"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldURL holds the string denoting the url field in the database.
+	FieldURL = "url"
+	// FieldPrunedAt holds the string denoting the pruned_at field in the database.
+	FieldPrunedAt = "pruned_at"
+	// Table holds the table name of the tombstone in the database.
+	Table = "tombstones"
+)
+
+// Columns holds all SQL columns for tombstone fields.
+var Columns = []string{
+	FieldID,
+	FieldURL,
+	FieldPrunedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// URLValidator is a validator for the "url" field. It is called by the builders before save.
+	URLValidator func(string) error
+	// DefaultPrunedAt holds the default value on creation for the "pruned_at" field.
+	DefaultPrunedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the Tombstone queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByURL orders the results by the url field.
+func ByURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldURL, opts...).ToFunc()
+}
+
+// ByPrunedAt orders the results by the pruned_at field.
+func ByPrunedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPrunedAt, opts...).ToFunc()
+}
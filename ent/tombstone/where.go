@@ -0,0 +1,186 @@
+// Code generated by ent, DO NOT EDIT.
+
+package tombstone
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/mopemope/quicknews/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.Tombstone {
+	return predicate.Tombstone(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.Tombstone {
+	return predicate.Tombstone(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.Tombstone {
+	return predicate.Tombstone(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.Tombstone {
+	return predicate.Tombstone(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.Tombstone {
+	return predicate.Tombstone(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.Tombstone {
+	return predicate.Tombstone(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.Tombstone {
+	return predicate.Tombstone(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.Tombstone {
+	return predicate.Tombstone(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.Tombstone {
+	return predicate.Tombstone(sql.FieldLTE(FieldID, id))
+}
+
+// URL applies equality check predicate on the "url" field. It's identical to URLEQ.
+func URL(v string) predicate.Tombstone {
+	return predicate.Tombstone(sql.FieldEQ(FieldURL, v))
+}
+
+// PrunedAt applies equality check predicate on the "pruned_at" field. It's identical to PrunedAtEQ.
+func PrunedAt(v time.Time) predicate.Tombstone {
+	return predicate.Tombstone(sql.FieldEQ(FieldPrunedAt, v))
+}
+
+// URLEQ applies the EQ predicate on the "url" field.
+func URLEQ(v string) predicate.Tombstone {
+	return predicate.Tombstone(sql.FieldEQ(FieldURL, v))
+}
+
+// URLNEQ applies the NEQ predicate on the "url" field.
+func URLNEQ(v string) predicate.Tombstone {
+	return predicate.Tombstone(sql.FieldNEQ(FieldURL, v))
+}
+
+// URLIn applies the In predicate on the "url" field.
+func URLIn(vs ...string) predicate.Tombstone {
+	return predicate.Tombstone(sql.FieldIn(FieldURL, vs...))
+}
+
+// URLNotIn applies the NotIn predicate on the "url" field.
+func URLNotIn(vs ...string) predicate.Tombstone {
+	return predicate.Tombstone(sql.FieldNotIn(FieldURL, vs...))
+}
+
+// URLGT applies the GT predicate on the "url" field.
+func URLGT(v string) predicate.Tombstone {
+	return predicate.Tombstone(sql.FieldGT(FieldURL, v))
+}
+
+// URLGTE applies the GTE predicate on the "url" field.
+func URLGTE(v string) predicate.Tombstone {
+	return predicate.Tombstone(sql.FieldGTE(FieldURL, v))
+}
+
+// URLLT applies the LT predicate on the "url" field.
+func URLLT(v string) predicate.Tombstone {
+	return predicate.Tombstone(sql.FieldLT(FieldURL, v))
+}
+
+// URLLTE applies the LTE predicate on the "url" field.
+func URLLTE(v string) predicate.Tombstone {
+	return predicate.Tombstone(sql.FieldLTE(FieldURL, v))
+}
+
+// URLContains applies the Contains predicate on the "url" field.
+func URLContains(v string) predicate.Tombstone {
+	return predicate.Tombstone(sql.FieldContains(FieldURL, v))
+}
+
+// URLHasPrefix applies the HasPrefix predicate on the "url" field.
+func URLHasPrefix(v string) predicate.Tombstone {
+	return predicate.Tombstone(sql.FieldHasPrefix(FieldURL, v))
+}
+
+// URLHasSuffix applies the HasSuffix predicate on the "url" field.
+func URLHasSuffix(v string) predicate.Tombstone {
+	return predicate.Tombstone(sql.FieldHasSuffix(FieldURL, v))
+}
+
+// URLEqualFold applies the EqualFold predicate on the "url" field.
+func URLEqualFold(v string) predicate.Tombstone {
+	return predicate.Tombstone(sql.FieldEqualFold(FieldURL, v))
+}
+
+// URLContainsFold applies the ContainsFold predicate on the "url" field.
+func URLContainsFold(v string) predicate.Tombstone {
+	return predicate.Tombstone(sql.FieldContainsFold(FieldURL, v))
+}
+
+// PrunedAtEQ applies the EQ predicate on the "pruned_at" field.
+func PrunedAtEQ(v time.Time) predicate.Tombstone {
+	return predicate.Tombstone(sql.FieldEQ(FieldPrunedAt, v))
+}
+
+// PrunedAtNEQ applies the NEQ predicate on the "pruned_at" field.
+func PrunedAtNEQ(v time.Time) predicate.Tombstone {
+	return predicate.Tombstone(sql.FieldNEQ(FieldPrunedAt, v))
+}
+
+// PrunedAtIn applies the In predicate on the "pruned_at" field.
+func PrunedAtIn(vs ...time.Time) predicate.Tombstone {
+	return predicate.Tombstone(sql.FieldIn(FieldPrunedAt, vs...))
+}
+
+// PrunedAtNotIn applies the NotIn predicate on the "pruned_at" field.
+func PrunedAtNotIn(vs ...time.Time) predicate.Tombstone {
+	return predicate.Tombstone(sql.FieldNotIn(FieldPrunedAt, vs...))
+}
+
+// PrunedAtGT applies the GT predicate on the "pruned_at" field.
+func PrunedAtGT(v time.Time) predicate.Tombstone {
+	return predicate.Tombstone(sql.FieldGT(FieldPrunedAt, v))
+}
+
+// PrunedAtGTE applies the GTE predicate on the "pruned_at" field.
+func PrunedAtGTE(v time.Time) predicate.Tombstone {
+	return predicate.Tombstone(sql.FieldGTE(FieldPrunedAt, v))
+}
+
+// PrunedAtLT applies the LT predicate on the "pruned_at" field.
+func PrunedAtLT(v time.Time) predicate.Tombstone {
+	return predicate.Tombstone(sql.FieldLT(FieldPrunedAt, v))
+}
+
+// PrunedAtLTE applies the LTE predicate on the "pruned_at" field.
+func PrunedAtLTE(v time.Time) predicate.Tombstone {
+	return predicate.Tombstone(sql.FieldLTE(FieldPrunedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Tombstone) predicate.Tombstone {
+	return predicate.Tombstone(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Tombstone) predicate.Tombstone {
+	return predicate.Tombstone(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Tombstone) predicate.Tombstone {
+	return predicate.Tombstone(sql.NotPredicates(p))
+}
@@ -0,0 +1,244 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/mopemope/quicknews/ent/tombstone"
+)
+
+// TombstoneCreate is the builder for creating a Tombstone entity.
+type TombstoneCreate struct {
+	config
+	mutation *TombstoneMutation
+	hooks    []Hook
+}
+
+// SetURL sets the "url" field.
+func (tc *TombstoneCreate) SetURL(s string) *TombstoneCreate {
+	tc.mutation.SetURL(s)
+	return tc
+}
+
+// SetPrunedAt sets the "pruned_at" field.
+func (tc *TombstoneCreate) SetPrunedAt(t time.Time) *TombstoneCreate {
+	tc.mutation.SetPrunedAt(t)
+	return tc
+}
+
+// SetNillablePrunedAt sets the "pruned_at" field if the given value is not nil.
+func (tc *TombstoneCreate) SetNillablePrunedAt(t *time.Time) *TombstoneCreate {
+	if t != nil {
+		tc.SetPrunedAt(*t)
+	}
+	return tc
+}
+
+// SetID sets the "id" field.
+func (tc *TombstoneCreate) SetID(u uuid.UUID) *TombstoneCreate {
+	tc.mutation.SetID(u)
+	return tc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (tc *TombstoneCreate) SetNillableID(u *uuid.UUID) *TombstoneCreate {
+	if u != nil {
+		tc.SetID(*u)
+	}
+	return tc
+}
+
+// Mutation returns the TombstoneMutation object of the builder.
+func (tc *TombstoneCreate) Mutation() *TombstoneMutation {
+	return tc.mutation
+}
+
+// Save creates the Tombstone in the database.
+func (tc *TombstoneCreate) Save(ctx context.Context) (*Tombstone, error) {
+	tc.defaults()
+	return withHooks(ctx, tc.sqlSave, tc.mutation, tc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (tc *TombstoneCreate) SaveX(ctx context.Context) *Tombstone {
+	v, err := tc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tc *TombstoneCreate) Exec(ctx context.Context) error {
+	_, err := tc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tc *TombstoneCreate) ExecX(ctx context.Context) {
+	if err := tc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tc *TombstoneCreate) defaults() {
+	if _, ok := tc.mutation.PrunedAt(); !ok {
+		v := tombstone.DefaultPrunedAt()
+		tc.mutation.SetPrunedAt(v)
+	}
+	if _, ok := tc.mutation.ID(); !ok {
+		v := tombstone.DefaultID()
+		tc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tc *TombstoneCreate) check() error {
+	if _, ok := tc.mutation.URL(); !ok {
+		return &ValidationError{Name: "url", err: errors.New(`ent: missing required field "Tombstone.url"`)}
+	}
+	if v, ok := tc.mutation.URL(); ok {
+		if err := tombstone.URLValidator(v); err != nil {
+			return &ValidationError{Name: "url", err: fmt.Errorf(`ent: validator failed for field "Tombstone.url": %w`, err)}
+		}
+	}
+	if _, ok := tc.mutation.PrunedAt(); !ok {
+		return &ValidationError{Name: "pruned_at", err: errors.New(`ent: missing required field "Tombstone.pruned_at"`)}
+	}
+	return nil
+}
+
+func (tc *TombstoneCreate) sqlSave(ctx context.Context) (*Tombstone, error) {
+	if err := tc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := tc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, tc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	tc.mutation.id = &_node.ID
+	tc.mutation.done = true
+	return _node, nil
+}
+
+func (tc *TombstoneCreate) createSpec() (*Tombstone, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Tombstone{config: tc.config}
+		_spec = sqlgraph.NewCreateSpec(tombstone.Table, sqlgraph.NewFieldSpec(tombstone.FieldID, field.TypeUUID))
+	)
+	if id, ok := tc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := tc.mutation.URL(); ok {
+		_spec.SetField(tombstone.FieldURL, field.TypeString, value)
+		_node.URL = value
+	}
+	if value, ok := tc.mutation.PrunedAt(); ok {
+		_spec.SetField(tombstone.FieldPrunedAt, field.TypeTime, value)
+		_node.PrunedAt = value
+	}
+	return _node, _spec
+}
+
+// TombstoneCreateBulk is the builder for creating many Tombstone entities in bulk.
+type TombstoneCreateBulk struct {
+	config
+	err      error
+	builders []*TombstoneCreate
+}
+
+// Save creates the Tombstone entities in the database.
+func (tcb *TombstoneCreateBulk) Save(ctx context.Context) ([]*Tombstone, error) {
+	if tcb.err != nil {
+		return nil, tcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(tcb.builders))
+	nodes := make([]*Tombstone, len(tcb.builders))
+	mutators := make([]Mutator, len(tcb.builders))
+	for i := range tcb.builders {
+		func(i int, root context.Context) {
+			builder := tcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TombstoneMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, tcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, tcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, tcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tcb *TombstoneCreateBulk) SaveX(ctx context.Context) []*Tombstone {
+	v, err := tcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tcb *TombstoneCreateBulk) Exec(ctx context.Context) error {
+	_, err := tcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tcb *TombstoneCreateBulk) ExecX(ctx context.Context) {
+	if err := tcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
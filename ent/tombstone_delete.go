@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mopemope/quicknews/ent/predicate"
+	"github.com/mopemope/quicknews/ent/tombstone"
+)
+
+// TombstoneDelete is the builder for deleting a Tombstone entity.
+type TombstoneDelete struct {
+	config
+	hooks    []Hook
+	mutation *TombstoneMutation
+}
+
+// Where appends a list predicates to the TombstoneDelete builder.
+func (td *TombstoneDelete) Where(ps ...predicate.Tombstone) *TombstoneDelete {
+	td.mutation.Where(ps...)
+	return td
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (td *TombstoneDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, td.sqlExec, td.mutation, td.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (td *TombstoneDelete) ExecX(ctx context.Context) int {
+	n, err := td.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (td *TombstoneDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(tombstone.Table, sqlgraph.NewFieldSpec(tombstone.FieldID, field.TypeUUID))
+	if ps := td.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, td.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	td.mutation.done = true
+	return affected, err
+}
+
+// TombstoneDeleteOne is the builder for deleting a single Tombstone entity.
+type TombstoneDeleteOne struct {
+	td *TombstoneDelete
+}
+
+// Where appends a list predicates to the TombstoneDelete builder.
+func (tdo *TombstoneDeleteOne) Where(ps ...predicate.Tombstone) *TombstoneDeleteOne {
+	tdo.td.mutation.Where(ps...)
+	return tdo
+}
+
+// Exec executes the deletion query.
+func (tdo *TombstoneDeleteOne) Exec(ctx context.Context) error {
+	n, err := tdo.td.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{tombstone.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tdo *TombstoneDeleteOne) ExecX(ctx context.Context) {
+	if err := tdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
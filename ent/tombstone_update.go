@@ -0,0 +1,235 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mopemope/quicknews/ent/predicate"
+	"github.com/mopemope/quicknews/ent/tombstone"
+)
+
+// TombstoneUpdate is the builder for updating Tombstone entities.
+type TombstoneUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TombstoneMutation
+}
+
+// Where appends a list predicates to the TombstoneUpdate builder.
+func (tu *TombstoneUpdate) Where(ps ...predicate.Tombstone) *TombstoneUpdate {
+	tu.mutation.Where(ps...)
+	return tu
+}
+
+// SetURL sets the "url" field.
+func (tu *TombstoneUpdate) SetURL(s string) *TombstoneUpdate {
+	tu.mutation.SetURL(s)
+	return tu
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (tu *TombstoneUpdate) SetNillableURL(s *string) *TombstoneUpdate {
+	if s != nil {
+		tu.SetURL(*s)
+	}
+	return tu
+}
+
+// Mutation returns the TombstoneMutation object of the builder.
+func (tu *TombstoneUpdate) Mutation() *TombstoneMutation {
+	return tu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (tu *TombstoneUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, tu.sqlSave, tu.mutation, tu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tu *TombstoneUpdate) SaveX(ctx context.Context) int {
+	affected, err := tu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (tu *TombstoneUpdate) Exec(ctx context.Context) error {
+	_, err := tu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tu *TombstoneUpdate) ExecX(ctx context.Context) {
+	if err := tu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tu *TombstoneUpdate) check() error {
+	if v, ok := tu.mutation.URL(); ok {
+		if err := tombstone.URLValidator(v); err != nil {
+			return &ValidationError{Name: "url", err: fmt.Errorf(`ent: validator failed for field "Tombstone.url": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (tu *TombstoneUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := tu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(tombstone.Table, tombstone.Columns, sqlgraph.NewFieldSpec(tombstone.FieldID, field.TypeUUID))
+	if ps := tu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tu.mutation.URL(); ok {
+		_spec.SetField(tombstone.FieldURL, field.TypeString, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, tu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tombstone.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	tu.mutation.done = true
+	return n, nil
+}
+
+// TombstoneUpdateOne is the builder for updating a single Tombstone entity.
+type TombstoneUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TombstoneMutation
+}
+
+// SetURL sets the "url" field.
+func (tuo *TombstoneUpdateOne) SetURL(s string) *TombstoneUpdateOne {
+	tuo.mutation.SetURL(s)
+	return tuo
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (tuo *TombstoneUpdateOne) SetNillableURL(s *string) *TombstoneUpdateOne {
+	if s != nil {
+		tuo.SetURL(*s)
+	}
+	return tuo
+}
+
+// Mutation returns the TombstoneMutation object of the builder.
+func (tuo *TombstoneUpdateOne) Mutation() *TombstoneMutation {
+	return tuo.mutation
+}
+
+// Where appends a list predicates to the TombstoneUpdate builder.
+func (tuo *TombstoneUpdateOne) Where(ps ...predicate.Tombstone) *TombstoneUpdateOne {
+	tuo.mutation.Where(ps...)
+	return tuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (tuo *TombstoneUpdateOne) Select(field string, fields ...string) *TombstoneUpdateOne {
+	tuo.fields = append([]string{field}, fields...)
+	return tuo
+}
+
+// Save executes the query and returns the updated Tombstone entity.
+func (tuo *TombstoneUpdateOne) Save(ctx context.Context) (*Tombstone, error) {
+	return withHooks(ctx, tuo.sqlSave, tuo.mutation, tuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tuo *TombstoneUpdateOne) SaveX(ctx context.Context) *Tombstone {
+	node, err := tuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (tuo *TombstoneUpdateOne) Exec(ctx context.Context) error {
+	_, err := tuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tuo *TombstoneUpdateOne) ExecX(ctx context.Context) {
+	if err := tuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tuo *TombstoneUpdateOne) check() error {
+	if v, ok := tuo.mutation.URL(); ok {
+		if err := tombstone.URLValidator(v); err != nil {
+			return &ValidationError{Name: "url", err: fmt.Errorf(`ent: validator failed for field "Tombstone.url": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (tuo *TombstoneUpdateOne) sqlSave(ctx context.Context) (_node *Tombstone, err error) {
+	if err := tuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(tombstone.Table, tombstone.Columns, sqlgraph.NewFieldSpec(tombstone.FieldID, field.TypeUUID))
+	id, ok := tuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Tombstone.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := tuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, tombstone.FieldID)
+		for _, f := range fields {
+			if !tombstone.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != tombstone.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := tuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tuo.mutation.URL(); ok {
+		_spec.SetField(tombstone.FieldURL, field.TypeString, value)
+	}
+	_node = &Tombstone{config: tuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, tuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tombstone.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	tuo.mutation.done = true
+	return _node, nil
+}
@@ -18,6 +18,8 @@ type Tx struct {
 	Feed *FeedClient
 	// Summary is the client for interacting with the Summary builders.
 	Summary *SummaryClient
+	// Tombstone is the client for interacting with the Tombstone builders.
+	Tombstone *TombstoneClient
 
 	// lazily loaded.
 	client     *Client
@@ -152,6 +154,7 @@ func (tx *Tx) init() {
 	tx.Article = NewArticleClient(tx.config)
 	tx.Feed = NewFeedClient(tx.config)
 	tx.Summary = NewSummaryClient(tx.config)
+	tx.Tombstone = NewTombstoneClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
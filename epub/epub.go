@@ -0,0 +1,270 @@
+// Package epub writes summaries as an EPUB 3 book.
+package epub
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"html/template"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// Book is a digest of summaries grouped by feed.
+type Book struct {
+	ID       string // Unique identifier, e.g. urn:uuid:...
+	Title    string
+	Language string
+	Modified time.Time
+	Sections []*Section
+}
+
+// Section is a feed with its articles, rendered as one chapter file.
+type Section struct {
+	Title    string
+	Articles []*Article
+}
+
+// Article is a summary with an optional full text.
+type Article struct {
+	ID        string
+	Title     string
+	URL       string
+	Published time.Time
+	Summary   string
+	Content   []string // Paragraphs of the full article
+}
+
+// file is a content document of the book.
+type file struct {
+	Name    string
+	ID      string
+	Section *Section
+}
+
+func (b *Book) files() []file {
+	files := make([]file, len(b.Sections))
+	for i, s := range b.Sections {
+		files[i] = file{Name: fmt.Sprintf("feed%03d.xhtml", i+1), ID: fmt.Sprintf("feed%03d", i+1), Section: s}
+	}
+	return files
+}
+
+// Write writes the book as an EPUB 3 container.
+func (b *Book) Write(w io.Writer) error {
+	zw := zip.NewWriter(w)
+
+	// The mimetype must be the first entry, stored without compression.
+	mw, err := zw.CreateHeader(&zip.FileHeader{Name: "mimetype", Method: zip.Store})
+	if err != nil {
+		return errors.Wrap(err, "failed to write mimetype")
+	}
+	if _, err := io.WriteString(mw, "application/epub+zip"); err != nil {
+		return errors.Wrap(err, "failed to write mimetype")
+	}
+
+	data := map[string]any{
+		"Book":     b,
+		"Files":    b.files(),
+		"Modified": b.Modified.UTC().Format("2006-01-02T15:04:05Z"),
+	}
+	entries := []struct{ name, tmpl string }{
+		{"META-INF/container.xml", "container"},
+		{"OEBPS/content.opf", "opf"},
+		{"OEBPS/nav.xhtml", "nav"},
+		{"OEBPS/toc.ncx", "ncx"},
+	}
+	for _, e := range entries {
+		if err := writeTemplate(zw, e.name, e.tmpl, data); err != nil {
+			return err
+		}
+	}
+	if err := writeEntry(zw, "OEBPS/style.css", []byte(stylesheet)); err != nil {
+		return err
+	}
+	for _, f := range b.files() {
+		if err := writeTemplate(zw, "OEBPS/"+f.Name, "section", map[string]any{"Book": b, "File": f}); err != nil {
+			return err
+		}
+	}
+
+	if err := zw.Close(); err != nil {
+		return errors.Wrap(err, "failed to close EPUB")
+	}
+	return nil
+}
+
+func writeTemplate(zw *zip.Writer, name, tmpl string, data any) error {
+	var buf bytes.Buffer
+	buf.WriteString(`<?xml version="1.0" encoding="UTF-8"?>` + "\n")
+	if err := templates.ExecuteTemplate(&buf, tmpl, data); err != nil {
+		return errors.Wrapf(err, "failed to render %s", name)
+	}
+	return writeEntry(zw, name, buf.Bytes())
+}
+
+func writeEntry(zw *zip.Writer, name string, data []byte) error {
+	w, err := zw.Create(name)
+	if err != nil {
+		return errors.Wrapf(err, "failed to create %s", name)
+	}
+	if _, err := w.Write(data); err != nil {
+		return errors.Wrapf(err, "failed to write %s", name)
+	}
+	return nil
+}
+
+// Paragraphs returns the text of each block element of an HTML fragment, for including
+// feed content that is not well-formed XHTML.
+func Paragraphs(fragment string) []string {
+	nodes, err := html.ParseFragment(strings.NewReader(fragment), &html.Node{Type: html.ElementNode, Data: "body", DataAtom: atom.Body})
+	if err != nil {
+		return nil
+	}
+
+	var paragraphs []string
+	var current strings.Builder
+	flush := func() {
+		if text := strings.Join(strings.Fields(current.String()), " "); text != "" {
+			paragraphs = append(paragraphs, text)
+		}
+		current.Reset()
+	}
+	var walk func(n *html.Node)
+	walk = func(n *html.Node) {
+		switch n.Type {
+		case html.TextNode:
+			current.WriteString(n.Data)
+		case html.ElementNode:
+			switch n.Data {
+			case "script", "style":
+				return
+			case "p", "div", "br", "li", "h1", "h2", "h3", "h4", "h5", "h6", "blockquote", "pre", "tr":
+				flush()
+				defer flush()
+			default:
+				current.WriteString(" ")
+				defer current.WriteString(" ")
+			}
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			walk(c)
+		}
+	}
+	for _, n := range nodes {
+		walk(n)
+	}
+	flush()
+	return paragraphs
+}
+
+func summaryParagraphs(s string) []string {
+	var paragraphs []string
+	for _, p := range strings.Split(s, "\n") {
+		if p = strings.TrimSpace(p); p != "" {
+			paragraphs = append(paragraphs, p)
+		}
+	}
+	return paragraphs
+}
+
+const stylesheet = `body { font-family: serif; line-height: 1.6; }
+h1 { font-size: 1.4em; }
+h2 { font-size: 1.2em; margin-top: 2em; }
+.meta { font-size: 0.8em; color: #555; }
+.content { margin-top: 1em; border-top: 1px solid #999; }
+`
+
+var templates = template.Must(template.New("epub").Funcs(template.FuncMap{
+	"paragraphs": summaryParagraphs,
+	"date":       func(t time.Time) string { return t.Local().Format("2006-01-02 15:04") },
+}).Parse(`
+{{define "container"}}<container version="1.0" xmlns="urn:oasis:names:tc:opendocument:xmlns:container">
+  <rootfiles>
+    <rootfile full-path="OEBPS/content.opf" media-type="application/oebps-package+xml"/>
+  </rootfiles>
+</container>
+{{end}}
+
+{{define "opf"}}<package xmlns="http://www.idpf.org/2007/opf" version="3.0" unique-identifier="bookid" xml:lang="{{.Book.Language}}">
+  <metadata xmlns:dc="http://purl.org/dc/elements/1.1/">
+    <dc:identifier id="bookid">{{.Book.ID}}</dc:identifier>
+    <dc:title>{{.Book.Title}}</dc:title>
+    <dc:language>{{.Book.Language}}</dc:language>
+    <meta property="dcterms:modified">{{.Modified}}</meta>
+  </metadata>
+  <manifest>
+    <item id="nav" href="nav.xhtml" media-type="application/xhtml+xml" properties="nav"/>
+    <item id="ncx" href="toc.ncx" media-type="application/x-dtbncx+xml"/>
+    <item id="css" href="style.css" media-type="text/css"/>
+{{range .Files}}    <item id="{{.ID}}" href="{{.Name}}" media-type="application/xhtml+xml"/>
+{{end}}  </manifest>
+  <spine toc="ncx">
+    <itemref idref="nav"/>
+{{range .Files}}    <itemref idref="{{.ID}}"/>
+{{end}}  </spine>
+</package>
+{{end}}
+
+{{define "nav"}}<html xmlns="http://www.w3.org/1999/xhtml" xmlns:epub="http://www.idpf.org/2007/ops" xml:lang="{{.Book.Language}}">
+<head>
+  <title>{{.Book.Title}}</title>
+  <link rel="stylesheet" type="text/css" href="style.css"/>
+</head>
+<body>
+  <nav epub:type="toc" id="toc">
+    <h1>{{.Book.Title}}</h1>
+    <ol>
+{{range .Files}}      <li><a href="{{.Name}}">{{.Section.Title}}</a>
+        <ol>
+{{$file := .}}{{range .Section.Articles}}          <li><a href="{{$file.Name}}#a-{{.ID}}">{{.Title}}</a></li>
+{{end}}        </ol>
+      </li>
+{{end}}    </ol>
+  </nav>
+</body>
+</html>
+{{end}}
+
+{{define "ncx"}}<ncx xmlns="http://www.daisy.org/z3986/2005/ncx/" version="2005-1">
+  <head>
+    <meta name="dtb:uid" content="{{.Book.ID}}"/>
+  </head>
+  <docTitle><text>{{.Book.Title}}</text></docTitle>
+  <navMap>
+{{range $i, $f := .Files}}    <navPoint id="nav-{{$f.ID}}">
+      <navLabel><text>{{$f.Section.Title}}</text></navLabel>
+      <content src="{{$f.Name}}"/>
+{{range $f.Section.Articles}}      <navPoint id="nav-{{.ID}}">
+        <navLabel><text>{{.Title}}</text></navLabel>
+        <content src="{{$f.Name}}#a-{{.ID}}"/>
+      </navPoint>
+{{end}}    </navPoint>
+{{end}}  </navMap>
+</ncx>
+{{end}}
+
+{{define "section"}}<html xmlns="http://www.w3.org/1999/xhtml" xml:lang="{{.Book.Language}}">
+<head>
+  <title>{{.File.Section.Title}}</title>
+  <link rel="stylesheet" type="text/css" href="style.css"/>
+</head>
+<body>
+  <h1>{{.File.Section.Title}}</h1>
+{{range .File.Section.Articles}}  <section id="a-{{.ID}}">
+    <h2>{{.Title}}</h2>
+    <p class="meta">{{date .Published}} · <a href="{{.URL}}">{{.URL}}</a></p>
+{{range paragraphs .Summary}}    <p>{{.}}</p>
+{{end}}{{if .Content}}    <div class="content">
+{{range .Content}}      <p>{{.}}</p>
+{{end}}    </div>
+{{end}}  </section>
+{{end}}</body>
+</html>
+{{end}}
+`))
@@ -0,0 +1,79 @@
+package epub
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBook_Write(t *testing.T) {
+	book := &Book{
+		ID:       "urn:uuid:00000000-0000-0000-0000-000000000001",
+		Title:    "quicknews digest",
+		Language: "ja",
+		Modified: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		Sections: []*Section{
+			{Title: "Feed & One", Articles: []*Article{
+				{ID: "1", Title: "First <article>", URL: "https://example.com/1?a=1&b=2", Summary: "line one\n\nline two"},
+				{ID: "2", Title: "Second", URL: "https://example.com/2", Summary: "summary", Content: []string{"full text"}},
+			}},
+			{Title: "Feed Two", Articles: []*Article{
+				{ID: "3", Title: "Third", URL: "https://example.com/3", Summary: "summary"},
+			}},
+		},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, book.Write(&buf))
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+	require.NotEmpty(t, zr.File)
+	assert.Equal(t, "mimetype", zr.File[0].Name)
+	assert.Equal(t, zip.Store, zr.File[0].Method)
+
+	files := map[string]string{}
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		require.NoError(t, err)
+		data, err := io.ReadAll(rc)
+		require.NoError(t, err)
+		_ = rc.Close()
+		files[f.Name] = string(data)
+
+		if strings.HasSuffix(f.Name, ".xhtml") || strings.HasSuffix(f.Name, ".opf") ||
+			strings.HasSuffix(f.Name, ".ncx") || strings.HasSuffix(f.Name, ".xml") {
+			// Every document must be well-formed XML.
+			dec := xml.NewDecoder(bytes.NewReader(data))
+			for {
+				_, err := dec.Token()
+				if err == io.EOF {
+					break
+				}
+				require.NoError(t, err, f.Name)
+			}
+		}
+	}
+
+	assert.Equal(t, "application/epub+zip", files["mimetype"])
+	assert.Contains(t, files["META-INF/container.xml"], `full-path="OEBPS/content.opf"`)
+	assert.Contains(t, files["OEBPS/content.opf"], `<item id="feed002" href="feed002.xhtml"`)
+	assert.Contains(t, files["OEBPS/nav.xhtml"], `<a href="feed001.xhtml">Feed &amp; One</a>`)
+	assert.Contains(t, files["OEBPS/nav.xhtml"], `<a href="feed001.xhtml#a-2">Second</a>`)
+	assert.Contains(t, files["OEBPS/feed001.xhtml"], `<section id="a-1">`)
+	assert.Contains(t, files["OEBPS/feed001.xhtml"], `<p>line two</p>`)
+	assert.Contains(t, files["OEBPS/feed001.xhtml"], `<p>full text</p>`)
+	assert.Contains(t, files["OEBPS/feed002.xhtml"], `<a href="https://example.com/3">`)
+}
+
+func TestParagraphs(t *testing.T) {
+	content := `<p>First <b>bold</b> paragraph.</p><div>Second<br>line</div><script>alert(1)</script><ul><li>item</li></ul>`
+	assert.Equal(t, []string{"First bold paragraph.", "Second", "line", "item"}, Paragraphs(content))
+}
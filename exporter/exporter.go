@@ -0,0 +1,43 @@
+// Package exporter fans a new or updated summary out to the configured export sinks.
+package exporter
+
+import (
+	"github.com/cockroachdb/errors"
+	"github.com/mopemope/quicknews/config"
+	"github.com/mopemope/quicknews/ent"
+	"github.com/mopemope/quicknews/markdown"
+	"github.com/mopemope/quicknews/org"
+)
+
+// Exporter writes a summary to an external destination such as a notes directory.
+// The summary is expected to have its feed and article edges loaded.
+type Exporter interface {
+	Name() string
+	Export(sum *ent.Summary) error
+}
+
+// Exporters runs several exporters.
+type Exporters []Exporter
+
+// New returns the exporters enabled in the config.
+func New(config *config.Config) Exporters {
+	var exporters Exporters
+	if config.ExportOrg != "" {
+		exporters = append(exporters, org.NewExporter(config))
+	}
+	if config.ExportMarkdown != "" {
+		exporters = append(exporters, markdown.NewExporter(config.ExportMarkdown))
+	}
+	return exporters
+}
+
+// Export runs every exporter, even when one of them fails, and returns the combined errors.
+func (e Exporters) Export(sum *ent.Summary) error {
+	var errs error
+	for _, ex := range e {
+		if err := ex.Export(sum); err != nil {
+			errs = errors.CombineErrors(errs, errors.Wrapf(err, "failed to export to %s", ex.Name()))
+		}
+	}
+	return errs
+}
@@ -0,0 +1,101 @@
+package exporter
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"github.com/cockroachdb/errors"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/mopemope/quicknews/config"
+	"github.com/mopemope/quicknews/ent"
+	"github.com/mopemope/quicknews/ent/enttest"
+	"github.com/mopemope/quicknews/models/summary"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeExporter struct {
+	name     string
+	err      error
+	exported int
+	last     *ent.Summary
+}
+
+func (f *fakeExporter) Name() string { return f.name }
+
+func (f *fakeExporter) Export(sum *ent.Summary) error {
+	f.exported++
+	f.last = sum
+	return f.err
+}
+
+func TestNew(t *testing.T) {
+	assert.Empty(t, New(&config.Config{}))
+
+	exporters := New(&config.Config{ExportOrg: "/tmp/org", ExportMarkdown: "/tmp/vault"})
+	if assert.Len(t, exporters, 2) {
+		assert.Equal(t, "org", exporters[0].Name())
+		assert.Equal(t, "markdown", exporters[1].Name())
+	}
+}
+
+func TestExporters_Export(t *testing.T) {
+	failing := &fakeExporter{name: "failing", err: errors.New("boom")}
+	ok := &fakeExporter{name: "ok"}
+
+	err := Exporters{failing, ok}.Export(&ent.Summary{})
+	assert.ErrorContains(t, err, "failed to export to failing")
+	// A failing exporter does not prevent the others from running.
+	assert.Equal(t, 1, ok.exported)
+
+	assert.NoError(t, Exporters{ok}.Export(&ent.Summary{}))
+}
+
+func TestWithExport(t *testing.T) {
+	client := enttest.Open(t, dialect.SQLite, "file:exporter?mode=memory&cache=shared&_fk=1")
+	defer func() { _ = client.Close() }()
+	ctx := context.Background()
+
+	f, err := client.Feed.Create().
+		SetURL("https://example.com/feed").
+		SetTitle("Example").
+		SetDescription("").
+		SetLink("https://example.com").
+		SetUpdatedAt(time.Now()).
+		Save(ctx)
+	require.NoError(t, err)
+	a, err := client.Article.Create().
+		SetTitle("Article").
+		SetURL("https://example.com/article").
+		SetDescription("").
+		SetContent("").
+		SetFeed(f).
+		Save(ctx)
+	require.NoError(t, err)
+	sum, err := client.Summary.Create().
+		SetURL(a.URL).
+		SetTitle("Article").
+		SetSummary("summary").
+		SetArticle(a).
+		SetFeed(f).
+		Save(ctx)
+	require.NoError(t, err)
+
+	repo := summary.NewRepository(client)
+	assert.Same(t, repo, WithExport(repo, nil))
+
+	fake := &fakeExporter{name: "fake"}
+	wrapped := WithExport(repo, Exporters{fake})
+
+	require.NoError(t, wrapped.UpdateReaded(ctx, sum))
+	require.Equal(t, 1, fake.exported)
+	assert.True(t, fake.last.Readed)
+	assert.NotNil(t, fake.last.Edges.Feed)
+	assert.NotNil(t, fake.last.Edges.Article)
+
+	require.NoError(t, wrapped.UpdateListened(ctx, sum))
+	require.Equal(t, 2, fake.exported)
+	assert.True(t, fake.last.Listened)
+}
@@ -0,0 +1,53 @@
+package exporter
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/mopemope/quicknews/ent"
+	"github.com/mopemope/quicknews/models/summary"
+)
+
+// summaryRepository re-exports a summary when its read or listened state changes.
+type summaryRepository struct {
+	summary.SummaryRepository
+	exporters Exporters
+}
+
+// WithExport wraps the repository so that read and listened state changes reach the
+// exported files. The repository is returned as is when no exporter is configured.
+func WithExport(repo summary.SummaryRepository, exporters Exporters) summary.SummaryRepository {
+	if len(exporters) == 0 {
+		return repo
+	}
+	return &summaryRepository{SummaryRepository: repo, exporters: exporters}
+}
+
+func (r *summaryRepository) UpdateReaded(ctx context.Context, sum *ent.Summary) error {
+	if err := r.SummaryRepository.UpdateReaded(ctx, sum); err != nil {
+		return err
+	}
+	r.export(ctx, sum)
+	return nil
+}
+
+func (r *summaryRepository) UpdateListened(ctx context.Context, sum *ent.Summary) error {
+	if err := r.SummaryRepository.UpdateListened(ctx, sum); err != nil {
+		return err
+	}
+	r.export(ctx, sum)
+	return nil
+}
+
+// export reloads the summary with its edges and exports it. Failures are only logged, the
+// state change itself has been saved.
+func (r *summaryRepository) export(ctx context.Context, sum *ent.Summary) {
+	updated, err := r.GetByID(ctx, sum.ID)
+	if err != nil {
+		slog.Error("failed to reload summary for export", "summary_id", sum.ID, "error", err)
+		return
+	}
+	if err := r.exporters.Export(updated); err != nil {
+		slog.Error("failed to export summary", "summary_id", sum.ID, "error", err)
+	}
+}
@@ -0,0 +1,227 @@
+// Package feedgen renders summaries as Atom 1.0 and JSON Feed 1.1 documents.
+package feedgen
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"io"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/mopemope/quicknews/ent"
+	"github.com/mopemope/quicknews/ent/schema"
+)
+
+// Meta describes the generated feed.
+type Meta struct {
+	Title   string
+	Link    string // Home page of the feed
+	FeedURL string // URL the document is published at
+	Author  string
+	Updated time.Time
+}
+
+// Entry is a summary of an article.
+type Entry struct {
+	ID         string
+	Title      string
+	URL        string // Original article
+	Content    string // Summary text
+	Feed       string // Title of the source feed
+	Tags       []string
+	Author     string
+	Image      string
+	Enclosures []schema.Enclosure
+	Published  time.Time
+	Updated    time.Time
+}
+
+// EntryFromSummary builds an entry from a summary with its feed and article edges loaded.
+func EntryFromSummary(sum *ent.Summary) *Entry {
+	e := &Entry{
+		ID:        "urn:uuid:" + sum.ID.String(),
+		Title:     sum.Title,
+		URL:       sum.URL,
+		Content:   sum.Summary,
+		Tags:      sum.Tags,
+		Published: sum.CreatedAt,
+		Updated:   sum.CreatedAt,
+	}
+	if sum.Edges.Feed != nil {
+		e.Feed = sum.Edges.Feed.Title
+	}
+	if a := sum.Edges.Article; a != nil {
+		if !a.PublishedAt.IsZero() {
+			e.Published = a.PublishedAt
+		}
+		e.Author = a.Author
+		e.Image = a.ImageURL
+		e.Enclosures = a.Enclosures
+	}
+	return e
+}
+
+type atomFeed struct {
+	XMLName xml.Name    `xml:"http://www.w3.org/2005/Atom feed"`
+	ID      string      `xml:"id"`
+	Title   string      `xml:"title"`
+	Updated string      `xml:"updated"`
+	Links   []atomLink  `xml:"link"`
+	Author  atomPerson  `xml:"author"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+type atomLink struct {
+	Href   string `xml:"href,attr"`
+	Rel    string `xml:"rel,attr,omitempty"`
+	Type   string `xml:"type,attr,omitempty"`
+	Length int64  `xml:"length,attr,omitempty"`
+}
+
+type atomPerson struct {
+	Name string `xml:"name"`
+}
+
+type atomCategory struct {
+	Term string `xml:"term,attr"`
+}
+
+type atomText struct {
+	Type string `xml:"type,attr"`
+	Text string `xml:",chardata"`
+}
+
+type atomEntry struct {
+	ID        string         `xml:"id"`
+	Title     string         `xml:"title"`
+	Links     []atomLink     `xml:"link"`
+	Author    *atomPerson    `xml:"author"`
+	Published string         `xml:"published"`
+	Updated   string         `xml:"updated"`
+	Category  []atomCategory `xml:"category"`
+	Content   atomText       `xml:"content"`
+}
+
+// WriteAtom writes the entries as an Atom 1.0 document.
+func WriteAtom(w io.Writer, meta *Meta, entries []*Entry) error {
+	feed := atomFeed{
+		ID:      meta.FeedURL,
+		Title:   meta.Title,
+		Updated: meta.Updated.UTC().Format(time.RFC3339),
+		Author:  atomPerson{Name: meta.Author},
+	}
+	if feed.ID == "" {
+		feed.ID = "urn:quicknews:summaries"
+	}
+	if meta.Link != "" {
+		feed.Links = append(feed.Links, atomLink{Href: meta.Link, Rel: "alternate"})
+	}
+	if meta.FeedURL != "" {
+		feed.Links = append(feed.Links, atomLink{Href: meta.FeedURL, Rel: "self", Type: "application/atom+xml"})
+	}
+	for _, e := range entries {
+		entry := atomEntry{
+			ID:        e.ID,
+			Title:     e.Title,
+			Links:     []atomLink{{Href: e.URL, Rel: "alternate"}},
+			Published: e.Published.UTC().Format(time.RFC3339),
+			Updated:   e.Updated.UTC().Format(time.RFC3339),
+			Content:   atomText{Type: "text", Text: e.Content},
+		}
+		if e.Author != "" {
+			entry.Author = &atomPerson{Name: e.Author}
+		}
+		for _, enc := range e.Enclosures {
+			entry.Links = append(entry.Links, atomLink{Href: enc.URL, Rel: "enclosure", Type: enc.Type, Length: enc.Length})
+		}
+		if e.Feed != "" {
+			entry.Category = append(entry.Category, atomCategory{Term: e.Feed})
+		}
+		for _, tag := range e.Tags {
+			entry.Category = append(entry.Category, atomCategory{Term: tag})
+		}
+		feed.Entries = append(feed.Entries, entry)
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return errors.Wrap(err, "failed to write Atom feed")
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(feed); err != nil {
+		return errors.Wrap(err, "failed to write Atom feed")
+	}
+	return nil
+}
+
+type jsonFeed struct {
+	Version     string         `json:"version"`
+	Title       string         `json:"title"`
+	HomePageURL string         `json:"home_page_url,omitempty"`
+	FeedURL     string         `json:"feed_url,omitempty"`
+	Authors     []jsonAuthor   `json:"authors,omitempty"`
+	Items       []jsonFeedItem `json:"items"`
+}
+
+type jsonAuthor struct {
+	Name string `json:"name"`
+}
+
+type jsonFeedItem struct {
+	ID            string           `json:"id"`
+	URL           string           `json:"url"`
+	Title         string           `json:"title"`
+	ContentText   string           `json:"content_text"`
+	Image         string           `json:"image,omitempty"`
+	DatePublished string           `json:"date_published"`
+	DateModified  string           `json:"date_modified"`
+	Authors       []jsonAuthor     `json:"authors,omitempty"`
+	Tags          []string         `json:"tags,omitempty"`
+	Attachments   []jsonAttachment `json:"attachments,omitempty"`
+}
+
+type jsonAttachment struct {
+	URL         string `json:"url"`
+	MimeType    string `json:"mime_type"`
+	SizeInBytes int64  `json:"size_in_bytes,omitempty"`
+}
+
+// WriteJSONFeed writes the entries as a JSON Feed 1.1 document.
+func WriteJSONFeed(w io.Writer, meta *Meta, entries []*Entry) error {
+	feed := jsonFeed{
+		Version:     "https://jsonfeed.org/version/1.1",
+		Title:       meta.Title,
+		HomePageURL: meta.Link,
+		FeedURL:     meta.FeedURL,
+		Items:       []jsonFeedItem{},
+	}
+	if meta.Author != "" {
+		feed.Authors = []jsonAuthor{{Name: meta.Author}}
+	}
+	for _, e := range entries {
+		item := jsonFeedItem{
+			ID:            e.ID,
+			URL:           e.URL,
+			Title:         e.Title,
+			ContentText:   e.Content,
+			Image:         e.Image,
+			DatePublished: e.Published.UTC().Format(time.RFC3339),
+			DateModified:  e.Updated.UTC().Format(time.RFC3339),
+		}
+		if e.Author != "" {
+			item.Authors = []jsonAuthor{{Name: e.Author}}
+		}
+		item.Tags = e.Tags
+		for _, enc := range e.Enclosures {
+			item.Attachments = append(item.Attachments, jsonAttachment{URL: enc.URL, MimeType: enc.Type, SizeInBytes: enc.Length})
+		}
+		feed.Items = append(feed.Items, item)
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(feed); err != nil {
+		return errors.Wrap(err, "failed to write JSON feed")
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+package feedgen
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mmcdole/gofeed"
+	"github.com/mopemope/quicknews/ent"
+	"github.com/mopemope/quicknews/ent/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testEntries() (*Meta, []*Entry) {
+	sum := &ent.Summary{
+		ID:        uuid.New(),
+		URL:       "https://example.com/article",
+		Title:     "Article & title",
+		Summary:   "A <short> summary.",
+		Tags:      []string{"go", "release"},
+		CreatedAt: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+	}
+	sum.Edges.Feed = &ent.Feed{Title: "Example Feed"}
+	sum.Edges.Article = &ent.Article{
+		PublishedAt: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		Author:      "Jane Doe",
+		Enclosures:  []schema.Enclosure{{URL: "https://example.com/episode.mp3", Type: "audio/mpeg", Length: 1024}},
+	}
+
+	meta := &Meta{
+		Title:   "quicknews",
+		Link:    "https://example.com",
+		FeedURL: "https://example.com/feed",
+		Author:  "quicknews",
+		Updated: sum.CreatedAt,
+	}
+	return meta, []*Entry{EntryFromSummary(sum)}
+}
+
+func TestWriteAtom(t *testing.T) {
+	meta, entries := testEntries()
+	var buf bytes.Buffer
+	require.NoError(t, WriteAtom(&buf, meta, entries))
+
+	feed, err := gofeed.NewParser().ParseString(buf.String())
+	require.NoError(t, err)
+	assert.Equal(t, "atom", feed.FeedType)
+	assert.Equal(t, "1.0", feed.FeedVersion)
+	require.Len(t, feed.Items, 1)
+	item := feed.Items[0]
+	assert.Equal(t, "Article & title", item.Title)
+	assert.Equal(t, "https://example.com/article", item.Link)
+	assert.Equal(t, "A <short> summary.", item.Content)
+	assert.Equal(t, []string{"Example Feed", "go", "release"}, item.Categories)
+	assert.Equal(t, entries[0].ID, item.GUID)
+	assert.Equal(t, "2024-01-01T00:00:00Z", item.Published)
+	assert.Equal(t, "Jane Doe", item.Authors[0].Name)
+	assert.Equal(t, []*gofeed.Enclosure{{URL: "https://example.com/episode.mp3", Type: "audio/mpeg", Length: "1024"}}, item.Enclosures)
+}
+
+func TestWriteJSONFeed(t *testing.T) {
+	meta, entries := testEntries()
+	var buf bytes.Buffer
+	require.NoError(t, WriteJSONFeed(&buf, meta, entries))
+
+	feed, err := gofeed.NewParser().ParseString(buf.String())
+	require.NoError(t, err)
+	assert.Equal(t, "json", feed.FeedType)
+	assert.Equal(t, "https://jsonfeed.org/version/1.1", feed.FeedVersion)
+	require.Len(t, feed.Items, 1)
+	item := feed.Items[0]
+	assert.Equal(t, "Article & title", item.Title)
+	assert.Equal(t, "https://example.com/article", item.Link)
+	assert.Equal(t, "A <short> summary.", item.Content)
+	assert.Equal(t, []string{"go", "release"}, item.Categories)
+	assert.Equal(t, "Jane Doe", item.Authors[0].Name)
+	require.Len(t, item.Enclosures, 1)
+	assert.Equal(t, "https://example.com/episode.mp3", item.Enclosures[0].URL)
+}
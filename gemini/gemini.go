@@ -9,7 +9,9 @@ import (
 
 	"github.com/cockroachdb/errors"
 	"github.com/mopemope/quicknews/config"
+	"github.com/mopemope/quicknews/httpclient"
 	_ "github.com/mopemope/quicknews/log"
+	"github.com/mopemope/quicknews/scraper"
 	"google.golang.org/genai"
 )
 
@@ -39,6 +41,26 @@ URL: %s
 
 `
 
+// contentPrompt is appended to the summary prompt with the title and text of a page the
+// model cannot access itself.
+const contentPrompt = `
+このページは認証が必要なため、URLにはアクセスせず、以下のページ本文のみを元に解説してください。
+
+タイトル: %s
+本文:
+%s
+`
+
+// transcriptPrompt is appended to the summary prompt with the title and transcript of a
+// podcast or video episode.
+const transcriptPrompt = `
+このページはポッドキャストまたは動画のエピソードです。URLにはアクセスせず、以下の文字起こしのみを元にエピソードの内容を解説してください。
+
+タイトル: %s
+文字起こし:
+%s
+`
+
 type PageSummary struct {
 	URL     string `json:"url"`
 	Title   string `json:"title"`
@@ -90,14 +112,60 @@ func (c *Client) Close() error {
 
 // Summarize sends a request to the Gemini API to summarize the given text.
 func (c *Client) Summarize(ctx context.Context, url string) (*PageSummary, error) {
+	return c.SummarizeWithPrompt(ctx, url, c.summaryPrompt())
+}
 
-	summaryPrompt := defaultSummaryPrompt
+func (c *Client) summaryPrompt() string {
 	if c.config.Prompt != nil && c.config.Prompt.Summary != nil {
 		// custom prompt
-		summaryPrompt = *c.config.Prompt.Summary
+		return *c.config.Prompt.Summary
+	}
+	return defaultSummaryPrompt
+}
+
+// SummarizeWithPrompt summarizes the page with the given prompt, in which %s is replaced
+// with the URL.
+func (c *Client) SummarizeWithPrompt(ctx context.Context, url, summaryPrompt string) (*PageSummary, error) {
+	return c.generate(ctx, url, fmt.Sprintf(summaryPrompt, url))
+}
+
+// SummarizeContent summarizes a page from its title and text, for pages the model cannot
+// access itself such as those behind authentication. An empty prompt is the configured
+// summary prompt.
+func (c *Client) SummarizeContent(ctx context.Context, url, summaryPrompt, title, text string) (*PageSummary, error) {
+	if summaryPrompt == "" {
+		summaryPrompt = c.summaryPrompt()
+	}
+	return c.generate(ctx, url, fmt.Sprintf(summaryPrompt, url)+fmt.Sprintf(contentPrompt, title, text))
+}
+
+// SummarizeTranscript summarizes a podcast or video episode from its transcript. An empty
+// prompt is the configured summary prompt.
+func (c *Client) SummarizeTranscript(ctx context.Context, url, summaryPrompt, title, transcript string) (*PageSummary, error) {
+	if summaryPrompt == "" {
+		summaryPrompt = c.summaryPrompt()
 	}
-	prompt := fmt.Sprintf(summaryPrompt, url)
+	return c.generate(ctx, url, fmt.Sprintf(summaryPrompt, url)+fmt.Sprintf(transcriptPrompt, title, transcript))
+}
+
+// SummarizePage summarizes the page at the URL with the prompt, the configured one when
+// empty. The model cannot reach pages that require credentials; they are fetched with the
+// settings and summarized from their text.
+func (c *Client) SummarizePage(ctx context.Context, url, summaryPrompt string, settings *httpclient.Settings) (*PageSummary, error) {
+	if settings == nil || !settings.Authenticated() {
+		if summaryPrompt == "" {
+			return c.Summarize(ctx, url)
+		}
+		return c.SummarizeWithPrompt(ctx, url, summaryPrompt)
+	}
+	content, err := scraper.ExtractContent(ctx, url, scraper.WithHTTP(settings))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to extract content")
+	}
+	return c.SummarizeContent(ctx, url, summaryPrompt, content.Title, content.Text)
+}
 
+func (c *Client) generate(ctx context.Context, url, prompt string) (*PageSummary, error) {
 	modelName := c.modelName()
 	res, err := c.client.Models.GenerateContent(ctx,
 		modelName,
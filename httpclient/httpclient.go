@@ -0,0 +1,213 @@
+// Package httpclient applies the [http] and [[feeds]] settings, such as credentials,
+// headers and proxies, to the requests for feeds, pages and articles.
+package httpclient
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/mopemope/quicknews/config"
+)
+
+// Settings are the resolved settings of the requests for a URL.
+type Settings struct {
+	host      string // Credentials are only sent to this host and port
+	username  string
+	password  string
+	token     string
+	cookie    string
+	headers   map[string]string
+	userAgent string
+	proxy     *url.URL
+	limiter   *hostLimiter // nil without [crawl]
+	robotsTxt bool
+}
+
+// New returns the settings of the requests for the target URL: the [http] defaults
+// overridden by the [[feeds]] entry with the longest URL prefix of the target.
+func New(cfg *config.Config, target string) (*Settings, error) {
+	s := &Settings{
+		limiter:   limiterFor(cfg.Crawl),
+		robotsTxt: cfg.Crawl != nil && !cfg.Crawl.IgnoreRobotsTxt,
+	}
+	proxy := ""
+	if cfg.HTTP != nil {
+		s.userAgent = cfg.HTTP.UserAgent
+		proxy = cfg.HTTP.Proxy
+	}
+
+	var entry *config.FeedHTTP
+	for _, f := range cfg.Feeds {
+		if f.URL != "" && strings.HasPrefix(target, f.URL) && (entry == nil || len(f.URL) > len(entry.URL)) {
+			entry = f
+		}
+	}
+	if entry != nil {
+		u, err := url.Parse(entry.URL)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid feed URL %s", entry.URL)
+		}
+		s.host = u.Host
+		s.username = entry.Username
+		s.headers = entry.Headers
+		if entry.UserAgent != "" {
+			s.userAgent = entry.UserAgent
+		}
+		if entry.Proxy != "" {
+			proxy = entry.Proxy
+		}
+		for _, secret := range []struct {
+			ref   string
+			value *string
+		}{
+			{entry.Password, &s.password},
+			{entry.Token, &s.token},
+			{entry.Cookie, &s.cookie},
+		} {
+			v, err := ResolveSecret(secret.ref)
+			if err != nil {
+				return nil, errors.Wrapf(err, "feed %s", entry.URL)
+			}
+			*secret.value = v
+		}
+	}
+
+	if proxy != "" {
+		u, err := url.Parse(proxy)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid proxy %s", proxy)
+		}
+		s.proxy = u
+	}
+	return s, nil
+}
+
+// Authenticated reports whether the requests carry credentials, in which case the pages
+// are not reachable by the LLM and their content has to be extracted locally.
+func (s *Settings) Authenticated() bool {
+	return s.username != "" || s.token != "" || s.cookie != ""
+}
+
+// RespectRobotsTxt reports whether scraped pages must be allowed by robots.txt.
+func (s *Settings) RespectRobotsTxt() bool {
+	return s.robotsTxt
+}
+
+// UserAgent returns the configured user agent, or an empty string.
+func (s *Settings) UserAgent() string {
+	return s.userAgent
+}
+
+// Transport returns a transport sending the requests through the proxy with the headers
+// and credentials of the settings, no faster than the rate limit of their host.
+func (s *Settings) Transport() http.RoundTripper {
+	return &transport{base: baseTransport(s.proxy), settings: s}
+}
+
+var (
+	baseTransportsMu sync.Mutex
+	baseTransports   = make(map[string]*http.Transport) // By proxy URL, so that connections are reused
+)
+
+// baseTransport returns the transport shared by the requests through the proxy, or sent
+// directly when proxy is nil.
+func baseTransport(proxy *url.URL) *http.Transport {
+	key := ""
+	if proxy != nil {
+		key = proxy.String()
+	}
+	baseTransportsMu.Lock()
+	defer baseTransportsMu.Unlock()
+	t, ok := baseTransports[key]
+	if !ok {
+		t = http.DefaultTransport.(*http.Transport).Clone()
+		if proxy != nil {
+			t.Proxy = http.ProxyURL(proxy)
+		}
+		baseTransports[key] = t
+	}
+	return t
+}
+
+// Client returns an HTTP client using the transport of the settings.
+func (s *Settings) Client(timeout time.Duration) *http.Client {
+	return &http.Client{Transport: s.Transport(), Timeout: timeout}
+}
+
+type transport struct {
+	base     http.RoundTripper
+	settings *Settings
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	s := t.settings
+	if s.limiter != nil {
+		if err := s.limiter.Wait(req.Context(), req.URL.Hostname()); err != nil {
+			return nil, err
+		}
+	}
+	req = req.Clone(req.Context())
+	for k, v := range s.headers {
+		req.Header.Set(k, v)
+	}
+	if s.userAgent != "" {
+		req.Header.Set("User-Agent", s.userAgent)
+	}
+	// Credentials must not leak to the other hosts a request is redirected to.
+	if s.host != "" && strings.EqualFold(req.URL.Host, s.host) {
+		switch {
+		case s.token != "":
+			req.Header.Set("Authorization", "Bearer "+s.token)
+		case s.username != "":
+			req.SetBasicAuth(s.username, s.password)
+		}
+		if s.cookie != "" {
+			req.Header.Set("Cookie", s.cookie)
+		}
+	}
+	return t.base.RoundTrip(req)
+}
+
+var (
+	secretsMu sync.Mutex
+	secrets   = make(map[string]string) // Output of the secret commands already run
+)
+
+// ResolveSecret returns the value of a secret reference: env:NAME reads an environment
+// variable and cmd:COMMAND runs a shell command, such as a password manager, and uses its
+// output. Any other value is used as is.
+func ResolveSecret(ref string) (string, error) {
+	switch {
+	case strings.HasPrefix(ref, "env:"):
+		name := strings.TrimPrefix(ref, "env:")
+		v, ok := os.LookupEnv(name)
+		if !ok {
+			return "", errors.Newf("environment variable %s is not set", name)
+		}
+		return v, nil
+	case strings.HasPrefix(ref, "cmd:"):
+		secretsMu.Lock()
+		defer secretsMu.Unlock()
+		if v, ok := secrets[ref]; ok {
+			return v, nil
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		defer cancel()
+		out, err := exec.CommandContext(ctx, "sh", "-c", strings.TrimPrefix(ref, "cmd:")).Output()
+		if err != nil {
+			return "", errors.Wrap(err, "failed to run secret command")
+		}
+		v := strings.TrimRight(string(out), "\r\n")
+		secrets[ref] = v
+		return v, nil
+	default:
+		return ref, nil
+	}
+}
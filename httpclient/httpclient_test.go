@@ -0,0 +1,100 @@
+package httpclient
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/mopemope/quicknews/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveSecret(t *testing.T) {
+	t.Setenv("QUICKNEWS_TEST_TOKEN", "from-env")
+
+	v, err := ResolveSecret("env:QUICKNEWS_TEST_TOKEN")
+	require.NoError(t, err)
+	assert.Equal(t, "from-env", v)
+
+	_, err = ResolveSecret("env:QUICKNEWS_TEST_MISSING")
+	assert.Error(t, err)
+
+	v, err = ResolveSecret("cmd:echo from-command")
+	require.NoError(t, err)
+	assert.Equal(t, "from-command", v)
+
+	_, err = ResolveSecret("cmd:exit 1")
+	assert.Error(t, err)
+
+	v, err = ResolveSecret("plain")
+	require.NoError(t, err)
+	assert.Equal(t, "plain", v)
+}
+
+func TestSettings_Transport(t *testing.T) {
+	type seen struct {
+		auth, cookie, agent, custom string
+	}
+	requests := make(chan seen, 2)
+	record := func(w http.ResponseWriter, r *http.Request) {
+		requests <- seen{r.Header.Get("Authorization"), r.Header.Get("Cookie"), r.UserAgent(), r.Header.Get("X-Custom")}
+	}
+	other := httptest.NewServer(http.HandlerFunc(record))
+	defer other.Close()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		record(w, r)
+		if r.URL.Path == "/redirect" {
+			http.Redirect(w, r, other.URL+"/", http.StatusFound)
+		}
+	}))
+	defer server.Close()
+
+	t.Setenv("QUICKNEWS_TEST_TOKEN", "s3cret")
+	cfg := &config.Config{
+		HTTP: &config.HTTP{UserAgent: "quicknews-test"},
+		Feeds: []*config.FeedHTTP{
+			{URL: server.URL, Username: "user", Password: "pass"},
+			{URL: server.URL + "/private/", Token: "env:QUICKNEWS_TEST_TOKEN", Cookie: "session=1", Headers: map[string]string{"X-Custom": "yes"}},
+		},
+	}
+
+	s, err := New(cfg, server.URL+"/private/feed.xml")
+	require.NoError(t, err)
+	assert.True(t, s.Authenticated())
+	_, err = s.Client(time.Second).Get(server.URL + "/private/feed.xml")
+	require.NoError(t, err)
+	assert.Equal(t, seen{"Bearer s3cret", "session=1", "quicknews-test", "yes"}, <-requests)
+
+	s, err = New(cfg, server.URL+"/redirect")
+	require.NoError(t, err)
+	_, err = s.Client(time.Second).Get(server.URL + "/redirect")
+	require.NoError(t, err)
+	first, redirected := <-requests, <-requests
+	assert.Contains(t, first.auth, "Basic ")
+	assert.Equal(t, seen{agent: "quicknews-test"}, redirected, "credentials are not sent to other hosts")
+
+	s, err = New(cfg, "https://example.com/feed")
+	require.NoError(t, err)
+	assert.False(t, s.Authenticated())
+}
+
+func TestNew_InvalidSecret(t *testing.T) {
+	cfg := &config.Config{Feeds: []*config.FeedHTTP{{URL: "https://example.com/", Token: "env:QUICKNEWS_TEST_MISSING"}}}
+	_, err := New(cfg, "https://example.com/feed")
+	assert.Error(t, err)
+}
+
+func TestSettings_TransportShared(t *testing.T) {
+	cfg := &config.Config{Feeds: []*config.FeedHTTP{{URL: "https://proxied.example.com/", Proxy: "http://proxy.example.com:3128"}}}
+	base := func(target string) http.RoundTripper {
+		s, err := New(cfg, target)
+		require.NoError(t, err)
+		return s.Transport().(*transport).base
+	}
+
+	assert.Same(t, base("https://example.com/feed"), base("https://example.org/page"), "connections are reused")
+	assert.Same(t, base("https://proxied.example.com/feed"), base("https://proxied.example.com/page"))
+	assert.NotSame(t, base("https://example.com/feed"), base("https://proxied.example.com/feed"))
+}
@@ -0,0 +1,59 @@
+package httpclient
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/mopemope/quicknews/config"
+	"golang.org/x/time/rate"
+)
+
+// hostLimiter holds a token bucket per host.
+type hostLimiter struct {
+	config   *config.Crawl
+	mu       sync.Mutex
+	limiters map[string]*rate.Limiter
+}
+
+var (
+	hostLimitersMu sync.Mutex
+	hostLimiters   = make(map[*config.Crawl]*hostLimiter) // Shared by every client of a configuration
+)
+
+// limiterFor returns the limiter of the crawl settings, nil when they are not set.
+func limiterFor(c *config.Crawl) *hostLimiter {
+	if c == nil {
+		return nil
+	}
+	hostLimitersMu.Lock()
+	defer hostLimitersMu.Unlock()
+	l, ok := hostLimiters[c]
+	if !ok {
+		l = &hostLimiter{config: c, limiters: make(map[string]*rate.Limiter)}
+		hostLimiters[c] = l
+	}
+	return l
+}
+
+// Wait blocks until a request may be sent to the host.
+func (l *hostLimiter) Wait(ctx context.Context, host string) error {
+	host = strings.ToLower(host)
+	l.mu.Lock()
+	limiter, ok := l.limiters[host]
+	if !ok {
+		rps := l.config.RequestsPerSecond
+		for h, r := range l.config.Hosts {
+			if strings.EqualFold(h, host) {
+				rps = r
+			}
+		}
+		limiter = rate.NewLimiter(rate.Limit(rps), max(l.config.Burst, 1))
+		if rps <= 0 {
+			limiter = rate.NewLimiter(rate.Inf, 0)
+		}
+		l.limiters[host] = limiter
+	}
+	l.mu.Unlock()
+	return limiter.Wait(ctx)
+}
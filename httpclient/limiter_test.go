@@ -0,0 +1,56 @@
+package httpclient
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/mopemope/quicknews/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHostLimiter(t *testing.T) {
+	cfg := &config.Crawl{RequestsPerSecond: 20, Burst: 1, Hosts: map[string]float64{"Slow.example.com": 5, "free.example.com": 0}}
+	l := limiterFor(cfg)
+	assert.Same(t, l, limiterFor(cfg), "clients of a configuration share the limiter")
+	assert.Nil(t, limiterFor(nil))
+
+	ctx := context.Background()
+	elapsed := func(host string, n int) time.Duration {
+		start := time.Now()
+		for range n {
+			require.NoError(t, l.Wait(ctx, host))
+		}
+		return time.Since(start)
+	}
+	// The first request uses the burst, the next ones wait for a token.
+	assert.GreaterOrEqual(t, elapsed("fast.example.com", 3), 90*time.Millisecond)
+	assert.GreaterOrEqual(t, elapsed("slow.example.com", 2), 190*time.Millisecond)
+	assert.Less(t, elapsed("free.example.com", 10), 50*time.Millisecond)
+
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	assert.Error(t, l.Wait(canceled, "slow.example.com"))
+}
+
+func TestSettings_TransportRateLimit(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	cfg := &config.Config{Crawl: &config.Crawl{RequestsPerSecond: 10, Burst: 1}}
+	s, err := New(cfg, server.URL)
+	require.NoError(t, err)
+	assert.True(t, s.RespectRobotsTxt())
+
+	client := s.Client(time.Second)
+	start := time.Now()
+	for range 3 {
+		resp, err := client.Get(server.URL)
+		require.NoError(t, err)
+		_ = resp.Body.Close()
+	}
+	assert.GreaterOrEqual(t, time.Since(start), 190*time.Millisecond)
+}
@@ -0,0 +1,108 @@
+package mailer
+
+import (
+	"bytes"
+	htmltemplate "html/template"
+	"strings"
+	texttemplate "text/template"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/mopemope/quicknews/ent"
+)
+
+// Digest is the content of a mail digest, summaries grouped by feed.
+type Digest struct {
+	Title string
+	Date  time.Time
+	Feeds []*DigestFeed
+}
+
+type DigestFeed struct {
+	Title string
+	Items []*DigestItem
+}
+
+type DigestItem struct {
+	Title    string
+	URL      string
+	Summary  string
+	AudioURL string // Link to the audio of the summary, if any
+}
+
+// NewDigest groups summaries with their feed edge loaded by feed, keeping their order.
+// The audio of each summary is linked below audioBaseURL when it is set.
+func NewDigest(title string, date time.Time, sums []*ent.Summary, audioBaseURL string) *Digest {
+	d := &Digest{Title: title, Date: date}
+	feeds := map[string]*DigestFeed{}
+	for _, sum := range sums {
+		name := "Other"
+		if sum.Edges.Feed != nil {
+			name = sum.Edges.Feed.Title
+		}
+		f, ok := feeds[name]
+		if !ok {
+			f = &DigestFeed{Title: name}
+			feeds[name] = f
+			d.Feeds = append(d.Feeds, f)
+		}
+		item := &DigestItem{
+			Title:   sum.Title,
+			URL:     sum.URL,
+			Summary: strings.TrimSpace(sum.Summary),
+		}
+		if audioBaseURL != "" && sum.AudioFile != "" {
+			item.AudioURL = strings.TrimSuffix(audioBaseURL, "/") + "/" + sum.AudioFile
+		}
+		f.Items = append(f.Items, item)
+	}
+	return d
+}
+
+// Render returns the plain text and the HTML body of the digest.
+func (d *Digest) Render() (string, string, error) {
+	var text, html bytes.Buffer
+	if err := textTemplate.Execute(&text, d); err != nil {
+		return "", "", errors.Wrap(err, "failed to render text digest")
+	}
+	if err := htmlTemplate.Execute(&html, d); err != nil {
+		return "", "", errors.Wrap(err, "failed to render HTML digest")
+	}
+	return text.String(), html.String(), nil
+}
+
+func paragraphs(s string) []string {
+	var ps []string
+	for _, p := range strings.Split(s, "\n") {
+		if p = strings.TrimSpace(p); p != "" {
+			ps = append(ps, p)
+		}
+	}
+	return ps
+}
+
+var textTemplate = texttemplate.Must(texttemplate.New("text").Parse(`{{.Title}}
+{{range .Feeds}}
+## {{.Title}}
+{{range .Items}}
+* {{.Title}}
+  {{.URL}}
+{{if .AudioURL}}  Audio: {{.AudioURL}}
+{{end}}
+{{.Summary}}
+{{end}}{{end}}`))
+
+var htmlTemplate = htmltemplate.Must(htmltemplate.New("html").Funcs(htmltemplate.FuncMap{
+	"paragraphs": paragraphs,
+}).Parse(`<!DOCTYPE html>
+<html>
+<head><meta charset="utf-8"><title>{{.Title}}</title></head>
+<body style="font-family: sans-serif; line-height: 1.6; max-width: 40em;">
+<h1 style="font-size: 1.3em;">{{.Title}}</h1>
+{{range .Feeds}}<h2 style="font-size: 1.15em; border-bottom: 1px solid #ccc;">{{.Title}}</h2>
+{{range .Items}}<h3 style="font-size: 1em;"><a href="{{.URL}}">{{.Title}}</a></h3>
+{{if .AudioURL}}<p><a href="{{.AudioURL}}">&#9654; Audio</a></p>
+{{end}}{{range paragraphs .Summary}}<p>{{.}}</p>
+{{end}}{{end}}{{end}}</body>
+</html>
+`))
@@ -0,0 +1,129 @@
+package mailer
+
+import (
+	"encoding/base64"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/mail"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mopemope/quicknews/config"
+	"github.com/mopemope/quicknews/ent"
+	"github.com/mopemope/quicknews/mailer/mailtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// readParts returns the decoded parts of a multipart body by content type.
+func readParts(t *testing.T, contentType string, body io.Reader) map[string]string {
+	t.Helper()
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(mediaType, "multipart/"))
+
+	parts := map[string]string{}
+	r := multipart.NewReader(body, params["boundary"])
+	for {
+		p, err := r.NextPart()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		ct := p.Header.Get("Content-Type")
+		if strings.HasPrefix(ct, "multipart/") {
+			for k, v := range readParts(t, ct, p) {
+				parts[k] = v
+			}
+			continue
+		}
+		var data []byte
+		if p.Header.Get("Content-Transfer-Encoding") == "base64" {
+			data, err = io.ReadAll(base64.NewDecoder(base64.StdEncoding, p))
+		} else {
+			// quoted-printable is decoded by the reader
+			data, err = io.ReadAll(p)
+		}
+		require.NoError(t, err)
+		mediaType, _, err := mime.ParseMediaType(ct)
+		require.NoError(t, err)
+		parts[mediaType] = string(data)
+	}
+	return parts
+}
+
+func TestSender_Send(t *testing.T) {
+	server := mailtest.NewServer(t)
+	sender := NewSender(&config.Mail{
+		Host:     server.Host,
+		Port:     server.Port,
+		Username: "user",
+		Password: "secret",
+		From:     "quicknews <quicknews@example.com>",
+	})
+
+	msg := &Message{
+		From:    "quicknews <quicknews@example.com>",
+		To:      []string{"a@example.com", "b@example.com"},
+		Subject: "今日のニュース",
+		Date:    time.Date(2025, 6, 1, 7, 0, 0, 0, time.UTC),
+		Text:    "plain body",
+		HTML:    "<p>html body</p>",
+		Attachments: []*Attachment{
+			{Name: "episode.mp3", ContentType: "audio/mpeg", Data: []byte("ID3 audio data")},
+		},
+	}
+	require.NoError(t, sender.Send(msg))
+
+	received := server.Messages()
+	require.Len(t, received, 1)
+	assert.Equal(t, "quicknews@example.com", received[0].From)
+	assert.Equal(t, []string{"a@example.com", "b@example.com"}, received[0].To)
+	assert.Equal(t, "user", received[0].Auth)
+
+	m, err := mail.ReadMessage(strings.NewReader(received[0].Data))
+	require.NoError(t, err)
+	subject, err := new(mime.WordDecoder).DecodeHeader(m.Header.Get("Subject"))
+	require.NoError(t, err)
+	assert.Equal(t, "今日のニュース", subject)
+
+	parts := readParts(t, m.Header.Get("Content-Type"), m.Body)
+	assert.Equal(t, "plain body", parts["text/plain"])
+	assert.Equal(t, "<p>html body</p>", parts["text/html"])
+	assert.Equal(t, "ID3 audio data", parts["audio/mpeg"])
+}
+
+func TestSender_Send_StartTLSUnsupported(t *testing.T) {
+	server := mailtest.NewServer(t)
+	sender := NewSender(&config.Mail{Host: server.Host, Port: server.Port, StartTLS: true, From: "q@example.com"})
+
+	err := sender.Send(&Message{To: []string{"a@example.com"}})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "STARTTLS")
+	assert.Empty(t, server.Messages())
+}
+
+func TestDigest_Render(t *testing.T) {
+	feed := &ent.Feed{Title: "Hacker News"}
+	sums := []*ent.Summary{
+		{Title: "First", URL: "https://example.com/1", Summary: "Line one.\nLine <two>.", AudioFile: "1.mp3"},
+		{Title: "Second", URL: "https://example.com/2", Summary: "Other."},
+	}
+	for _, s := range sums {
+		s.Edges.Feed = feed
+	}
+
+	d := NewDigest("quicknews 2025-06-01", time.Now(), sums, "https://cdn.example.com/audio/")
+	require.Len(t, d.Feeds, 1)
+	assert.Equal(t, "https://cdn.example.com/audio/1.mp3", d.Feeds[0].Items[0].AudioURL)
+	assert.Empty(t, d.Feeds[0].Items[1].AudioURL)
+
+	text, html, err := d.Render()
+	require.NoError(t, err)
+	assert.Contains(t, text, "## Hacker News")
+	assert.Contains(t, text, "Audio: https://cdn.example.com/audio/1.mp3")
+	assert.Contains(t, html, `<a href="https://example.com/1">First</a>`)
+	assert.Contains(t, html, "<p>Line &lt;two&gt;.</p>")
+}
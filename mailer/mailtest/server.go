@@ -0,0 +1,159 @@
+// Package mailtest provides an SMTP server for tests that records the delivered messages.
+package mailtest
+
+import (
+	"bufio"
+	"encoding/base64"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// Message is a message received by the server.
+type Message struct {
+	From string
+	To   []string
+	Auth string // Username of the PLAIN authentication, if any
+	Data string
+}
+
+// Server is an SMTP server listening on localhost. It accepts every message and
+// advertises PLAIN authentication but not STARTTLS.
+type Server struct {
+	Host string
+	Port int
+
+	listener net.Listener
+	mu       sync.Mutex
+	messages []Message
+	wg       sync.WaitGroup
+}
+
+// NewServer starts a server that is closed when the test ends.
+func NewServer(t *testing.T) *Server {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	addr := l.Addr().(*net.TCPAddr)
+	s := &Server{Host: "127.0.0.1", Port: addr.Port, listener: l}
+	s.wg.Add(1)
+	go s.serve()
+	t.Cleanup(func() {
+		_ = l.Close()
+		s.wg.Wait()
+	})
+	return s
+}
+
+// Messages returns the messages received so far.
+func (s *Server) Messages() []Message {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Message(nil), s.messages...)
+}
+
+func (s *Server) serve() {
+	defer s.wg.Done()
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			s.handle(conn)
+		}()
+	}
+}
+
+func (s *Server) handle(conn net.Conn) {
+	defer func() {
+		_ = conn.Close()
+	}()
+	r := bufio.NewReader(conn)
+	reply := func(lines ...string) {
+		_, _ = conn.Write([]byte(strings.Join(lines, "\r\n") + "\r\n"))
+	}
+
+	reply("220 localhost ESMTP mailtest")
+	var msg Message
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		line = strings.TrimRight(line, "\r\n")
+		verb, arg, _ := strings.Cut(line, " ")
+		switch strings.ToUpper(verb) {
+		case "EHLO", "HELO":
+			reply("250-localhost", "250-AUTH PLAIN", "250 8BITMIME")
+		case "AUTH":
+			mech, initial, _ := strings.Cut(arg, " ")
+			if mech != "PLAIN" {
+				reply("504 unsupported mechanism")
+				continue
+			}
+			// authzid NUL authcid NUL password
+			decoded, err := base64.StdEncoding.DecodeString(initial)
+			parts := strings.Split(string(decoded), "\x00")
+			if err != nil || len(parts) != 3 {
+				reply("501 malformed credentials")
+				continue
+			}
+			msg.Auth = parts[1]
+			reply("235 authenticated")
+		case "MAIL":
+			msg.From = trimAddress(arg, "FROM:")
+			reply("250 ok")
+		case "RCPT":
+			msg.To = append(msg.To, trimAddress(arg, "TO:"))
+			reply("250 ok")
+		case "DATA":
+			reply("354 end data with <CR><LF>.<CR><LF>")
+			var data strings.Builder
+			for {
+				l, err := r.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if l == ".\r\n" {
+					break
+				}
+				// Undo dot-stuffing.
+				data.WriteString(strings.TrimPrefix(l, "."))
+			}
+			msg.Data = data.String()
+			s.mu.Lock()
+			s.messages = append(s.messages, msg)
+			s.mu.Unlock()
+			msg = Message{Auth: msg.Auth}
+			reply("250 queued as " + strconv.Itoa(len(s.Messages())))
+		case "RSET":
+			msg = Message{Auth: msg.Auth}
+			reply("250 ok")
+		case "NOOP":
+			reply("250 ok")
+		case "QUIT":
+			reply("221 bye")
+			return
+		default:
+			reply("502 command not implemented")
+		}
+	}
+}
+
+func trimAddress(arg, prefix string) string {
+	arg = strings.TrimSpace(arg)
+	if len(arg) >= len(prefix) && strings.EqualFold(arg[:len(prefix)], prefix) {
+		arg = arg[len(prefix):]
+	}
+	if i := strings.IndexByte(arg, ' '); i >= 0 {
+		arg = arg[:i] // drop parameters such as BODY=8BITMIME
+	}
+	return strings.Trim(arg, "<>")
+}
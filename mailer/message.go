@@ -0,0 +1,134 @@
+// Package mailer builds and sends the mail digest of summaries.
+package mailer
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/textproto"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+// Message is a mail with a plain text and an HTML body and optional attachments.
+type Message struct {
+	From        string
+	To          []string
+	Subject     string
+	Date        time.Time
+	Text        string
+	HTML        string
+	Attachments []*Attachment
+}
+
+// Attachment is a file attached to a message.
+type Attachment struct {
+	Name        string
+	ContentType string
+	Data        []byte
+}
+
+// Bytes returns the message in RFC 5322 format. The bodies are a multipart/alternative
+// part, wrapped in a multipart/mixed part when the message has attachments.
+func (m *Message) Bytes() ([]byte, error) {
+	var buf bytes.Buffer
+	header := func(key, value string) {
+		fmt.Fprintf(&buf, "%s: %s\r\n", key, value)
+	}
+	header("From", m.From)
+	header("To", strings.Join(m.To, ", "))
+	header("Subject", mime.QEncoding.Encode("utf-8", m.Subject))
+	header("Date", m.Date.Format(time.RFC1123Z))
+	header("MIME-Version", "1.0")
+
+	contentType, body, err := m.alternative()
+	if err != nil {
+		return nil, err
+	}
+	if len(m.Attachments) == 0 {
+		header("Content-Type", contentType)
+		buf.WriteString("\r\n")
+		buf.Write(body)
+		return buf.Bytes(), nil
+	}
+
+	mixed := multipart.NewWriter(&buf)
+	header("Content-Type", "multipart/mixed; boundary="+mixed.Boundary())
+	buf.WriteString("\r\n")
+
+	w, err := mixed.CreatePart(textproto.MIMEHeader{"Content-Type": {contentType}})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create message part")
+	}
+	if _, err := w.Write(body); err != nil {
+		return nil, errors.Wrap(err, "failed to write message part")
+	}
+
+	for _, a := range m.Attachments {
+		h := textproto.MIMEHeader{}
+		h.Set("Content-Type", mime.FormatMediaType(a.ContentType, map[string]string{"name": a.Name}))
+		h.Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": a.Name}))
+		h.Set("Content-Transfer-Encoding", "base64")
+		w, err := mixed.CreatePart(h)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create attachment part")
+		}
+		if err := writeBase64(w, a.Data); err != nil {
+			return nil, errors.Wrapf(err, "failed to write attachment %s", a.Name)
+		}
+	}
+	if err := mixed.Close(); err != nil {
+		return nil, errors.Wrap(err, "failed to close message")
+	}
+	return buf.Bytes(), nil
+}
+
+// alternative returns the content type and the body of the multipart/alternative part
+// holding the text and HTML bodies.
+func (m *Message) alternative() (string, []byte, error) {
+	var body bytes.Buffer
+	alt := multipart.NewWriter(&body)
+
+	for _, part := range []struct{ contentType, body string }{
+		{"text/plain; charset=utf-8", m.Text},
+		{"text/html; charset=utf-8", m.HTML},
+	} {
+		h := textproto.MIMEHeader{}
+		h.Set("Content-Type", part.contentType)
+		h.Set("Content-Transfer-Encoding", "quoted-printable")
+		pw, err := alt.CreatePart(h)
+		if err != nil {
+			return "", nil, errors.Wrap(err, "failed to create message part")
+		}
+		qp := quotedprintable.NewWriter(pw)
+		if _, err := io.WriteString(qp, part.body); err != nil {
+			return "", nil, errors.Wrap(err, "failed to write message part")
+		}
+		if err := qp.Close(); err != nil {
+			return "", nil, errors.Wrap(err, "failed to write message part")
+		}
+	}
+	if err := alt.Close(); err != nil {
+		return "", nil, errors.Wrap(err, "failed to close message part")
+	}
+	return "multipart/alternative; boundary=" + alt.Boundary(), body.Bytes(), nil
+}
+
+// writeBase64 writes data base64 encoded in lines of 76 characters.
+func writeBase64(w io.Writer, data []byte) error {
+	encoded := base64.StdEncoding.EncodeToString(data)
+	for len(encoded) > 0 {
+		n := min(76, len(encoded))
+		if _, err := io.WriteString(w, encoded[:n]+"\r\n"); err != nil {
+			return err
+		}
+		encoded = encoded[n:]
+	}
+	return nil
+}
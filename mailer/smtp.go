@@ -0,0 +1,91 @@
+package mailer
+
+import (
+	"crypto/tls"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strconv"
+
+	"github.com/cockroachdb/errors"
+	"github.com/mopemope/quicknews/config"
+)
+
+// Sender delivers messages through the configured SMTP server.
+type Sender struct {
+	config *config.Mail
+}
+
+func NewSender(config *config.Mail) *Sender {
+	return &Sender{config: config}
+}
+
+// Send delivers the message to its recipients. The connection is upgraded with STARTTLS
+// when it is enabled, and authenticated with PLAIN when a username is configured.
+func (s *Sender) Send(msg *Message) error {
+	if s.config.Host == "" {
+		return errors.New("mail host is not configured")
+	}
+	if len(msg.To) == 0 {
+		return errors.New("no mail recipients")
+	}
+	data, err := msg.Bytes()
+	if err != nil {
+		return err
+	}
+
+	addr := net.JoinHostPort(s.config.Host, strconv.Itoa(s.config.Port))
+	c, err := smtp.Dial(addr)
+	if err != nil {
+		return errors.Wrapf(err, "failed to connect to %s", addr)
+	}
+	defer func() {
+		_ = c.Close()
+	}()
+
+	if s.config.StartTLS {
+		if ok, _ := c.Extension("STARTTLS"); !ok {
+			return errors.Newf("%s does not support STARTTLS", addr)
+		}
+		if err := c.StartTLS(&tls.Config{ServerName: s.config.Host}); err != nil {
+			return errors.Wrap(err, "failed to start TLS")
+		}
+	}
+	if s.config.Username != "" {
+		auth := smtp.PlainAuth("", s.config.Username, s.config.Password, s.config.Host)
+		if err := c.Auth(auth); err != nil {
+			return errors.Wrap(err, "failed to authenticate")
+		}
+	}
+
+	if err := c.Mail(envelopeAddress(s.config.From)); err != nil {
+		return errors.Wrap(err, "failed to set sender")
+	}
+	for _, to := range msg.To {
+		if err := c.Rcpt(envelopeAddress(to)); err != nil {
+			return errors.Wrapf(err, "failed to add recipient %s", to)
+		}
+	}
+	w, err := c.Data()
+	if err != nil {
+		return errors.Wrap(err, "failed to start message data")
+	}
+	if _, err := w.Write(data); err != nil {
+		return errors.Wrap(err, "failed to write message")
+	}
+	if err := w.Close(); err != nil {
+		return errors.Wrap(err, "failed to send message")
+	}
+	if err := c.Quit(); err != nil {
+		return errors.Wrap(err, "failed to close SMTP session")
+	}
+	return nil
+}
+
+// envelopeAddress returns the bare address of "Name <address>".
+func envelopeAddress(s string) string {
+	if addr, err := mail.ParseAddress(s); err == nil {
+		return addr.Address
+	}
+	return s
+}
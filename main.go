@@ -26,6 +26,15 @@ type CLI struct {
 	ExportAudio cmd.ExportAudioCmd `cmd:""  help:"Export audio files."`
 	Publish     cmd.PublishCmd     `cmd:"" help:"Publish articles."`
 	Config      cmd.ConfigCmd      `cmd:"" aliases:"cfg" help:"Show the current configuration."`
+	Feeds       cmd.FeedsCmd       `cmd:"" help:"Manage feeds."`
+	Site        cmd.SiteCmd        `cmd:"" help:"Generate a static HTML site of the summaries."`
+	ExportFeed  cmd.ExportFeedCmd  `cmd:"" help:"Export summaries as an Atom or JSON feed."`
+	ExportEpub  cmd.ExportEpubCmd  `cmd:"" help:"Export summaries as an EPUB digest."`
+	MailDigest  cmd.MailDigestCmd  `cmd:"" help:"Mail a digest of unread summaries."`
+	Rules       cmd.RulesCmd       `cmd:"" help:"List and test the rules applied to incoming articles."`
+	Prune       cmd.PruneCmd       `cmd:"" help:"Delete old articles, their summaries and audio files."`
+	Backup      cmd.BackupCmd      `cmd:"" help:"Back up the database, optionally with the audio files and config."`
+	Restore     cmd.RestoreCmd     `cmd:"" help:"Restore a backup."`
 
 	// Global flags
 	ConfigPath string           `name:"config" type:"path" default:"~/.config/quicknews/config.toml" help:"Path to the config file."`
@@ -91,21 +100,6 @@ func main() {
 }
 
 func setup(ctx context.Context, cilent *ent.Client) error {
-	repo := feed.NewRepository(cilent)
-	exist, err := repo.ExistBookmarkFeed(ctx)
-	if err != nil {
-		return err
-	}
-	if !exist {
-		input := &feed.FeedInput{
-			URL:         "https://quicknews.org/bookmark/rss",
-			Title:       "Bookmark",
-			Description: "Bookmark",
-			Link:        "https://quicknews.org/bookmark/rss",
-		}
-		if err := repo.Save(ctx, input, true); err != nil {
-			return err
-		}
-	}
-	return nil
+	_, err := feed.NewRepository(cilent).EnsureBookmarkFeed(ctx)
+	return err
 }
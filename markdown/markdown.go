@@ -0,0 +1,210 @@
+// Package markdown exports summaries as Markdown notes laid out for an Obsidian vault.
+package markdown
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/mopemope/quicknews/ent"
+)
+
+// Folder is the folder of the vault the notes are written into.
+const Folder = "quicknews"
+
+// Exporter writes a note per summary into <vault>/quicknews/<feed>/ and a note per feed
+// into <vault>/quicknews/feeds/, which the summary notes wiki-link to.
+type Exporter struct {
+	vault string
+}
+
+func NewExporter(vault string) *Exporter {
+	return &Exporter{vault: vault}
+}
+
+func (e *Exporter) Name() string {
+	return "markdown"
+}
+
+// Export writes the note of the summary, replacing the previous version, and creates the
+// note of its feed if it does not exist yet.
+func (e *Exporter) Export(sum *ent.Summary) error {
+	if sum.Edges.Feed == nil || sum.Edges.Article == nil {
+		return nil
+	}
+	feed := sum.Edges.Feed
+	feedNote := NoteName(feed.Title)
+
+	if err := e.writeFeedNote(feed, feedNote); err != nil {
+		return err
+	}
+
+	dir := filepath.Join(e.vault, Folder, feedNote)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return errors.Wrap(err, "failed to create directory")
+	}
+	published := publishedAt(sum)
+	name := NoteName(published.Local().Format("2006-01-02") + " " + sum.Title)
+	path := filepath.Join(dir, name+".md")
+	if err := os.WriteFile(path, []byte(Render(sum)), 0644); err != nil {
+		return errors.Wrapf(err, "failed to write %s", path)
+	}
+	return nil
+}
+
+func (e *Exporter) writeFeedNote(feed *ent.Feed, name string) error {
+	dir := filepath.Join(e.vault, Folder, "feeds")
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return errors.Wrap(err, "failed to create directory")
+	}
+	path := filepath.Join(dir, name+".md")
+	if _, err := os.Stat(path); err == nil {
+		// The feed note may have been edited in the vault.
+		return nil
+	}
+
+	var b bytes.Buffer
+	b.WriteString("---\n")
+	writeField(&b, "id", feed.ID.String())
+	writeField(&b, "url", feed.URL)
+	writeField(&b, "link", feed.Link)
+	b.WriteString("tags:\n  - quicknews/feed\n")
+	b.WriteString("---\n\n")
+	fmt.Fprintf(&b, "# %s\n\n", feed.Title)
+	if feed.Description != "" {
+		fmt.Fprintf(&b, "%s\n\n", feed.Description)
+	}
+	fmt.Fprintf(&b, "[%s](%s)\n", feed.Title, feed.Link)
+	if err := os.WriteFile(path, b.Bytes(), 0644); err != nil {
+		return errors.Wrapf(err, "failed to write %s", path)
+	}
+	return nil
+}
+
+// Render returns the note of a summary: YAML front matter followed by the summary.
+func Render(sum *ent.Summary) string {
+	feedLink := ""
+	if sum.Edges.Feed != nil {
+		feedLink = "[[" + NoteName(sum.Edges.Feed.Title) + "]]"
+	}
+
+	var b bytes.Buffer
+	b.WriteString("---\n")
+	writeField(&b, "id", sum.ID.String())
+	writeField(&b, "title", sum.Title)
+	writeField(&b, "feed", feedLink)
+	writeField(&b, "url", sum.URL)
+	a := sum.Edges.Article
+	if a != nil && a.Author != "" {
+		writeField(&b, "author", a.Author)
+	}
+	if a != nil && len(a.Categories) > 0 {
+		b.WriteString("categories:\n")
+		for _, c := range a.Categories {
+			b.WriteString("  - ")
+			writeValue(&b, c)
+		}
+	}
+	if a != nil && a.ImageURL != "" {
+		writeField(&b, "image", a.ImageURL)
+	}
+	if a != nil && len(a.Enclosures) > 0 {
+		b.WriteString("enclosures:\n")
+		for _, enc := range a.Enclosures {
+			b.WriteString("  - url: ")
+			writeValue(&b, enc.URL)
+			if enc.Type != "" {
+				b.WriteString("    type: ")
+				writeValue(&b, enc.Type)
+			}
+			if enc.Length > 0 {
+				fmt.Fprintf(&b, "    length: %d\n", enc.Length)
+			}
+		}
+	}
+	b.WriteString("tags:\n")
+	for _, tag := range Tags(sum) {
+		fmt.Fprintf(&b, "  - %s\n", tag)
+	}
+	fmt.Fprintf(&b, "published: %s\n", publishedAt(sum).Format(time.RFC3339))
+	b.WriteString("---\n\n")
+
+	fmt.Fprintf(&b, "# %s\n\n", sum.Title)
+	if feedLink != "" {
+		fmt.Fprintf(&b, "Feed: %s\n", feedLink)
+	}
+	fmt.Fprintf(&b, "Source: [%s](%s)\n\n", sum.Title, sum.URL)
+	if a != nil && a.ImageURL != "" {
+		fmt.Fprintf(&b, "![](%s)\n\n", a.ImageURL)
+	}
+	b.WriteString(strings.TrimSpace(sum.Summary))
+	b.WriteString("\n")
+	if a != nil && len(a.Enclosures) > 0 {
+		b.WriteString("\n")
+		for _, enc := range a.Enclosures {
+			label := enc.Type
+			if label == "" {
+				label = "media"
+			}
+			fmt.Fprintf(&b, "- [%s](%s)\n", label, enc.URL)
+		}
+	}
+	return b.String()
+}
+
+// Tags returns the tags of a summary note.
+func Tags(sum *ent.Summary) []string {
+	tags := []string{"quicknews"}
+	if sum.Edges.Feed != nil && sum.Edges.Feed.IsBookmark {
+		tags = append(tags, "quicknews/bookmark")
+	}
+	for _, tag := range sum.Tags {
+		// Obsidian tags cannot contain spaces.
+		tags = append(tags, "quicknews/"+strings.Join(strings.Fields(tag), "-"))
+	}
+	return tags
+}
+
+// NoteName converts a title to a note name Obsidian accepts in file names and wiki-links.
+func NoteName(title string) string {
+	s := strings.Map(func(r rune) rune {
+		switch r {
+		case '*', '"', '\\', '/', '<', '>', ':', '|', '?', '#', '^', '[', ']':
+			return '_'
+		case '\n', '\r', '\t':
+			return ' '
+		}
+		return r
+	}, title)
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return "untitled"
+	}
+	return s
+}
+
+// writeField writes a YAML key with a double quoted string value.
+func writeField(b *bytes.Buffer, key, value string) {
+	fmt.Fprintf(b, "%s: ", key)
+	writeValue(b, value)
+}
+
+// writeValue writes a double quoted string value followed by a newline.
+func writeValue(b *bytes.Buffer, value string) {
+	enc := json.NewEncoder(b)
+	enc.SetEscapeHTML(false)
+	// A JSON string is a valid YAML double quoted scalar.
+	_ = enc.Encode(value)
+}
+
+func publishedAt(sum *ent.Summary) time.Time {
+	if a := sum.Edges.Article; a != nil && !a.PublishedAt.IsZero() {
+		return a.PublishedAt
+	}
+	return sum.CreatedAt
+}
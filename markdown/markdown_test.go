@@ -0,0 +1,88 @@
+package markdown
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mopemope/quicknews/ent"
+	"github.com/mopemope/quicknews/ent/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testSummary() *ent.Summary {
+	sum := &ent.Summary{
+		ID:        uuid.New(),
+		URL:       "https://example.com/article",
+		Title:     `Go 1.24: "generic" type aliases`,
+		Summary:   "Summary text.\n",
+		CreatedAt: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+	}
+	sum.Edges.Feed = &ent.Feed{
+		ID:    uuid.New(),
+		URL:   "https://example.com/feed",
+		Title: "Example: News",
+		Link:  "https://example.com",
+	}
+	sum.Edges.Article = &ent.Article{PublishedAt: time.Date(2024, 1, 31, 12, 0, 0, 0, time.UTC)}
+	return sum
+}
+
+func TestRender(t *testing.T) {
+	sum := testSummary()
+	note := Render(sum)
+
+	assert.Contains(t, note, "---\nid: \""+sum.ID.String()+"\"\n")
+	assert.Contains(t, note, `title: "Go 1.24: \"generic\" type aliases"`)
+	assert.Contains(t, note, `feed: "[[Example_ News]]"`)
+	assert.Contains(t, note, `url: "https://example.com/article"`)
+	assert.Contains(t, note, "tags:\n  - quicknews\n")
+	assert.Contains(t, note, "published: 2024-01-31T12:00:00Z\n---\n")
+	assert.Contains(t, note, "Feed: [[Example_ News]]\n")
+	assert.Contains(t, note, "Summary text.\n")
+
+	sum.Tags = []string{"go", "release notes"}
+	assert.Contains(t, Render(sum), "tags:\n  - quicknews\n  - quicknews/go\n  - quicknews/release-notes\n")
+}
+
+func TestRender_Media(t *testing.T) {
+	sum := testSummary()
+	a := sum.Edges.Article
+	a.Author = "Jane Doe"
+	a.Categories = []string{"tech", "podcast"}
+	a.ImageURL = "https://example.com/cover.jpg"
+	a.Enclosures = []schema.Enclosure{{URL: "https://example.com/episode.mp3", Type: "audio/mpeg", Length: 1024}}
+	note := Render(sum)
+
+	assert.Contains(t, note, "author: \"Jane Doe\"\ncategories:\n  - \"tech\"\n  - \"podcast\"\n")
+	assert.Contains(t, note, "image: \"https://example.com/cover.jpg\"\n")
+	assert.Contains(t, note, "enclosures:\n  - url: \"https://example.com/episode.mp3\"\n    type: \"audio/mpeg\"\n    length: 1024\n")
+	assert.Contains(t, note, "![](https://example.com/cover.jpg)\n\nSummary text.\n")
+	assert.True(t, strings.HasSuffix(note, "Summary text.\n\n- [audio/mpeg](https://example.com/episode.mp3)\n"))
+}
+
+func TestExporter_Export(t *testing.T) {
+	vault := t.TempDir()
+	sum := testSummary()
+	e := NewExporter(vault)
+
+	require.NoError(t, e.Export(sum))
+	// Exporting again replaces the note instead of adding another one.
+	require.NoError(t, e.Export(sum))
+
+	feedNote := filepath.Join(vault, Folder, "feeds", "Example_ News.md")
+	assert.FileExists(t, feedNote)
+
+	notes, err := filepath.Glob(filepath.Join(vault, Folder, "Example_ News", "*.md"))
+	require.NoError(t, err)
+	require.Len(t, notes, 1)
+	assert.Equal(t, "2024-01-31 Go 1.24_ _generic_ type aliases.md", filepath.Base(notes[0]))
+
+	data, err := os.ReadFile(notes[0])
+	require.NoError(t, err)
+	assert.Equal(t, Render(sum), string(data))
+}
@@ -11,7 +11,9 @@ import (
 	"github.com/mopemope/quicknews/ent"
 	"github.com/mopemope/quicknews/ent/article"
 	"github.com/mopemope/quicknews/ent/feed"
+	"github.com/mopemope/quicknews/ent/predicate"
 	"github.com/mopemope/quicknews/ent/summary"
+	"github.com/mopemope/quicknews/ent/tombstone"
 )
 
 type ArticleRepository interface {
@@ -20,9 +22,21 @@ type ArticleRepository interface {
 	GetByUnreaded(ctx context.Context, feedID uuid.UUID) (ent.Articles, error)
 	GetFromURL(ctx context.Context, url string) (*ent.Article, error)
 	GetByDate(ctx context.Context, feedId uuid.UUID, date string) (ent.Articles, error)
+	GetRecent(ctx context.Context, since time.Time) (ent.Articles, error)
 	Save(ctx context.Context, article *ent.Article) (*ent.Article, error)
 	SaveAll(ctx context.Context, articles ent.Articles) error
 	Delete(ctx context.Context, id string) error
+	GetPrunable(ctx context.Context, opts PruneOptions) (ent.Articles, error)
+	DeleteAll(ctx context.Context, ids []uuid.UUID) error
+	// IsPruned reports whether the article with the URL was pruned.
+	IsPruned(ctx context.Context, url string) (bool, error)
+}
+
+// PruneOptions selects the articles removed by a retention policy.
+type PruneOptions struct {
+	Before     time.Time // Articles added before this time, zero for no age limit
+	MaxPerFeed int       // Articles beyond the newest of each feed, 0 for no limit
+	KeepUnread bool      // Keep the articles with an unread summary
 }
 
 type ArticleRepositoryImpl struct {
@@ -85,6 +99,7 @@ func (r *ArticleRepositoryImpl) GetFromURL(ctx context.Context, url string) (*en
 		Where(article.URL(url)).
 		WithFeed().
 		WithSummary().
+		WithDuplicateOf().
 		Only(ctx)
 
 	if err != nil && !ent.IsNotFound(err) {
@@ -130,9 +145,14 @@ func (r *ArticleRepositoryImpl) Save(ctx context.Context, article *ent.Article)
 			SetURL(article.URL).
 			SetDescription(article.Description).
 			SetContent(article.Content).
+			SetAuthor(article.Author).
+			SetCategories(article.Categories).
+			SetImageURL(article.ImageURL).
+			SetEnclosures(article.Enclosures).
 			SetCreatedAt(now).
 			SetPublishedAt(article.PublishedAt).
 			SetFeed(article.Edges.Feed).
+			SetNillableDuplicateOfID(duplicateOfID(article)).
 			Save(ctx)
 
 		if err != nil {
@@ -143,6 +163,32 @@ func (r *ArticleRepositoryImpl) Save(ctx context.Context, article *ent.Article)
 	return newArticle, err
 }
 
+func duplicateOfID(a *ent.Article) *uuid.UUID {
+	if a.Edges.DuplicateOf == nil {
+		return nil
+	}
+	return &a.Edges.DuplicateOf.ID
+}
+
+// GetRecent returns the articles added since the given time that are not duplicates of
+// another article, with their feed and summary.
+func (r *ArticleRepositoryImpl) GetRecent(ctx context.Context, since time.Time) (ent.Articles, error) {
+	articles, err := r.client.Article.
+		Query().
+		Where(
+			article.CreatedAtGTE(since),
+			article.Not(article.HasDuplicateOf()),
+		).
+		WithFeed().
+		WithSummary().
+		Order(ent.Asc(article.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get recent articles")
+	}
+	return articles, nil
+}
+
 // SaveAll saves all articles to the database.
 func (r *ArticleRepositoryImpl) SaveAll(ctx context.Context, articles ent.Articles) error {
 	now := clock.Now()
@@ -155,6 +201,10 @@ func (r *ArticleRepositoryImpl) SaveAll(ctx context.Context, articles ent.Articl
 				SetURL(article.URL).
 				SetDescription(article.Description).
 				SetContent(article.Content).
+				SetAuthor(article.Author).
+				SetCategories(article.Categories).
+				SetImageURL(article.ImageURL).
+				SetEnclosures(article.Enclosures).
 				SetCreatedAt(now).
 				SetPublishedAt(article.PublishedAt).
 				SetFeed(article.Edges.Feed).
@@ -196,3 +246,137 @@ func (r *ArticleRepositoryImpl) Delete(ctx context.Context, id string) error {
 		return nil
 	})
 }
+
+// GetPrunable returns the articles selected by the options, with their summary, and the
+// duplicates of the selected articles. The articles of the bookmark feed are never selected.
+func (r *ArticleRepositoryImpl) GetPrunable(ctx context.Context, opts PruneOptions) (ent.Articles, error) {
+	var selected []predicate.Article
+	if !opts.Before.IsZero() {
+		selected = append(selected, article.CreatedAtLT(opts.Before))
+	}
+	if opts.MaxPerFeed > 0 {
+		feedIDs, err := r.client.Feed.
+			Query().
+			Where(feed.IsBookmark(false)).
+			IDs(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get feeds")
+		}
+		var excess []uuid.UUID
+		for _, feedID := range feedIDs {
+			ids, err := r.client.Article.
+				Query().
+				Where(article.HasFeedWith(feed.ID(feedID))).
+				Order(ent.Desc(article.FieldPublishedAt), ent.Desc(article.FieldCreatedAt)).
+				IDs(ctx)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to get articles by feed ID")
+			}
+			if len(ids) > opts.MaxPerFeed {
+				excess = append(excess, ids[opts.MaxPerFeed:]...)
+			}
+		}
+		if len(excess) > 0 {
+			selected = append(selected, article.IDIn(excess...))
+		}
+	}
+	if len(selected) == 0 {
+		return nil, nil
+	}
+
+	query := r.client.Article.
+		Query().
+		Where(
+			article.Or(selected...),
+			article.HasFeedWith(feed.IsBookmark(false)),
+		)
+	if opts.KeepUnread {
+		query = query.Where(article.Not(article.HasSummaryWith(summary.Readed(false))))
+	}
+	articles, err := query.
+		WithSummary().
+		Order(ent.Asc(article.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get prunable articles")
+	}
+	if len(articles) == 0 {
+		return articles, nil
+	}
+
+	// A duplicate left without its original would be summarized on the next fetch
+	ids := make([]uuid.UUID, len(articles))
+	for i, a := range articles {
+		ids[i] = a.ID
+	}
+	duplicates, err := r.client.Article.
+		Query().
+		Where(
+			article.HasDuplicateOfWith(article.IDIn(ids...)),
+			article.IDNotIn(ids...),
+			article.HasFeedWith(feed.IsBookmark(false)),
+		).
+		WithSummary().
+		Order(ent.Asc(article.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get duplicates of prunable articles")
+	}
+	return append(articles, duplicates...), nil
+}
+
+// DeleteAll deletes the articles and their summaries, and records the URLs of the articles
+// as pruned.
+func (r *ArticleRepositoryImpl) DeleteAll(ctx context.Context, ids []uuid.UUID) error {
+	now := clock.Now()
+	return database.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		urls, err := tx.Article.
+			Query().
+			Where(article.IDIn(ids...)).
+			Select(article.FieldURL).
+			Strings(ctx)
+		if err != nil {
+			return errors.Wrap(err, "failed to get article URLs")
+		}
+		if _, err := tx.Tombstone.
+			Delete().
+			Where(tombstone.URLIn(urls...)).
+			Exec(ctx); err != nil {
+			return errors.Wrap(err, "failed to delete tombstones")
+		}
+		bulk := make([]*ent.TombstoneCreate, len(urls))
+		for i, url := range urls {
+			bulk[i] = tx.Tombstone.
+				Create().
+				SetURL(url).
+				SetPrunedAt(now)
+		}
+		if err := tx.Tombstone.CreateBulk(bulk...).Exec(ctx); err != nil {
+			return errors.Wrap(err, "failed to save tombstones")
+		}
+		if _, err := tx.Summary.
+			Delete().
+			Where(summary.HasArticleWith(article.IDIn(ids...))).
+			Exec(ctx); err != nil {
+			return errors.Wrap(err, "failed to delete summaries")
+		}
+		if _, err := tx.Article.
+			Delete().
+			Where(article.IDIn(ids...)).
+			Exec(ctx); err != nil {
+			return errors.Wrap(err, "failed to delete articles")
+		}
+		return nil
+	})
+}
+
+func (r *ArticleRepositoryImpl) IsPruned(ctx context.Context, url string) (bool, error) {
+	pruned, err := r.client.Tombstone.
+		Query().
+		Where(tombstone.URL(url)).
+		Exist(ctx)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to check tombstone")
+	}
+	return pruned, nil
+}
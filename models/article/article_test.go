@@ -10,6 +10,7 @@ import (
 	_ "github.com/mattn/go-sqlite3"
 	"github.com/mopemope/quicknews/ent"
 	"github.com/mopemope/quicknews/ent/enttest"
+	"github.com/mopemope/quicknews/ent/schema"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -40,6 +41,12 @@ func TestArticleRepository(t *testing.T) {
 		URL:         "https://example.com/article",
 		Description: "Test Description",
 		Content:     "Test Content",
+		Author:      "Jane Doe",
+		Categories:  []string{"tech", "podcast"},
+		ImageURL:    "https://example.com/cover.jpg",
+		Enclosures: []schema.Enclosure{
+			{URL: "https://example.com/episode.mp3", Type: "audio/mpeg", Length: 1024},
+		},
 		PublishedAt: time.Now(),
 	}
 	article.Edges.Feed = feed // Set the feed edge
@@ -52,6 +59,10 @@ func TestArticleRepository(t *testing.T) {
 	retrievedArticle, err := repo.GetById(ctx, savedArticle.ID)
 	require.NoError(t, err)
 	assert.Equal(t, "Test Article", retrievedArticle.Title)
+	assert.Equal(t, "Jane Doe", retrievedArticle.Author)
+	assert.Equal(t, []string{"tech", "podcast"}, retrievedArticle.Categories)
+	assert.Equal(t, "https://example.com/cover.jpg", retrievedArticle.ImageURL)
+	assert.Equal(t, article.Enclosures, retrievedArticle.Enclosures)
 
 	// Test GetFromURL
 	retrievedByUrl, err := repo.GetFromURL(ctx, "https://example.com/article")
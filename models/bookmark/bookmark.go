@@ -12,10 +12,12 @@ import (
 	"github.com/mopemope/quicknews/ent"
 	"github.com/mopemope/quicknews/ent/article"
 	"github.com/mopemope/quicknews/ent/feed"
+	"github.com/mopemope/quicknews/exporter"
 	"github.com/mopemope/quicknews/gemini"
+	"github.com/mopemope/quicknews/httpclient"
 	"github.com/mopemope/quicknews/models/summary"
-	"github.com/mopemope/quicknews/org"
 	"github.com/mopemope/quicknews/scraper"
+	"github.com/mopemope/quicknews/webhook"
 )
 
 type Repository interface {
@@ -27,6 +29,8 @@ type RepositoryImpl struct {
 	client       *ent.Client
 	config       *config.Config
 	geminiClient *gemini.Client
+	exporters    exporter.Exporters
+	webhooks     *webhook.Dispatcher
 }
 
 func NewRepository(ctx context.Context, client *ent.Client, config *config.Config) (Repository, error) {
@@ -38,6 +42,8 @@ func NewRepository(ctx context.Context, client *ent.Client, config *config.Confi
 		client:       client,
 		config:       config,
 		geminiClient: geminiClient,
+		exporters:    exporter.New(config),
+		webhooks:     webhook.New(config),
 	}, nil
 }
 
@@ -58,8 +64,8 @@ func (r *RepositoryImpl) AddBookmark(ctx context.Context, url string) error {
 		return err
 	}
 
-	return database.WithTx(ctx, r.client, func(tx *ent.Tx) error {
-
+	var bookmarked *ent.Summary
+	err = database.WithTx(ctx, r.client, func(tx *ent.Tx) error {
 		existArticle, err := tx.Article.Query().
 			Where(article.URL(url)).
 			WithFeed().
@@ -71,20 +77,39 @@ func (r *RepositoryImpl) AddBookmark(ctx context.Context, url string) error {
 
 		if existArticle != nil {
 			// Handle existing article: update its feed association
-			return r.handleExistingArticle(ctx, tx, existArticle, bookmarkFeed)
+			bookmarked, err = r.handleExistingArticle(ctx, tx, existArticle, bookmarkFeed)
+			return err
 		}
 
 		// Create new article and summary
-		return r.createNewBookmarkArticle(ctx, tx, url, bookmarkFeed)
+		bookmarked, err = r.createNewBookmarkArticle(ctx, tx, url, bookmarkFeed)
+		return err
 	})
+	if err != nil {
+		return err
+	}
+
+	if bookmarked == nil {
+		return nil
+	}
+	// Export and notify once committed, so that the files carry the bookmark and deliveries,
+	// which may be retried for a while, do not hold the transaction.
+	if err := r.exporters.Export(bookmarked); err != nil {
+		slog.Error("failed to export summary", slog.Any("summary_id", bookmarked.ID), slog.Any("error", err))
+	}
+	if err := r.webhooks.Summary(ctx, webhook.EventBookmarked, bookmarked); err != nil {
+		slog.Error("failed to notify webhooks", slog.Any("summary_id", bookmarked.ID), slog.Any("error", err))
+	}
+	return nil
 }
 
-// handleExistingArticle handles the case where the article already exists.
-func (r *RepositoryImpl) handleExistingArticle(ctx context.Context, tx *ent.Tx, existArticle *ent.Article, bookmarkFeed *ent.Feed) error {
+// handleExistingArticle handles the case where the article already exists. It returns the
+// summary moved to the bookmark feed, if any.
+func (r *RepositoryImpl) handleExistingArticle(ctx context.Context, tx *ent.Tx, existArticle *ent.Article, bookmarkFeed *ent.Feed) (*ent.Summary, error) {
 	if existArticle.Edges.Feed.IsBookmark {
 		// already bookmarked
 		slog.Warn("already bookmarked", slog.Any("url", existArticle.URL))
-		return nil
+		return nil, nil
 	}
 
 	// Update the article and summary to point to the bookmark feed
@@ -92,32 +117,42 @@ func (r *RepositoryImpl) handleExistingArticle(ctx context.Context, tx *ent.Tx,
 		UpdateOneID(existArticle.ID).
 		SetFeedID(bookmarkFeed.ID).
 		Exec(ctx); err != nil {
-		return errors.Wrap(err, "failed to update article")
+		return nil, errors.Wrap(err, "failed to update article")
 	}
 
-	if existArticle.Edges.Summary == nil {
+	sum := existArticle.Edges.Summary
+	if sum == nil {
 		// Summary might not exist if it failed previously or was deleted
 		slog.Warn("summary not found for existing article, skipping summary update", slog.Any("article_id", existArticle.ID))
-		return nil
+		return nil, nil
 	}
 
 	if err := tx.Summary.
-		UpdateOneID(existArticle.Edges.Summary.ID).
+		UpdateOneID(sum.ID).
 		SetFeedID(bookmarkFeed.ID).
 		Exec(ctx); err != nil {
 		// Log the error but don't fail the whole transaction,
 		// as the article itself was successfully moved.
-		slog.Error("failed to update summary feed", slog.Any("summary_id", existArticle.Edges.Summary.ID), slog.Any("error", err))
+		slog.Error("failed to update summary feed", slog.Any("summary_id", sum.ID), slog.Any("error", err))
+		return nil, nil
 	}
-	return nil
+	existArticle.Edges.Feed = bookmarkFeed
+	sum.Edges.Article = existArticle
+	sum.Edges.Feed = bookmarkFeed
+	return sum, nil
 }
 
-// createNewBookmarkArticle creates a new article, summary, and exports it.
-func (r *RepositoryImpl) createNewBookmarkArticle(ctx context.Context, tx *ent.Tx, url string, bookmarkFeed *ent.Feed) error {
+// createNewBookmarkArticle creates a new article and its summary. It returns the created
+// summary.
+func (r *RepositoryImpl) createNewBookmarkArticle(ctx context.Context, tx *ent.Tx, url string, bookmarkFeed *ent.Feed) (*ent.Summary, error) {
+	settings, err := httpclient.New(r.config, url)
+	if err != nil {
+		return nil, err
+	}
 	// get title from url
-	title, err := scraper.GetTitle(url)
+	title, err := scraper.GetTitle(url, scraper.WithHTTP(settings))
 	if err != nil {
-		return errors.Wrap(err, "failed to get title")
+		return nil, errors.Wrap(err, "failed to get title")
 	}
 
 	now := clock.Now()
@@ -131,7 +166,7 @@ func (r *RepositoryImpl) createNewBookmarkArticle(ctx context.Context, tx *ent.T
 		SetFeed(bookmarkFeed).
 		Save(ctx)
 	if err != nil {
-		return errors.Wrap(err, "failed to create article")
+		return nil, errors.Wrap(err, "failed to create article")
 	}
 	article.Edges.Feed = bookmarkFeed // Set edge for immediate use
 
@@ -170,7 +205,7 @@ func (r *RepositoryImpl) createNewBookmarkArticle(ctx context.Context, tx *ent.T
 		SetFeed(sum.Edges.Feed).       // Use edge directly
 		Save(ctx)
 	if err != nil {
-		return errors.Wrap(err, "failed to save summary")
+		return nil, errors.Wrap(err, "failed to save summary")
 	}
 	sum = createdSummary          // Update sum with the created entity including ID
 	sum.Edges.Article = article   // Set the article edge for the summary
@@ -183,34 +218,34 @@ func (r *RepositoryImpl) createNewBookmarkArticle(ctx context.Context, tx *ent.T
 		} else {
 			filename, err := summary.SaveAudioData(ctx, sum, r.config)
 			if err != nil {
-				return err
+				return nil, err
 			}
 			if filename != nil {
 				if _, err := tx.Summary.
 					UpdateOneID(sum.ID).
 					SetAudioFile(*filename).
 					Save(ctx); err != nil {
-					return errors.Wrap(err, "failed to update summary with audio file")
+					return nil, errors.Wrap(err, "failed to update summary with audio file")
 				}
+				sum.AudioFile = *filename
 			}
 		}
 	}
 
-	if err := org.ExportOrg(r.config, sum); err != nil {
-		// Log the error but don't fail the transaction
-		slog.Error("failed to export org", slog.Any("summary_id", sum.ID), slog.Any("error", err))
-	}
-	return nil
+	return sum, nil
 }
 
 func (r *RepositoryImpl) summarizePage(ctx context.Context, url string) (*gemini.PageSummary, error) {
+	settings, err := httpclient.New(r.config, url)
+	if err != nil {
+		return nil, err
+	}
 	var pageSummary *gemini.PageSummary
-	var err error
 	const maxRetries = 3
 	const baseWaitSeconds = 1
 
 	for i := range maxRetries {
-		pageSummary, err = r.geminiClient.Summarize(ctx, url)
+		pageSummary, err = r.geminiClient.SummarizePage(ctx, url, "", settings)
 		if err == nil && pageSummary != nil {
 			return pageSummary, nil // Success
 		}
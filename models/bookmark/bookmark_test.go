@@ -2,13 +2,19 @@ package bookmark
 
 import (
 	"context"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
 	"entgo.io/ent/dialect"
 	_ "github.com/mattn/go-sqlite3"
 	"github.com/mopemope/quicknews/config"
+	"github.com/mopemope/quicknews/ent"
 	"github.com/mopemope/quicknews/ent/enttest"
+	"github.com/mopemope/quicknews/ent/summary"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -79,3 +85,68 @@ func TestBookmarkRepository_AddBookmark(t *testing.T) {
 	// If it doesn't panic, the test passes in terms of structure
 	// The important thing is that the function doesn't crash
 }
+
+func TestBookmarkRepository_AddBookmark_Existing(t *testing.T) {
+	client := enttest.Open(t, dialect.SQLite, "file:bookmark_existing?mode=memory&cache=shared&_fk=1")
+	defer func() { _ = client.Close() }()
+	ctx := context.Background()
+
+	vault := t.TempDir()
+	config := &config.Config{
+		GeminiApiKey:   "test-key",
+		ExportMarkdown: vault,
+	}
+
+	newFeed := func(url string, bookmark bool) *ent.Feed {
+		f, err := client.Feed.Create().
+			SetURL(url).
+			SetTitle(url).
+			SetLink(url).
+			SetUpdatedAt(time.Now()).
+			SetIsBookmark(bookmark).
+			Save(ctx)
+		require.NoError(t, err)
+		return f
+	}
+	bookmarkFeed := newFeed("https://quicknews.org/bookmark/rss", true)
+	f := newFeed("https://example.com/feed", false)
+	a, err := client.Article.Create().
+		SetTitle("Post").
+		SetURL("https://example.com/post").
+		SetFeed(f).
+		Save(ctx)
+	require.NoError(t, err)
+	sum, err := client.Summary.Create().
+		SetURL(a.URL).
+		SetTitle("Post").
+		SetSummary("summary").
+		SetArticle(a).
+		SetFeed(f).
+		Save(ctx)
+	require.NoError(t, err)
+
+	repo, err := NewRepository(ctx, client, config)
+	require.NoError(t, err)
+	require.NoError(t, repo.AddBookmark(ctx, a.URL))
+
+	moved, err := client.Summary.Query().Where(summary.ID(sum.ID)).QueryFeed().Only(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, bookmarkFeed.ID, moved.ID)
+
+	// The exported note carries the bookmark
+	var notes []string
+	require.NoError(t, filepath.WalkDir(vault, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		if strings.Contains(string(data), "quicknews/bookmark") {
+			notes = append(notes, path)
+		}
+		return nil
+	}))
+	assert.NotEmpty(t, notes)
+}
@@ -2,6 +2,7 @@ package feed
 
 import (
 	"context"
+	"time"
 
 	"github.com/cockroachdb/errors"
 	"github.com/google/uuid"
@@ -14,6 +15,14 @@ import (
 	"github.com/mopemope/quicknews/ent/summary"
 )
 
+// BookmarkFeed is the feed bookmarked articles belong to.
+var BookmarkFeed = FeedInput{
+	URL:         "https://quicknews.org/bookmark/rss",
+	Title:       "Bookmark",
+	Description: "Bookmark",
+	Link:        "https://quicknews.org/bookmark/rss",
+}
+
 type FeedInput struct {
 	URL         string
 	Title       string
@@ -25,6 +34,8 @@ type FeedRepository interface {
 	GetByID(ctx context.Context, id uuid.UUID) (*ent.Feed, error)
 	GetBookmarkFeed(ctx context.Context) (*ent.Feed, error)
 	ExistBookmarkFeed(ctx context.Context) (bool, error)
+	// EnsureBookmarkFeed returns the bookmark feed, creating it when it does not exist.
+	EnsureBookmarkFeed(ctx context.Context) (*ent.Feed, error)
 	All(ctx context.Context) ([]*ent.Feed, error)
 	UpdateFeed(ctx context.Context, feed *ent.Feed, parsedFeed *gofeed.Feed) (*ent.Feed, error)
 	// Exist checks if a feed with the given URL already exists.
@@ -33,6 +44,24 @@ type FeedRepository interface {
 	// SaveFeeds saves multiple feeds in a single transaction.
 	SaveFeeds(ctx context.Context, inputs []*FeedInput) error
 	DeleteWithArticle(ctx context.Context, id uuid.UUID) error
+	// GetByURL retrieves a feed by its URL.
+	GetByURL(ctx context.Context, url string) (*ent.Feed, error)
+	// UpdateOrder sets the order (priority) of the feed. Lower values come first.
+	UpdateOrder(ctx context.Context, id uuid.UUID, order int) error
+	// RecordFetch records the outcome of a fetch in the health of the feed.
+	RecordFetch(ctx context.Context, id uuid.UUID, status int, fetchErr error, maxFailures int) (*ent.Feed, error)
+	// SetDisabled disables or re-enables fetching a feed.
+	SetDisabled(ctx context.Context, id uuid.UUID, disabled bool) error
+	// SetNextPoll sets the time the feed is due to be polled.
+	SetNextPoll(ctx context.Context, id uuid.UUID, at time.Time) error
+	// SetIntervals sets the bounds of the polling interval of the feed in minutes, 0 for
+	// the configured ones. The feed is due again right away.
+	SetIntervals(ctx context.Context, id uuid.UUID, minInterval, maxInterval int) error
+	// SetHub records the WebSub hub and topic advertised by the feed. A subscription to
+	// another hub or topic is forgotten.
+	SetHub(ctx context.Context, id uuid.UUID, hub, topic string) error
+	// SetWebSub records the state, secret and lease expiry of the WebSub subscription.
+	SetWebSub(ctx context.Context, id uuid.UUID, state, secret string, expiresAt *time.Time) error
 }
 
 type FeedRepositoryImpl struct {
@@ -82,6 +111,20 @@ func (r *FeedRepositoryImpl) ExistBookmarkFeed(ctx context.Context) (bool, error
 	return result, nil
 }
 
+func (r *FeedRepositoryImpl) EnsureBookmarkFeed(ctx context.Context) (*ent.Feed, error) {
+	exist, err := r.ExistBookmarkFeed(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !exist {
+		input := BookmarkFeed
+		if err := r.Save(ctx, &input, true); err != nil {
+			return nil, err
+		}
+	}
+	return r.GetBookmarkFeed(ctx)
+}
+
 // UpdateFeed updates the feed with the given ID using the parsed feed data.
 func (r *FeedRepositoryImpl) UpdateFeed(ctx context.Context, f *ent.Feed, parsedFeed *gofeed.Feed) (*ent.Feed, error) {
 
@@ -206,3 +249,135 @@ func (r *FeedRepositoryImpl) DeleteWithArticle(ctx context.Context, id uuid.UUID
 		return nil
 	})
 }
+
+func (r *FeedRepositoryImpl) GetByURL(ctx context.Context, url string) (*ent.Feed, error) {
+	f, err := r.client.Feed.
+		Query().
+		Where(feed.URLEQ(url)).
+		Only(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get feed by URL")
+	}
+	return f, nil
+}
+
+func (r *FeedRepositoryImpl) UpdateOrder(ctx context.Context, id uuid.UUID, order int) error {
+	return database.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		if err := tx.Feed.UpdateOneID(id).SetOrder(order).Exec(ctx); err != nil {
+			return errors.Wrap(err, "failed to update feed order")
+		}
+		return nil
+	})
+}
+
+// RecordFetch resets the failure count of the feed when fetchErr is nil, or counts one more
+// failure and stores the error otherwise. A feed failing maxFailures times in a row is
+// disabled; 0 never disables it.
+func (r *FeedRepositoryImpl) RecordFetch(ctx context.Context, id uuid.UUID, status int, fetchErr error, maxFailures int) (*ent.Feed, error) {
+	now := clock.Now()
+	var updatedFeed *ent.Feed
+	err := database.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		f, err := tx.Feed.Get(ctx, id)
+		if err != nil {
+			return errors.Wrap(err, "failed to get feed")
+		}
+
+		update := tx.Feed.UpdateOne(f).
+			SetLastStatus(status)
+		if fetchErr == nil {
+			update.
+				SetConsecutiveFailures(0).
+				ClearLastError().
+				SetLastSuccessAt(now)
+		} else {
+			failures := f.ConsecutiveFailures + 1
+			update.
+				SetConsecutiveFailures(failures).
+				SetLastError(fetchErr.Error())
+			if maxFailures > 0 && failures >= maxFailures {
+				update.SetDisabled(true)
+			}
+		}
+		updatedFeed, err = update.Save(ctx)
+		if err != nil {
+			return errors.Wrap(err, "failed to update feed health")
+		}
+		return nil
+	})
+	return updatedFeed, err
+}
+
+func (r *FeedRepositoryImpl) SetDisabled(ctx context.Context, id uuid.UUID, disabled bool) error {
+	return database.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		update := tx.Feed.UpdateOneID(id).SetDisabled(disabled)
+		if !disabled {
+			// Give a re-enabled feed a fresh start.
+			update.SetConsecutiveFailures(0)
+		}
+		if err := update.Exec(ctx); err != nil {
+			return errors.Wrap(err, "failed to update feed")
+		}
+		return nil
+	})
+}
+
+func (r *FeedRepositoryImpl) SetNextPoll(ctx context.Context, id uuid.UUID, at time.Time) error {
+	return database.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		if err := tx.Feed.UpdateOneID(id).SetNextPollAt(at).Exec(ctx); err != nil {
+			return errors.Wrap(err, "failed to update next poll time")
+		}
+		return nil
+	})
+}
+
+func (r *FeedRepositoryImpl) SetIntervals(ctx context.Context, id uuid.UUID, minInterval, maxInterval int) error {
+	return database.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		if err := tx.Feed.UpdateOneID(id).
+			SetMinInterval(minInterval).
+			SetMaxInterval(maxInterval).
+			ClearNextPollAt().
+			Exec(ctx); err != nil {
+			return errors.Wrap(err, "failed to update polling intervals")
+		}
+		return nil
+	})
+}
+
+func (r *FeedRepositoryImpl) SetHub(ctx context.Context, id uuid.UUID, hub, topic string) error {
+	return database.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		f, err := tx.Feed.Get(ctx, id)
+		if err != nil {
+			return errors.Wrap(err, "failed to get feed")
+		}
+		if f.HubURL == hub && f.TopicURL == topic {
+			return nil
+		}
+		if err := tx.Feed.UpdateOne(f).
+			SetHubURL(hub).
+			SetTopicURL(topic).
+			ClearWebsubState().
+			ClearWebsubSecret().
+			ClearWebsubExpiresAt().
+			Exec(ctx); err != nil {
+			return errors.Wrap(err, "failed to update feed hub")
+		}
+		return nil
+	})
+}
+
+func (r *FeedRepositoryImpl) SetWebSub(ctx context.Context, id uuid.UUID, state, secret string, expiresAt *time.Time) error {
+	return database.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		update := tx.Feed.UpdateOneID(id).
+			SetWebsubState(state).
+			SetWebsubSecret(secret)
+		if expiresAt != nil {
+			update.SetWebsubExpiresAt(*expiresAt)
+		} else {
+			update.ClearWebsubExpiresAt()
+		}
+		if err := update.Exec(ctx); err != nil {
+			return errors.Wrap(err, "failed to update WebSub subscription")
+		}
+		return nil
+	})
+}
@@ -3,8 +3,10 @@ package feed
 import (
 	"context"
 	"testing"
+	"time"
 
 	"entgo.io/ent/dialect"
+	"github.com/cockroachdb/errors"
 	"github.com/google/uuid"
 	_ "github.com/mattn/go-sqlite3"
 	"github.com/mopemope/quicknews/ent"
@@ -199,3 +201,124 @@ func TestFeedRepository_DeleteWithArticle_Bookmark(t *testing.T) {
 	require.NoError(t, err)
 	assert.Len(t, remainingFeeds, len(feeds)) // same number as before
 }
+
+func TestFeedRepository_UpdateOrder(t *testing.T) {
+	client := enttest.Open(t, dialect.SQLite, "file:feed_order?mode=memory&cache=shared&_fk=1")
+	defer func() { _ = client.Close() }()
+
+	repo := NewRepository(client)
+	ctx := context.Background()
+
+	for _, url := range []string{"https://example.com/a", "https://example.com/b"} {
+		err := repo.Save(ctx, &FeedInput{URL: url, Title: url}, false)
+		require.NoError(t, err)
+	}
+
+	b, err := repo.GetByURL(ctx, "https://example.com/b")
+	require.NoError(t, err)
+	require.NoError(t, repo.UpdateOrder(ctx, b.ID, 0))
+
+	feeds, err := repo.All(ctx)
+	require.NoError(t, err)
+	require.Len(t, feeds, 2)
+	assert.Equal(t, "https://example.com/b", feeds[0].URL)
+	assert.Equal(t, 0, feeds[0].Order)
+
+	_, err = repo.GetByURL(ctx, "https://example.com/missing")
+	assert.Error(t, err)
+}
+
+func TestFeedRepository_RecordFetch(t *testing.T) {
+	client := enttest.Open(t, dialect.SQLite, "file:feed_health?mode=memory&cache=shared&_fk=1")
+	defer func() { _ = client.Close() }()
+
+	repo := NewRepository(client)
+	ctx := context.Background()
+
+	require.NoError(t, repo.Save(ctx, &FeedInput{URL: "https://example.com/feed", Title: "Feed"}, false))
+	f, err := repo.GetByURL(ctx, "https://example.com/feed")
+	require.NoError(t, err)
+	assert.Nil(t, f.LastSuccessAt)
+
+	f, err = repo.RecordFetch(ctx, f.ID, 404, errors.New("http error: 404 Not Found"), 2)
+	require.NoError(t, err)
+	assert.Equal(t, 1, f.ConsecutiveFailures)
+	assert.Equal(t, 404, f.LastStatus)
+	assert.Equal(t, "http error: 404 Not Found", f.LastError)
+	assert.False(t, f.Disabled)
+
+	f, err = repo.RecordFetch(ctx, f.ID, 0, errors.New("connection refused"), 2)
+	require.NoError(t, err)
+	assert.Equal(t, 2, f.ConsecutiveFailures)
+	assert.True(t, f.Disabled, "disabled after max failures")
+
+	require.NoError(t, repo.SetDisabled(ctx, f.ID, false))
+	f, err = repo.RecordFetch(ctx, f.ID, 200, nil, 2)
+	require.NoError(t, err)
+	assert.Zero(t, f.ConsecutiveFailures)
+	assert.Empty(t, f.LastError)
+	assert.NotNil(t, f.LastSuccessAt)
+	assert.False(t, f.Disabled)
+
+	// 0 never disables
+	for range 5 {
+		f, err = repo.RecordFetch(ctx, f.ID, 500, errors.New("http error: 500"), 0)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 5, f.ConsecutiveFailures)
+	assert.False(t, f.Disabled)
+}
+
+func TestFeedRepository_SetHub(t *testing.T) {
+	client := enttest.Open(t, dialect.SQLite, "file:feed_hub?mode=memory&cache=shared&_fk=1")
+	defer func() { _ = client.Close() }()
+
+	repo := NewRepository(client)
+	ctx := context.Background()
+
+	require.NoError(t, repo.Save(ctx, &FeedInput{URL: "https://example.com/feed", Title: "Feed"}, false))
+	f, err := repo.GetByURL(ctx, "https://example.com/feed")
+	require.NoError(t, err)
+
+	require.NoError(t, repo.SetHub(ctx, f.ID, "https://hub.example.com/", ""))
+	expires := time.Now().Add(time.Hour)
+	require.NoError(t, repo.SetWebSub(ctx, f.ID, "subscribed", "secret", &expires))
+
+	// The same hub keeps the subscription
+	require.NoError(t, repo.SetHub(ctx, f.ID, "https://hub.example.com/", ""))
+	f, err = repo.GetByID(ctx, f.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "subscribed", f.WebsubState)
+	assert.Equal(t, "secret", f.WebsubSecret)
+	require.NotNil(t, f.WebsubExpiresAt)
+
+	// Another hub forgets it
+	require.NoError(t, repo.SetHub(ctx, f.ID, "https://other.example.com/", ""))
+	f, err = repo.GetByID(ctx, f.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "https://other.example.com/", f.HubURL)
+	assert.Empty(t, f.WebsubState)
+	assert.Empty(t, f.WebsubSecret)
+	assert.Nil(t, f.WebsubExpiresAt)
+}
+
+func TestFeedRepository_EnsureBookmarkFeed(t *testing.T) {
+	client := enttest.Open(t, dialect.SQLite, "file:ensure_bookmark?mode=memory&cache=shared&_fk=1")
+	defer func() { _ = client.Close() }()
+
+	repo := NewRepository(client)
+	ctx := context.Background()
+
+	created, err := repo.EnsureBookmarkFeed(ctx)
+	require.NoError(t, err)
+	assert.True(t, created.IsBookmark)
+	assert.Equal(t, BookmarkFeed.URL, created.URL)
+
+	found, err := repo.EnsureBookmarkFeed(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, created.ID, found.ID)
+
+	count, err := client.Feed.Query().Count(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+}
@@ -22,12 +22,15 @@ import (
 
 type SummaryRepository interface {
 	GetAll(ctx context.Context) ([]*ent.Summary, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*ent.Summary, error)
 	GetFromURL(ctx context.Context, url string) (*ent.Summary, error)
 	Save(ctx context.Context, sum *ent.Summary) (*ent.Summary, error)
 	GetUnlistened(ctx context.Context, date *string) ([]*ent.Summary, error)
 	UpdateListened(ctx context.Context, sum *ent.Summary) error
 	UpdateReaded(ctx context.Context, sum *ent.Summary) error
 	UpdateAudioFile(ctx context.Context, id uuid.UUID, filename string) error
+	GetUnmailed(ctx context.Context, since time.Time) ([]*ent.Summary, error)
+	UpdateMailed(ctx context.Context, ids []uuid.UUID, at time.Time) error
 	Delete(ctx context.Context, id uuid.UUID) error
 }
 
@@ -56,6 +59,19 @@ func (r *SummaryRepositoryImpl) GetAll(ctx context.Context) ([]*ent.Summary, err
 	return sums, nil
 }
 
+func (r *SummaryRepositoryImpl) GetByID(ctx context.Context, id uuid.UUID) (*ent.Summary, error) {
+	sum, err := r.client.Summary.
+		Query().
+		Where(summary.ID(id)).
+		WithFeed().
+		WithArticle().
+		Only(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get summary by ID")
+	}
+	return sum, nil
+}
+
 func (r *SummaryRepositoryImpl) GetFromURL(ctx context.Context, url string) (*ent.Summary, error) {
 	sum, err := r.client.Summary.
 		Query().
@@ -88,6 +104,9 @@ func (r *SummaryRepositoryImpl) Save(ctx context.Context, sum *ent.Summary) (*en
 			SetTitle(sum.Title).
 			SetSummary(sum.Summary).
 			SetURL(sum.URL).
+			SetReaded(sum.Readed).
+			SetTags(sum.Tags).
+			SetPriority(sum.Priority).
 			SetCreatedAt(now).
 			SetArticle(sum.Edges.Article).
 			SetFeed(sum.Edges.Feed).
@@ -170,6 +189,40 @@ func (r *SummaryRepositoryImpl) UpdateAudioFile(ctx context.Context, id uuid.UUI
 	})
 }
 
+// GetUnmailed returns the unread summaries created since the given time that have not
+// been sent in a mail digest yet.
+func (r *SummaryRepositoryImpl) GetUnmailed(ctx context.Context, since time.Time) ([]*ent.Summary, error) {
+	sums, err := r.client.Summary.
+		Query().
+		Where(
+			summary.Readed(false),
+			summary.MailedAtIsNil(),
+			summary.CreatedAtGTE(since),
+		).
+		WithFeed().
+		WithArticle().
+		Order(ent.Asc(summary.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get unmailed summaries")
+	}
+	return sums, nil
+}
+
+func (r *SummaryRepositoryImpl) UpdateMailed(ctx context.Context, ids []uuid.UUID, at time.Time) error {
+	return database.WithTx(ctx, r.client, func(tx *ent.Tx) error {
+		_, err := tx.Summary.
+			Update().
+			Where(summary.IDIn(ids...)).
+			SetMailedAt(at).
+			Save(ctx)
+		if err != nil {
+			return errors.Wrap(err, "failed to update summaries as mailed")
+		}
+		return nil
+	})
+}
+
 func (r *SummaryRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
 	return database.WithTx(ctx, r.client, func(tx *ent.Tx) error {
 		if err := tx.Summary.DeleteOneID(id).Exec(ctx); err != nil {
@@ -224,9 +277,13 @@ func SaveAudioData(ctx context.Context, sum *ent.Summary, cfg *config.Config) (*
 		return nil, err
 	}
 	filename := fmt.Sprintf("%s.mp3", sum.ID.String()) // TODO check fileformat
-	if err := os.WriteFile(filepath.Join(*dir, filename), data, os.ModePerm); err != nil {
+	path := filepath.Join(*dir, filename)
+	if err := os.WriteFile(path, data, os.ModePerm); err != nil {
 		return nil, errors.Wrap(err, "failed to save audio data")
 	}
+	if err := tts.NormalizeLoudness(path, cfg.Loudness); err != nil {
+		slog.Warn("Failed to normalize loudness", "path", path, "error", err)
+	}
 
 	return &filename, nil
 }
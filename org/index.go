@@ -0,0 +1,152 @@
+package org
+
+import (
+	"encoding/json"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sync"
+
+	"github.com/cockroachdb/errors"
+)
+
+// indexName is the file in the ExportOrg directory that records where each summary was
+// exported, so that an export finds the file to update without reading the archive.
+const indexName = ".quicknews-index.json"
+
+// lockName is the file locked while the index is read and written, see lockIndex.
+const lockName = ".quicknews-index.lock"
+
+// indexMutex serializes the exports of the process, which read and write the index.
+var indexMutex sync.Mutex
+
+var refProperty = regexp.MustCompile(`(?m)^:ROAM_REFS:\s+(\S+)\s*$`)
+
+// index maps the IDs of the exported summaries to their files, and the URLs of their
+// articles to the IDs.
+type index struct {
+	dir  string
+	IDs  map[string]string `json:"ids"`  // ID to the path relative to dir
+	Refs map[string]string `json:"refs"` // URL to the ID
+}
+
+// loadIndex reads the index of the directory. A directory exported before the index
+// existed is scanned once to build it.
+func loadIndex(dir string) (*index, error) {
+	idx := &index{dir: dir}
+	data, err := os.ReadFile(filepath.Join(dir, indexName))
+	switch {
+	case err == nil:
+		if err := json.Unmarshal(data, idx); err != nil {
+			return nil, errors.Wrap(err, "failed to decode org index")
+		}
+	case errors.Is(err, fs.ErrNotExist):
+		if err := idx.scan(); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, errors.Wrap(err, "failed to read org index")
+	}
+	if idx.IDs == nil {
+		idx.IDs = make(map[string]string)
+	}
+	if idx.Refs == nil {
+		idx.Refs = make(map[string]string)
+	}
+	return idx, nil
+}
+
+// scan records the :ID: and :ROAM_REFS: properties of every Org file of the directory.
+func (idx *index) scan() error {
+	idx.IDs = make(map[string]string)
+	idx.Refs = make(map[string]string)
+	err := filepath.WalkDir(idx.dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		if d.IsDir() || filepath.Ext(path) != ".org" {
+			return nil
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(idx.dir, path)
+		if err != nil {
+			return err
+		}
+		for _, section := range splitSections(string(data)) {
+			m := idProperty.FindStringSubmatch(section)
+			if m == nil {
+				continue
+			}
+			idx.IDs[m[1]] = rel
+			if ref := refProperty.FindStringSubmatch(section); ref != nil {
+				idx.Refs[ref[1]] = m[1]
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to search org files")
+	}
+	return nil
+}
+
+// find returns the file of the exported summary with the ID, or of the summary exported
+// from a previous summary of the same article, with the ID found. Files removed since
+// their export are not returned.
+func (idx *index) find(id, url string) (string, string) {
+	foundID := id
+	rel, ok := idx.IDs[id]
+	if !ok {
+		if foundID, ok = idx.Refs[url]; !ok {
+			return "", ""
+		}
+		if rel, ok = idx.IDs[foundID]; !ok {
+			return "", ""
+		}
+	}
+	path := filepath.Join(idx.dir, rel)
+	if _, err := os.Stat(path); err != nil {
+		return "", ""
+	}
+	return path, foundID
+}
+
+// add records the export of a summary to path and writes the index. The index is replaced
+// at once, so that it is never read half written.
+func (idx *index) add(path, id, url string) error {
+	rel, err := filepath.Rel(idx.dir, path)
+	if err != nil {
+		return errors.Wrap(err, "failed to update org index")
+	}
+	idx.IDs[id] = rel
+	idx.Refs[url] = id
+	data, err := json.Marshal(idx)
+	if err != nil {
+		return errors.Wrap(err, "failed to encode org index")
+	}
+	tmp, err := os.CreateTemp(idx.dir, indexName+".*")
+	if err != nil {
+		return errors.Wrap(err, "failed to write org index")
+	}
+	defer func() {
+		_ = os.Remove(tmp.Name())
+	}()
+	_, err = tmp.Write(data)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return errors.Wrap(err, "failed to write org index")
+	}
+	if err := os.Rename(tmp.Name(), filepath.Join(idx.dir, indexName)); err != nil {
+		return errors.Wrap(err, "failed to write org index")
+	}
+	return nil
+}
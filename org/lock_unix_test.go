@@ -0,0 +1,49 @@
+//go:build unix
+
+package org
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/mopemope/quicknews/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExporter_IndexLock(t *testing.T) {
+	dir := t.TempDir()
+	e := NewExporter(&config.Config{ExportOrg: dir})
+	first := testSummary("First", "https://example.com/first")
+	require.NoError(t, e.Export(first))
+
+	// Another process holds the lock and adds an entry
+	unlock, err := lockIndex(dir)
+	require.NoError(t, err)
+	done := make(chan error, 1)
+	second := testSummary("Second", "https://example.com/second")
+	go func() {
+		done <- e.Export(second)
+	}()
+	select {
+	case <-done:
+		t.Fatal("the export did not wait for the lock")
+	case <-time.After(100 * time.Millisecond):
+	}
+	idx, err := loadIndex(dir)
+	require.NoError(t, err)
+	require.NoError(t, idx.add(filepath.Join(dir, "other.org"), "other-id", "https://example.com/other"))
+	unlock()
+	require.NoError(t, <-done)
+
+	data, err := os.ReadFile(filepath.Join(dir, indexName))
+	require.NoError(t, err)
+	var written index
+	require.NoError(t, json.Unmarshal(data, &written))
+	assert.Contains(t, written.IDs, first.ID.String())
+	assert.Contains(t, written.IDs, second.ID.String())
+	assert.Contains(t, written.IDs, "other-id", "the entries of the other process are kept")
+}
@@ -1,71 +1,314 @@
 package org
 
 import (
-	"fmt"
-	"log/slog"
+	"bytes"
 	"os"
-	"path"
+	"path/filepath"
+	"regexp"
 	"strings"
+	"text/template"
+	"time"
 
 	"github.com/cockroachdb/errors"
 	"github.com/mopemope/quicknews/config"
 	"github.com/mopemope/quicknews/ent"
+	"github.com/mopemope/quicknews/ent/schema"
 )
 
-// ExportOrg exports the summary to an Org file.
-func ExportOrg(config *config.Config, sum *ent.Summary) error {
-	dst := config.ExportOrg
-	if dst == "" {
-		slog.Info("EXPORT_ORG is not set")
-		return nil
+// DefaultFileTemplate renders a summary as a whole Org file, an org-roam file node.
+const DefaultFileTemplate = `:PROPERTIES:
+:ID:       {{.ID}}
+:ROAM_REFS: {{.URL}}
+:FEEDURL:  {{.FeedURL}}
+:FEED:     {{.Feed}}
+:LINK:     {{.ArticleURL}}
+:TITLE:    {{.ArticleTitle}} [{{.ArticleURL}}]
+{{- if .Author}}
+:AUTHOR:   {{.Author}}
+{{- end}}
+{{- if .Categories}}
+:CATEGORIES: {{join .Categories ", "}}
+{{- end}}
+:END:
+#+TITLE:   {{.Title}} [{{.URL}}]
+#+FILETAGS: :{{join .Tags ":"}}:
+#+STARTUP: overview
+#+STARTUP: inlineimages
+#+OPTIONS: ^:nil
+
+* {{.State}} [[{{.URL}}][{{.Title}}]]
+
+{{.Summary}}
+{{- if .ImageURL}}
+
+[[{{.ImageURL}}]]
+{{- end}}
+{{- if .Enclosures}}
+{{range .Enclosures}}
+- [[{{.URL}}][{{or .Type "media"}}]]
+{{- end}}
+{{- end}}
+`
+
+// DefaultDailyTemplate renders a summary as a heading of a daily Org file, an org-roam
+// heading node.
+const DefaultDailyTemplate = `* {{.State}} {{.Title}} :{{join .Tags ":"}}:
+:PROPERTIES:
+:ID:       {{.ID}}
+:ROAM_REFS: {{.URL}}
+:FEEDURL:  {{.FeedURL}}
+:FEED:     {{.Feed}}
+{{- if .Author}}
+:AUTHOR:   {{.Author}}
+{{- end}}
+{{- if .Categories}}
+:CATEGORIES: {{join .Categories ", "}}
+{{- end}}
+:END:
+[[{{.URL}}][{{.URL}}]]
+
+{{.Summary}}
+{{- if .ImageURL}}
+
+[[{{.ImageURL}}]]
+{{- end}}
+{{- if .Enclosures}}
+{{range .Enclosures}}
+- [[{{.URL}}][{{or .Type "media"}}]]
+{{- end}}
+{{- end}}
+`
+
+// Entry is the data available to the templates.
+type Entry struct {
+	ID           string
+	Title        string
+	URL          string
+	Summary      string
+	Feed         string
+	FeedURL      string
+	ArticleTitle string
+	ArticleURL   string
+	State        string   // TODO, or DONE once read
+	Tags         []string // feed, plus bookmark, listened and the tags added by rules
+	Created      time.Time
+	Published    time.Time
+	Readed       bool
+	Listened     bool
+	Bookmark     bool
+	Author       string
+	Categories   []string
+	ImageURL     string
+	Enclosures   []schema.Enclosure // Media files, such as podcast episodes
+}
+
+// Exporter exports summaries to Org files in the ExportOrg directory, either one file per
+// summary under a directory per feed, or one file per day with a heading per summary.
+// An exported summary is found again through an index of the :ID: and :ROAM_REFS: of the
+// exported summaries and updated in place.
+type Exporter struct {
+	config *config.Config
+	tmpl   *template.Template
+	err    error
+}
+
+func NewExporter(config *config.Config) *Exporter {
+	e := &Exporter{config: config}
+	text := DefaultFileTemplate
+	if config.ExportOrgDaily {
+		text = DefaultDailyTemplate
+	}
+	if config.ExportOrgTemplate != "" {
+		data, err := os.ReadFile(config.ExportOrgTemplate)
+		if err != nil {
+			e.err = errors.Wrap(err, "failed to read org template")
+			return e
+		}
+		text = string(data)
+	}
+	e.tmpl, e.err = template.New("org").Funcs(template.FuncMap{
+		"join": strings.Join,
+	}).Parse(text)
+	if e.err != nil {
+		e.err = errors.Wrap(e.err, "failed to parse org template")
+	}
+	return e
+}
+
+func (e *Exporter) Name() string {
+	return "org"
+}
+
+func (e *Exporter) Export(sum *ent.Summary) error {
+	if e.err != nil {
+		return e.err
 	}
-	if sum.Edges.Feed == nil || sum.Edges.Article == nil {
-		slog.Info("Feed or Article is nil")
+	dst := e.config.ExportOrg
+	if dst == "" || sum.Edges.Feed == nil || sum.Edges.Article == nil {
 		return nil
 	}
+	entry := NewEntry(sum)
+
+	indexMutex.Lock()
+	defer indexMutex.Unlock()
+	unlock, err := lockIndex(dst)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+	// Read under the lock, as another process may have updated the index
+	idx, err := loadIndex(dst)
+	if err != nil {
+		return err
+	}
+	existing, existingID := idx.find(entry.ID, entry.URL)
+	if existingID != "" {
+		// Keep the ID of a re-summarized article so that links to it keep working.
+		entry.ID = existingID
+	}
+
+	var buf bytes.Buffer
+	if err := e.tmpl.Execute(&buf, entry); err != nil {
+		return errors.Wrap(err, "failed to render org template")
+	}
+
+	if e.config.ExportOrgDaily {
+		path := existing
+		if path == "" {
+			path = filepath.Join(dst, "daily", entry.Created.Local().Format("2006-01-02")+".org")
+		}
+		if err := writeDailyEntry(path, entry, buf.String()); err != nil {
+			return err
+		}
+		return idx.add(path, entry.ID, entry.URL)
+	}
+
+	path := existing
+	if path == "" {
+		dir := filepath.Join(dst, ConvertPathName(entry.Feed))
+		timestamp := entry.Created.Format("20060102150405")
+		path = filepath.Join(dir, timestamp+"-"+ConvertPathName(entry.Title)+".org")
+	}
+	if err := writeFile(path, buf.Bytes()); err != nil {
+		return err
+	}
+	return idx.add(path, entry.ID, entry.URL)
+}
+
+// ExportOrg exports the summary to an Org file.
+func ExportOrg(config *config.Config, sum *ent.Summary) error {
+	return NewExporter(config).Export(sum)
+}
+
+// NewEntry builds the template data of a summary with its feed and article edges loaded.
+func NewEntry(sum *ent.Summary) *Entry {
 	feed := sum.Edges.Feed
 	article := sum.Edges.Article
-
-	dst = path.Join(dst, ConvertPathName(feed.Title))
-	if err := os.MkdirAll(dst, os.ModePerm); err != nil {
-		return errors.Wrap(err, "failed to create directory")
+	entry := &Entry{
+		ID:           sum.ID.String(),
+		Title:        sum.Title,
+		URL:          sum.URL,
+		Summary:      strings.TrimSpace(sum.Summary),
+		Feed:         feed.Title,
+		FeedURL:      feed.URL,
+		ArticleTitle: article.Title,
+		ArticleURL:   article.URL,
+		State:        "TODO",
+		Tags:         []string{"feed"},
+		Created:      sum.CreatedAt,
+		Published:    article.PublishedAt,
+		Readed:       sum.Readed,
+		Listened:     sum.Listened,
+		Bookmark:     feed.IsBookmark,
+		Author:       article.Author,
+		Categories:   article.Categories,
+		ImageURL:     article.ImageURL,
+		Enclosures:   article.Enclosures,
+	}
+	if sum.Readed {
+		entry.State = "DONE"
+	}
+	if feed.IsBookmark {
+		entry.Tags = append(entry.Tags, "bookmark")
+	}
+	if sum.Listened {
+		entry.Tags = append(entry.Tags, "listened")
 	}
+	for _, tag := range sum.Tags {
+		entry.Tags = append(entry.Tags, tagName.ReplaceAllString(tag, "_"))
+	}
+	return entry
+}
 
-	timestamp := sum.CreatedAt.Format("20060102150405")
-	orgFile := timestamp + "-" + ConvertPathName(sum.Title) + ".org"
-	dst = path.Join(dst, orgFile)
+// tagName matches the characters not allowed in Org tags.
+var tagName = regexp.MustCompile(`[^\p{L}\p{N}_@#%]+`)
 
-	contentTemplate := `:PROPERTIES:
-:ID:       %s
-:FEEDURL:  %s
-:FEED:     %s
-:LINK:     %s
-:TITLE:    %s [%s]
-:END:
-#+TITLE:   %s [%s]
-#+TAGS: feed
-#+STARTUP: overview
-#+STARTUP: inlineimages
-#+OPTIONS: ^:nil
+var idProperty = regexp.MustCompile(`(?m)^:ID:\s+(\S+)\s*$`)
 
-# [[%s][%s %s]]
+func propertyPattern(name, value string) *regexp.Regexp {
+	return regexp.MustCompile(`(?m)^:` + name + `:\s+` + regexp.QuoteMeta(value) + `\s*$`)
+}
 
-%s
-`
-	content := fmt.Sprintf(contentTemplate,
-		sum.ID,
-		feed.URL,
-		feed.Title,
-		article.URL,
-		article.Title,
-		article.URL,
-		sum.Title,
-		sum.URL,
-		sum.URL,
-		sum.Title,
-		sum.URL,
-		sum.Summary)
-	return os.WriteFile(dst, []byte(content), os.ModePerm)
+// splitSections splits an Org document into the text before the first top-level heading
+// followed by each top-level heading with its content.
+func splitSections(content string) []string {
+	var sections []string
+	start := 0
+	for i := 0; i < len(content); {
+		if strings.HasPrefix(content[i:], "* ") && i > start {
+			sections = append(sections, content[start:i])
+			start = i
+		}
+		next := strings.IndexByte(content[i:], '\n')
+		if next < 0 {
+			break
+		}
+		i += next + 1
+	}
+	return append(sections, content[start:])
+}
+
+// writeDailyEntry replaces the heading of the entry in the daily file, or appends it.
+func writeDailyEntry(path string, entry *Entry, text string) error {
+	data, err := os.ReadFile(path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return errors.Wrapf(err, "failed to read %s", path)
+	}
+	content := string(data)
+	if content == "" {
+		content = "#+TITLE: " + entry.Created.Local().Format("2006-01-02") + "\n\n"
+	}
+	if !strings.HasSuffix(text, "\n") {
+		text += "\n"
+	}
+
+	idPattern := propertyPattern("ID", entry.ID)
+	sections := splitSections(content)
+	replaced := false
+	for i, section := range sections {
+		if strings.HasPrefix(section, "* ") && idPattern.MatchString(section) {
+			sections[i] = text
+			replaced = true
+			break
+		}
+	}
+	if !replaced {
+		if last := sections[len(sections)-1]; !strings.HasSuffix(last, "\n") {
+			sections[len(sections)-1] = last + "\n"
+		}
+		sections = append(sections, text)
+	}
+	return writeFile(path, []byte(strings.Join(sections, "")))
+}
+
+func writeFile(path string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return errors.Wrap(err, "failed to create directory")
+	}
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return errors.Wrapf(err, "failed to write %s", path)
+	}
+	return nil
 }
 
 // ConvertPathName converts a string to a safe path name component
@@ -0,0 +1,187 @@
+package org
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mopemope/quicknews/config"
+	"github.com/mopemope/quicknews/ent"
+	"github.com/mopemope/quicknews/ent/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testSummary(title, url string) *ent.Summary {
+	sum := &ent.Summary{
+		ID:        uuid.New(),
+		URL:       url,
+		Title:     title,
+		Summary:   "Summary of " + title,
+		CreatedAt: time.Date(2024, 3, 1, 9, 0, 0, 0, time.Local),
+	}
+	sum.Edges.Feed = &ent.Feed{Title: "Example Feed", URL: "https://example.com/feed"}
+	sum.Edges.Article = &ent.Article{Title: title, URL: url}
+	return sum
+}
+
+func orgFiles(t *testing.T, dir string) []string {
+	t.Helper()
+	var files []string
+	err := filepath.WalkDir(dir, func(path string, d os.DirEntry, err error) error {
+		if err == nil && !d.IsDir() && filepath.Ext(path) == ".org" {
+			files = append(files, path)
+		}
+		return err
+	})
+	require.NoError(t, err)
+	return files
+}
+
+func TestExporter_FileLayout(t *testing.T) {
+	dir := t.TempDir()
+	e := NewExporter(&config.Config{ExportOrg: dir})
+	sum := testSummary("First", "https://example.com/first")
+
+	require.NoError(t, e.Export(sum))
+	files := orgFiles(t, dir)
+	require.Len(t, files, 1)
+	assert.Equal(t, filepath.Join(dir, "Example_Feed", "20240301090000-First.org"), files[0])
+
+	data, err := os.ReadFile(files[0])
+	require.NoError(t, err)
+	assert.Contains(t, string(data), ":ID:       "+sum.ID.String()+"\n")
+	assert.Contains(t, string(data), ":ROAM_REFS: https://example.com/first\n")
+	assert.Contains(t, string(data), "* TODO [[https://example.com/first][First]]")
+
+	info, err := os.Stat(files[0])
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0644), info.Mode().Perm())
+
+	// Reading the summary updates the same file.
+	sum.Readed = true
+	require.NoError(t, e.Export(sum))
+	files = orgFiles(t, dir)
+	require.Len(t, files, 1)
+	data, err = os.ReadFile(files[0])
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "* DONE [[https://example.com/first][First]]")
+
+	// A new summary of the same article replaces the file and keeps its ID.
+	resummarized := testSummary("First (again)", "https://example.com/first")
+	resummarized.CreatedAt = resummarized.CreatedAt.Add(time.Hour)
+	require.NoError(t, e.Export(resummarized))
+	files = orgFiles(t, dir)
+	require.Len(t, files, 1)
+	data, err = os.ReadFile(files[0])
+	require.NoError(t, err)
+	assert.Contains(t, string(data), ":ID:       "+sum.ID.String()+"\n")
+	assert.Contains(t, string(data), "First (again)")
+}
+
+func TestExporter_DailyLayout(t *testing.T) {
+	dir := t.TempDir()
+	e := NewExporter(&config.Config{ExportOrg: dir, ExportOrgDaily: true})
+	first := testSummary("First", "https://example.com/first")
+	second := testSummary("Second", "https://example.com/second")
+	second.Edges.Feed.IsBookmark = true
+
+	require.NoError(t, e.Export(first))
+	require.NoError(t, e.Export(second))
+	first.Readed = true
+	first.Listened = true
+	require.NoError(t, e.Export(first))
+
+	files := orgFiles(t, dir)
+	require.Len(t, files, 1)
+	assert.Equal(t, filepath.Join(dir, "daily", "2024-03-01.org"), files[0])
+
+	data, err := os.ReadFile(files[0])
+	require.NoError(t, err)
+	content := string(data)
+	assert.True(t, strings.HasPrefix(content, "#+TITLE: 2024-03-01\n\n* DONE First :feed:listened:\n"))
+	assert.Contains(t, content, "* TODO Second :feed:bookmark:\n")
+	assert.Equal(t, 1, strings.Count(content, ":ID:       "+first.ID.String()))
+	// The updated heading keeps its position.
+	assert.Less(t, strings.Index(content, "First"), strings.Index(content, "Second"))
+}
+
+func TestExporter_CustomTemplate(t *testing.T) {
+	dir := t.TempDir()
+	tmpl := filepath.Join(t.TempDir(), "entry.tmpl")
+	require.NoError(t, os.WriteFile(tmpl, []byte("#+TITLE: {{.Title}}\n:PROPERTIES:\n:ID: {{.ID}}\n:END:\n{{.State}} {{.Feed}}\n"), 0644))
+
+	e := NewExporter(&config.Config{ExportOrg: dir, ExportOrgTemplate: tmpl})
+	sum := testSummary("Custom", "https://example.com/custom")
+	require.NoError(t, e.Export(sum))
+
+	files := orgFiles(t, dir)
+	require.Len(t, files, 1)
+	data, err := os.ReadFile(files[0])
+	require.NoError(t, err)
+	assert.Equal(t, "#+TITLE: Custom\n:PROPERTIES:\n:ID: "+sum.ID.String()+"\n:END:\nTODO Example Feed\n", string(data))
+
+	assert.Error(t, NewExporter(&config.Config{ExportOrg: dir, ExportOrgTemplate: filepath.Join(dir, "missing")}).Export(sum))
+}
+
+func TestExporter_Media(t *testing.T) {
+	dir := t.TempDir()
+	e := NewExporter(&config.Config{ExportOrg: dir})
+	sum := testSummary("Episode", "https://example.com/episode")
+	a := sum.Edges.Article
+	a.Author = "Jane Doe"
+	a.Categories = []string{"tech", "podcast"}
+	a.ImageURL = "https://example.com/cover.jpg"
+	a.Enclosures = []schema.Enclosure{{URL: "https://example.com/episode.mp3", Type: "audio/mpeg", Length: 1024}}
+	require.NoError(t, e.Export(sum))
+
+	files := orgFiles(t, dir)
+	require.Len(t, files, 1)
+	data, err := os.ReadFile(files[0])
+	require.NoError(t, err)
+	content := string(data)
+	assert.Contains(t, content, ":AUTHOR:   Jane Doe\n:CATEGORIES: tech, podcast\n:END:\n")
+	assert.True(t, strings.HasSuffix(content, "Summary of Episode\n\n[[https://example.com/cover.jpg]]\n\n- [[https://example.com/episode.mp3][audio/mpeg]]\n"), content)
+
+	// Without media the summary ends the file as before.
+	plain := testSummary("Plain", "https://example.com/plain")
+	assert.True(t, strings.HasSuffix(renderEntry(t, e, plain), ":END:\n#+TITLE:   Plain [https://example.com/plain]\n#+FILETAGS: :feed:\n#+STARTUP: overview\n#+STARTUP: inlineimages\n#+OPTIONS: ^:nil\n\n* TODO [[https://example.com/plain][Plain]]\n\nSummary of Plain\n"))
+}
+
+func renderEntry(t *testing.T, e *Exporter, sum *ent.Summary) string {
+	t.Helper()
+	var buf strings.Builder
+	require.NoError(t, e.tmpl.Execute(&buf, NewEntry(sum)))
+	return buf.String()
+}
+
+func TestExporter_Index(t *testing.T) {
+	dir := t.TempDir()
+	e := NewExporter(&config.Config{ExportOrg: dir})
+	sum := testSummary("Indexed", "https://example.com/indexed")
+
+	// A file exported before the index existed is found by scanning the directory once.
+	moved := filepath.Join(dir, "archive", "indexed.org")
+	require.NoError(t, os.MkdirAll(filepath.Dir(moved), 0755))
+	require.NoError(t, os.WriteFile(moved, []byte(":PROPERTIES:\n:ID:       "+sum.ID.String()+"\n:ROAM_REFS: https://example.com/indexed\n:END:\n"), 0644))
+	require.NoError(t, e.Export(sum))
+	assert.Equal(t, []string{moved}, orgFiles(t, dir))
+	assert.FileExists(t, filepath.Join(dir, indexName))
+
+	// Later exports go through the index rather than reading the other files.
+	other := testSummary("Other", "https://example.com/other")
+	decoy := filepath.Join(dir, "decoy.org")
+	require.NoError(t, os.WriteFile(decoy, []byte(":PROPERTIES:\n:ID:       "+other.ID.String()+"\n:END:\n"), 0644))
+	require.NoError(t, e.Export(other))
+	data, err := os.ReadFile(decoy)
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "Other")
+
+	// A file removed since its export is written again.
+	require.NoError(t, os.Remove(moved))
+	require.NoError(t, e.Export(sum))
+	assert.FileExists(t, filepath.Join(dir, "Example_Feed", "20240301090000-Indexed.org"))
+}
@@ -0,0 +1,44 @@
+package rss
+
+// ItemDiff lists the differences between the items of two feeds, matched by guid.
+type ItemDiff struct {
+	Added   []Item
+	Removed []Item
+	Changed []Item // Items of the new feed whose title or audio URL differ
+}
+
+// Empty reports whether the feeds have the same items.
+func (d *ItemDiff) Empty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0
+}
+
+// DiffItems compares the items of the old feed with the new one. old may be nil.
+func DiffItems(old, new *RSS) *ItemDiff {
+	diff := &ItemDiff{}
+	oldItems := map[string]Item{}
+	if old != nil {
+		for _, item := range old.Channel.Items {
+			oldItems[item.Guid] = item
+		}
+	}
+
+	seen := map[string]bool{}
+	for _, item := range new.Channel.Items {
+		seen[item.Guid] = true
+		prev, ok := oldItems[item.Guid]
+		switch {
+		case !ok:
+			diff.Added = append(diff.Added, item)
+		case prev.Title != item.Title || prev.Enclosure.URL != item.Enclosure.URL:
+			diff.Changed = append(diff.Changed, item)
+		}
+	}
+	if old != nil {
+		for _, item := range old.Channel.Items {
+			if !seen[item.Guid] {
+				diff.Removed = append(diff.Removed, item)
+			}
+		}
+	}
+	return diff
+}
@@ -0,0 +1,49 @@
+package rss
+
+import (
+	"encoding/xml"
+
+	"github.com/cockroachdb/errors"
+)
+
+// document mirrors the parts of a published feed needed to carry its episodes over,
+// with the namespaced elements qualified by their namespace URL.
+type document struct {
+	Channel struct {
+		Items []struct {
+			Title       string    `xml:"title"`
+			Link        string    `xml:"link"`
+			Guid        string    `xml:"guid"`
+			PubDate     string    `xml:"pubDate"`
+			Description string    `xml:"description"`
+			Content     string    `xml:"http://purl.org/rss/1.0/modules/content/ encoded"`
+			Enclosure   Enclosure `xml:"enclosure"`
+			Duration    string    `xml:"http://www.itunes.com/dtds/podcast-1.0.dtd duration"`
+			Episode     int       `xml:"http://www.itunes.com/dtds/podcast-1.0.dtd episode"`
+		} `xml:"item"`
+	} `xml:"channel"`
+}
+
+// Parse reads the episodes of a published RSS feed. Channel metadata is not restored.
+func Parse(data []byte) (*RSS, error) {
+	doc := &document{}
+	if err := xml.Unmarshal(data, doc); err != nil {
+		return nil, errors.Wrap(err, "failed to parse RSS")
+	}
+
+	r := &RSS{}
+	for _, it := range doc.Channel.Items {
+		r.Channel.Items = append(r.Channel.Items, Item{
+			Title:          it.Title,
+			Link:           it.Link,
+			Guid:           it.Guid,
+			PubDate:        it.PubDate,
+			Description:    CDATA(it.Description),
+			ContentEncoded: CDATA(it.Content),
+			Enclosure:      it.Enclosure,
+			ItunesDuration: it.Duration,
+			ItunesEpisode:  it.Episode,
+		})
+	}
+	return r, nil
+}
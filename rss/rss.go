@@ -1,19 +1,42 @@
 package rss
 
 import (
+	"cmp"
 	"encoding/xml"
 	"os"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/cockroachdb/errors"
+	"github.com/google/uuid"
 	"github.com/mopemope/quicknews/config"
 )
 
+const (
+	namespaceContent = "http://purl.org/rss/1.0/modules/content/"
+	namespacePodcast = "https://podcastindex.org/namespace/1.0"
+)
+
+// podcastGuidNamespace is the UUIDv5 namespace defined by the podcast namespace for podcast:guid.
+var podcastGuidNamespace = uuid.MustParse("ead4c236-bf58-58c6-a2c6-a6b28d128cb6")
+
 type RSS struct {
-	XMLName            xml.Name `xml:"rss"`
-	Version            string   `xml:"version,attr"`
-	XMLNamespaceItunes string   `xml:"xmlns:itunes,attr"`
-	Channel            Channel  `xml:"channel"`
+	XMLName             xml.Name `xml:"rss"`
+	Version             string   `xml:"version,attr"`
+	XMLNamespaceItunes  string   `xml:"xmlns:itunes,attr"`
+	XMLNamespaceContent string   `xml:"xmlns:content,attr,omitempty"` // Declared when an item has content:encoded
+	XMLNamespacePodcast string   `xml:"xmlns:podcast,attr,omitempty"` // Declared when podcast:guid is set
+	Channel             Channel  `xml:"channel"`
+}
+
+// CDATA is a string written as a CDATA section, allowing HTML without escaping.
+type CDATA string
+
+func (c CDATA) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return e.EncodeElement(struct {
+		Text string `xml:",cdata"`
+	}{string(c)}, start)
 }
 
 type Channel struct {
@@ -30,7 +53,8 @@ type Channel struct {
 	ItunesImage    ItunesImage    `xml:"itunes:image"`    // Artwork image
 	ItunesCategory ItunesCategory `xml:"itunes:category"` // Category
 	ItunesExplicit string         `xml:"itunes:explicit"` // Explicit content (yes/no)
-	Items          []Item         `xml:"item"`            // List of episodes
+	PodcastGuid    string         `xml:"podcast:guid,omitempty"`
+	Items          []Item         `xml:"item"` // List of episodes
 }
 
 type ItunesOwner struct {
@@ -51,16 +75,18 @@ type ItunesCategory struct {
 
 // Item (episode) information
 type Item struct {
-	Title          string      `xml:"title"`           // Episode title
-	Link           string      `xml:"link"`            // Episode webpage URL (optional)
-	Guid           string      `xml:"guid"`            // Unique identifier (usually audio file URL, etc.)
-	PubDate        string      `xml:"pubDate"`         // Episode publication date (RFC1123Z format)
-	Description    string      `xml:"description"`     // Episode description (CDATA allows HTML tags)
+	Title          string      `xml:"title"`       // Episode title
+	Link           string      `xml:"link"`        // Episode webpage URL (optional)
+	Guid           string      `xml:"guid"`        // Unique identifier (usually audio file URL, etc.)
+	PubDate        string      `xml:"pubDate"`     // Episode publication date (RFC1123Z format)
+	Description    CDATA       `xml:"description"` // Episode description (CDATA allows HTML tags)
+	ContentEncoded CDATA       `xml:"content:encoded,omitempty"`
 	Enclosure      Enclosure   `xml:"enclosure"`       // Audio file information
 	ItunesAuthor   string      `xml:"itunes:author"`   // Episode author (can be the same as the channel)
 	ItunesSubtitle string      `xml:"itunes:subtitle"` // Episode short description
 	ItunesSummary  string      `xml:"itunes:summary"`  // Episode detailed description (can be the same as description)
 	ItunesDuration string      `xml:"itunes:duration"` // Duration (seconds or HH:MM:SS)
+	ItunesEpisode  int         `xml:"itunes:episode,omitempty"`
 	ItunesImage    ItunesImage `xml:"itunes:image"`    // Episode-specific artwork (optional)
 	ItunesExplicit string      `xml:"itunes:explicit"` // Explicit content (yes/no)
 }
@@ -74,33 +100,56 @@ type Enclosure struct {
 
 func NewRSS(config *config.Podcast) *RSS {
 	pubDate := time.Now().Format(time.RFC1123Z)
+	language := cmp.Or(config.Language, "ja")
+	category := cmp.Or(config.Category, "Technology")
+	explicit := "no"
+	if config.Explicit {
+		explicit = "yes"
+	}
+
 	// create
-	return &RSS{
+	r := &RSS{
 		Version:            "2.0",
 		XMLNamespaceItunes: "http://www.itunes.com/dtds/podcast-1.0.dtd",
 		Channel: Channel{
 			Title:          config.ChannelTitle,
 			Link:           config.ChannelLink,
 			Description:    config.ChannelDesc,
-			Language:       "ja",
+			Language:       language,
 			PubDate:        pubDate,
-			ItunesAuthor:   "",
+			ItunesAuthor:   config.Author,
 			ItunesSubtitle: "",
-			ItunesSummary:  "",
+			ItunesSummary:  config.ChannelDesc,
 			ItunesOwner: ItunesOwner{
-				ItunesName:  "",
-				ItunesEmail: "",
+				ItunesName:  config.Author,
+				ItunesEmail: config.OwnerEmail,
 			},
 			ItunesImage: ItunesImage{
-				Href: "",
+				Href: config.ImageURL,
 			},
 			ItunesCategory: ItunesCategory{
-				Text: "Technology",
+				Text: category,
 			},
-			ItunesExplicit: "no",
+			ItunesExplicit: explicit,
 			Items:          []Item{},
 		},
 	}
+	if config.PublishURL != "" {
+		r.Channel.PodcastGuid = PodcastGuid(config.PublishURL + "/rss.xml")
+		r.XMLNamespacePodcast = namespacePodcast
+	}
+	return r
+}
+
+// PodcastGuid returns the podcast:guid of a feed: a UUIDv5 of its URL without the scheme
+// and trailing slashes.
+func PodcastGuid(feedURL string) string {
+	s := feedURL
+	if i := strings.Index(s, "://"); i >= 0 {
+		s = s[i+3:]
+	}
+	s = strings.TrimRight(s, "/")
+	return uuid.NewSHA1(podcastGuidNamespace, []byte(s)).String()
 }
 
 type RSSItem struct {
@@ -108,7 +157,10 @@ type RSSItem struct {
 	Link        string
 	Guid        string
 	PubDate     string
-	Description string
+	Description string // Plain text or HTML show notes
+	Content     string // Full HTML show notes written to content:encoded
+	Duration    string // HH:MM:SS
+	Episode     int    // itunes:episode, assigned by NumberEpisodes when zero
 	AudioURL    string
 	Length      string
 	MimeType    string
@@ -116,11 +168,12 @@ type RSSItem struct {
 
 func (r *RSS) AddItem(rssIem RSSItem) {
 	item := Item{
-		Title:       rssIem.Title,
-		Link:        rssIem.Link,
-		Guid:        rssIem.Guid,
-		PubDate:     rssIem.PubDate,
-		Description: rssIem.Description,
+		Title:          rssIem.Title,
+		Link:           rssIem.Link,
+		Guid:           rssIem.Guid,
+		PubDate:        rssIem.PubDate,
+		Description:    CDATA(rssIem.Description),
+		ContentEncoded: CDATA(rssIem.Content),
 		Enclosure: Enclosure{
 			URL:    rssIem.AudioURL,
 			Length: rssIem.Length,
@@ -129,13 +182,103 @@ func (r *RSS) AddItem(rssIem RSSItem) {
 		ItunesAuthor:   r.Channel.ItunesAuthor,
 		ItunesSubtitle: r.Channel.ItunesSubtitle,
 		ItunesSummary:  r.Channel.ItunesSummary,
-		ItunesDuration: "00:00", // default value
+		ItunesDuration: cmp.Or(rssIem.Duration, "00:00"),
+		ItunesEpisode:  rssIem.Episode,
 		ItunesImage:    r.Channel.ItunesImage,
 		ItunesExplicit: r.Channel.ItunesExplicit,
 	}
+	if item.ContentEncoded != "" {
+		r.XMLNamespaceContent = namespaceContent
+	}
 	r.Channel.Items = append(r.Channel.Items, item)
 }
 
+// NumberEpisodes assigns itunes:episode numbers in chronological order to the items
+// without one, continuing after the highest number already assigned.
+func (r *RSS) NumberEpisodes() {
+	items := make([]*Item, 0, len(r.Channel.Items))
+	last := 0
+	for i := range r.Channel.Items {
+		item := &r.Channel.Items[i]
+		if item.ItunesEpisode == 0 {
+			items = append(items, item)
+		}
+		last = max(last, item.ItunesEpisode)
+	}
+	slices.SortStableFunc(items, func(a, b *Item) int {
+		return cmp.Or(parsePubDate(a.PubDate).Compare(parsePubDate(b.PubDate)), cmp.Compare(a.Title, b.Title))
+	})
+	for i, item := range items {
+		item.ItunesEpisode = last + i + 1
+	}
+}
+
+// Merge carries over the items of a previously published feed that were not regenerated,
+// and keeps the episode numbers of the regenerated ones. Items are sorted newest first.
+func (r *RSS) Merge(prev *RSS) {
+	if prev == nil {
+		return
+	}
+	index := make(map[string]int, len(r.Channel.Items))
+	for i, item := range r.Channel.Items {
+		index[item.Guid] = i
+	}
+	for _, item := range prev.Channel.Items {
+		if i, ok := index[item.Guid]; ok {
+			if r.Channel.Items[i].ItunesEpisode == 0 {
+				r.Channel.Items[i].ItunesEpisode = item.ItunesEpisode
+			}
+			continue
+		}
+		r.AddItem(RSSItem{
+			Title:       item.Title,
+			Link:        item.Link,
+			Guid:        item.Guid,
+			PubDate:     item.PubDate,
+			Description: string(item.Description),
+			Content:     string(item.ContentEncoded),
+			Duration:    item.ItunesDuration,
+			Episode:     item.ItunesEpisode,
+			AudioURL:    item.Enclosure.URL,
+			Length:      item.Enclosure.Length,
+			MimeType:    item.Enclosure.Type,
+		})
+	}
+	slices.SortStableFunc(r.Channel.Items, func(a, b Item) int {
+		return parsePubDate(b.PubDate).Compare(parsePubDate(a.PubDate))
+	})
+}
+
+// Retain removes the items published before now-maxAge and the items beyond the newest
+// count, and returns the removed items. Zero values disable the corresponding limit.
+func (r *RSS) Retain(maxAge time.Duration, count int, now time.Time) []Item {
+	slices.SortStableFunc(r.Channel.Items, func(a, b Item) int {
+		return parsePubDate(b.PubDate).Compare(parsePubDate(a.PubDate))
+	})
+	var kept, removed []Item
+	for _, item := range r.Channel.Items {
+		switch {
+		case maxAge > 0 && parsePubDate(item.PubDate).Before(now.Add(-maxAge)):
+			removed = append(removed, item)
+		case count > 0 && len(kept) >= count:
+			removed = append(removed, item)
+		default:
+			kept = append(kept, item)
+		}
+	}
+	r.Channel.Items = kept
+	return removed
+}
+
+func parsePubDate(s string) time.Time {
+	for _, layout := range []string{time.RFC1123Z, time.RFC1123} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t
+		}
+	}
+	return time.Time{}
+}
+
 func (r *RSS) WriteToFile(filePath string) error {
 	xmlOutput := []byte(xml.Header)
 
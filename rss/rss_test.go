@@ -63,7 +63,7 @@ func TestRSS_AddItem(t *testing.T) {
 	assert.Equal(t, "Test Item", item.Title)
 	assert.Equal(t, "https://example.com/item", item.Link)
 	assert.Equal(t, "test-guid", item.Guid)
-	assert.Equal(t, "Test description", item.Description)
+	assert.Equal(t, CDATA("Test description"), item.Description)
 	assert.Equal(t, "https://example.com/audio.mp3", item.Enclosure.URL)
 	assert.Equal(t, "12345", item.Enclosure.Length)
 	assert.Equal(t, "audio/mpeg", item.Enclosure.Type)
@@ -148,6 +148,121 @@ func TestRSS_WriteToFile(t *testing.T) {
 	assert.Contains(t, contentStr, "Test description")
 }
 
+func TestRSS_WriteToFile_ShowNotes(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "rss.xml")
+
+	rss := NewRSS(&config.Podcast{
+		ChannelTitle: "Test Podcast",
+		Author:       "Test Author",
+		PublishURL:   "https://podcast.example.com",
+		ImageURL:     "https://podcast.example.com/artwork.png",
+		OwnerEmail:   "owner@example.com",
+		Language:     "en",
+		Category:     "News",
+		Explicit:     true,
+	})
+	notes := `<p>Notes</p><ul><li><a href="https://example.com/a">A &amp; B</a></li></ul>`
+	rss.AddItem(RSSItem{
+		Title:       "Second",
+		PubDate:     "Tue, 02 Jan 2024 00:00:00 UTC",
+		Description: notes,
+		Content:     notes,
+		Duration:    "00:12:34",
+	})
+	rss.AddItem(RSSItem{
+		Title:   "First",
+		PubDate: "Mon, 01 Jan 2024 00:00:00 UTC",
+	})
+	rss.NumberEpisodes()
+	require.NoError(t, rss.WriteToFile(filePath))
+
+	content, err := os.ReadFile(filePath)
+	require.NoError(t, err)
+	contentStr := string(content)
+
+	assert.Contains(t, contentStr, `xmlns:content="http://purl.org/rss/1.0/modules/content/"`)
+	assert.Contains(t, contentStr, `xmlns:podcast="https://podcastindex.org/namespace/1.0"`)
+	assert.Contains(t, contentStr, "<podcast:guid>"+PodcastGuid("https://podcast.example.com/rss.xml")+"</podcast:guid>")
+	assert.Contains(t, contentStr, "<language>en</language>")
+	assert.Contains(t, contentStr, `<itunes:category text="News">`)
+	assert.Contains(t, contentStr, "<itunes:explicit>yes</itunes:explicit>")
+	assert.Contains(t, contentStr, "<itunes:email>owner@example.com</itunes:email>")
+	assert.Contains(t, contentStr, `<itunes:image href="https://podcast.example.com/artwork.png">`)
+	assert.Contains(t, contentStr, "<description><![CDATA["+notes+"]]></description>")
+	assert.Contains(t, contentStr, "<content:encoded><![CDATA["+notes+"]]></content:encoded>")
+	assert.Contains(t, contentStr, "<itunes:duration>00:12:34</itunes:duration>")
+	// Episodes are numbered chronologically.
+	assert.Equal(t, 2, rss.Channel.Items[0].ItunesEpisode)
+	assert.Equal(t, 1, rss.Channel.Items[1].ItunesEpisode)
+}
+
+func TestPodcastGuid(t *testing.T) {
+	// Example from the podcast namespace specification.
+	assert.Equal(t, "917393e3-1b1e-5cef-ace4-edaa54e1f810", PodcastGuid("https://mp3s.nashownotes.com/pc20rss.xml"))
+	assert.Equal(t, PodcastGuid("https://mp3s.nashownotes.com/pc20rss.xml/"), PodcastGuid("http://mp3s.nashownotes.com/pc20rss.xml"))
+}
+
+func TestDiffItems(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "rss.xml")
+
+	old := NewRSS(&config.Podcast{})
+	old.AddItem(RSSItem{Title: "Kept", Guid: "a", AudioURL: "https://example.com/a.mp3"})
+	old.AddItem(RSSItem{Title: "Old title", Guid: "b", AudioURL: "https://example.com/b.mp3"})
+	old.AddItem(RSSItem{Title: "Removed", Guid: "c", AudioURL: "https://example.com/c.mp3"})
+	require.NoError(t, old.WriteToFile(filePath))
+
+	data, err := os.ReadFile(filePath)
+	require.NoError(t, err)
+	parsed, err := Parse(data)
+	require.NoError(t, err)
+	require.Len(t, parsed.Channel.Items, 3)
+
+	new := NewRSS(&config.Podcast{})
+	new.AddItem(RSSItem{Title: "Kept", Guid: "a", AudioURL: "https://example.com/a.mp3"})
+	new.AddItem(RSSItem{Title: "New title", Guid: "b", AudioURL: "https://example.com/b.mp3"})
+	new.AddItem(RSSItem{Title: "Added", Guid: "d", AudioURL: "https://example.com/d.mp3"})
+
+	diff := DiffItems(parsed, new)
+	require.Len(t, diff.Added, 1)
+	assert.Equal(t, "d", diff.Added[0].Guid)
+	require.Len(t, diff.Removed, 1)
+	assert.Equal(t, "c", diff.Removed[0].Guid)
+	require.Len(t, diff.Changed, 1)
+	assert.Equal(t, "New title", diff.Changed[0].Title)
+
+	assert.Len(t, DiffItems(nil, new).Added, 3)
+	assert.True(t, DiffItems(new, new).Empty())
+}
+
+func TestRSS_MergeRetain(t *testing.T) {
+	prev := NewRSS(&config.Podcast{})
+	prev.AddItem(RSSItem{Title: "Day 1", Guid: "1", PubDate: "Mon, 01 Jan 2024 00:00:00 UTC", Episode: 1})
+	prev.AddItem(RSSItem{Title: "Day 2", Guid: "2", PubDate: "Tue, 02 Jan 2024 00:00:00 UTC", Episode: 2})
+
+	r := NewRSS(&config.Podcast{})
+	r.AddItem(RSSItem{Title: "Day 2 (again)", Guid: "2", PubDate: "Tue, 02 Jan 2024 00:00:00 UTC"})
+	r.AddItem(RSSItem{Title: "Day 3", Guid: "3", PubDate: "Wed, 03 Jan 2024 00:00:00 UTC"})
+	r.Merge(prev)
+	r.NumberEpisodes()
+
+	require.Len(t, r.Channel.Items, 3)
+	assert.Equal(t, "Day 3", r.Channel.Items[0].Title)
+	assert.Equal(t, 3, r.Channel.Items[0].ItunesEpisode)
+	assert.Equal(t, "Day 2 (again)", r.Channel.Items[1].Title)
+	assert.Equal(t, 2, r.Channel.Items[1].ItunesEpisode)
+	assert.Equal(t, 1, r.Channel.Items[2].ItunesEpisode)
+
+	now := time.Date(2024, 1, 3, 12, 0, 0, 0, time.UTC)
+	removed := r.Retain(48*time.Hour, 0, now)
+	require.Len(t, removed, 1)
+	assert.Equal(t, "Day 1", removed[0].Title)
+
+	removed = r.Retain(0, 1, now)
+	require.Len(t, removed, 1)
+	assert.Equal(t, "Day 2 (again)", removed[0].Title)
+	assert.Len(t, r.Channel.Items, 1)
+}
+
 func TestRSS_WriteToFile_InvalidPath(t *testing.T) {
 	podcastConfig := &config.Podcast{}
 	rss := NewRSS(podcastConfig)
@@ -0,0 +1,225 @@
+// Package rules evaluates the configured rules on incoming feed items to skip them or to
+// change how they are summarized and stored.
+package rules
+
+import (
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/mmcdole/gofeed"
+	"github.com/mopemope/quicknews/config"
+	"github.com/mopemope/quicknews/ent"
+)
+
+// Actions of a rule.
+const (
+	ActionSkip     = "skip"      // Do not save nor summarize the item
+	ActionMarkRead = "mark-read" // Save the summary as read
+	ActionBookmark = "bookmark"  // Save the item in the bookmark feed
+	ActionTag      = "tag"       // Add the tags of the rule to the summary
+	ActionPriority = "priority"  // Raise the priority of the summary
+	ActionPrompt   = "prompt"    // Summarize with the prompt of the rule
+)
+
+var actions = []string{ActionSkip, ActionMarkRead, ActionBookmark, ActionTag, ActionPriority, ActionPrompt}
+
+// Item is the part of a feed item rules match on.
+type Item struct {
+	FeedTitle   string
+	FeedURL     string
+	Title       string
+	Description string
+	Author      string
+	Categories  []string
+}
+
+// NewItem returns the item of a feed entry.
+func NewItem(feed *ent.Feed, item *gofeed.Item) *Item {
+	i := &Item{
+		Title:       item.Title,
+		Description: item.Description,
+		Categories:  item.Categories,
+	}
+	if feed != nil {
+		i.FeedTitle, i.FeedURL = feed.Title, feed.URL
+	}
+	var authors []string
+	for _, a := range item.Authors {
+		if a != nil && a.Name != "" {
+			authors = append(authors, a.Name)
+		}
+	}
+	if len(authors) == 0 && item.Author != nil {
+		authors = append(authors, item.Author.Name)
+	}
+	i.Author = strings.Join(authors, ", ")
+	return i
+}
+
+// Decision is the combined outcome of the rules matching an item.
+type Decision struct {
+	Matched  []string // Names of the matching rules
+	Skip     bool
+	MarkRead bool
+	Bookmark bool
+	Tags     []string
+	Priority int
+	Prompt   string // Empty for the default prompt
+}
+
+// rule is a configured rule with its compiled expressions.
+type rule struct {
+	config      *config.Rule
+	title       *regexp.Regexp
+	description *regexp.Regexp
+	author      *regexp.Regexp
+}
+
+// Engine evaluates rules in their configured order.
+type Engine struct {
+	rules []*rule
+	err   error
+}
+
+// New compiles the rules. An invalid rule is reported by every call to Evaluate, so that
+// items are not summarized against the intent of the rules.
+func New(configs []*config.Rule) *Engine {
+	e := &Engine{}
+	for _, c := range configs {
+		r, err := compile(c)
+		if err != nil {
+			e.err = errors.CombineErrors(e.err, err)
+			continue
+		}
+		e.rules = append(e.rules, r)
+	}
+	return e
+}
+
+// Err returns the error of the invalid rules, if any.
+func (e *Engine) Err() error {
+	return e.err
+}
+
+func compile(c *config.Rule) (*rule, error) {
+	r := &rule{config: c}
+	for _, a := range c.Actions {
+		if !slices.Contains(actions, a) {
+			return nil, errors.Newf("rule %s: unknown action %q", c.Name, a)
+		}
+	}
+	if slices.Contains(c.Actions, ActionPrompt) && c.Prompt == "" {
+		return nil, errors.Newf("rule %s: prompt action without a prompt", c.Name)
+	}
+	for _, p := range []struct {
+		expr string
+		re   **regexp.Regexp
+	}{
+		{c.Title, &r.title},
+		{c.Description, &r.description},
+		{c.Author, &r.author},
+	} {
+		if p.expr == "" {
+			continue
+		}
+		re, err := regexp.Compile(p.expr)
+		if err != nil {
+			return nil, errors.Wrapf(err, "rule %s: invalid expression", c.Name)
+		}
+		*p.re = re
+	}
+	return r, nil
+}
+
+func (r *rule) match(item *Item) bool {
+	c := r.config
+	if c.Feed != "" && c.Feed != item.FeedTitle && c.Feed != item.FeedURL {
+		return false
+	}
+	if r.title != nil && !r.title.MatchString(item.Title) {
+		return false
+	}
+	if r.description != nil && !r.description.MatchString(item.Description) {
+		return false
+	}
+	if r.author != nil && !r.author.MatchString(item.Author) {
+		return false
+	}
+	if len(c.Keywords) > 0 {
+		text := strings.ToLower(item.Title + "\n" + item.Description + "\n" + strings.Join(item.Categories, "\n"))
+		if !slices.ContainsFunc(c.Keywords, func(k string) bool {
+			return strings.Contains(text, strings.ToLower(k))
+		}) {
+			return false
+		}
+	}
+	return true
+}
+
+// Evaluate applies the actions of every rule matching the item, in order. A skip action
+// ends the evaluation.
+func (e *Engine) Evaluate(item *Item) (*Decision, error) {
+	if e.err != nil {
+		return nil, e.err
+	}
+	d := &Decision{}
+	for _, r := range e.rules {
+		if !r.match(item) {
+			continue
+		}
+		d.Matched = append(d.Matched, r.config.Name)
+		for _, a := range r.config.Actions {
+			switch a {
+			case ActionSkip:
+				d.Skip = true
+			case ActionMarkRead:
+				d.MarkRead = true
+			case ActionBookmark:
+				d.Bookmark = true
+			case ActionTag:
+				for _, t := range r.config.Tags {
+					if !slices.Contains(d.Tags, t) {
+						d.Tags = append(d.Tags, t)
+					}
+				}
+			case ActionPriority:
+				d.Priority = max(d.Priority, r.config.Priority)
+			case ActionPrompt:
+				if d.Prompt == "" {
+					d.Prompt = r.config.Prompt
+				}
+			}
+		}
+		if d.Skip {
+			break
+		}
+	}
+	return d, nil
+}
+
+// Actions returns the actions of the decision, e.g. "tag:go,release" or "priority:2".
+func (d *Decision) Actions() []string {
+	var s []string
+	if d.Skip {
+		s = append(s, ActionSkip)
+	}
+	if d.MarkRead {
+		s = append(s, ActionMarkRead)
+	}
+	if d.Bookmark {
+		s = append(s, ActionBookmark)
+	}
+	if len(d.Tags) > 0 {
+		s = append(s, ActionTag+":"+strings.Join(d.Tags, ","))
+	}
+	if d.Priority > 0 {
+		s = append(s, ActionPriority+":"+strconv.Itoa(d.Priority))
+	}
+	if d.Prompt != "" {
+		s = append(s, ActionPrompt)
+	}
+	return s
+}
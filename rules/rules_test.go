@@ -0,0 +1,57 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/mmcdole/gofeed"
+	"github.com/mopemope/quicknews/config"
+	"github.com/mopemope/quicknews/ent"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEngine_Evaluate(t *testing.T) {
+	engine := New([]*config.Rule{
+		{Name: "ads", Feed: "Hacker News", Title: "(?i)sponsored", Actions: []string{ActionSkip}},
+		{Name: "go", Keywords: []string{"Golang"}, Actions: []string{ActionTag, ActionPriority}, Tags: []string{"go"}, Priority: 2},
+		{Name: "alice", Author: "^Alice", Actions: []string{ActionBookmark, ActionPrompt}, Prompt: "Summarize %s briefly"},
+		{Name: "all", Actions: []string{ActionTag}, Tags: []string{"go", "news"}},
+	})
+	require.NoError(t, engine.Err())
+
+	hn := &ent.Feed{Title: "Hacker News", URL: "https://news.ycombinator.com/rss"}
+
+	d, err := engine.Evaluate(NewItem(hn, &gofeed.Item{Title: "Sponsored: buy now", Description: "golang"}))
+	require.NoError(t, err)
+	assert.True(t, d.Skip)
+	assert.Equal(t, []string{"ads"}, d.Matched, "a skip ends the evaluation")
+
+	d, err = engine.Evaluate(NewItem(&ent.Feed{Title: "Other"}, &gofeed.Item{Title: "Sponsored", Categories: []string{"golang"}}))
+	require.NoError(t, err)
+	assert.False(t, d.Skip)
+	assert.Equal(t, []string{"go", "all"}, d.Matched)
+	assert.Equal(t, []string{"go", "news"}, d.Tags)
+	assert.Equal(t, 2, d.Priority)
+	assert.Equal(t, []string{"tag:go,news", "priority:2"}, d.Actions())
+
+	d, err = engine.Evaluate(NewItem(hn, &gofeed.Item{Title: "Post", Authors: []*gofeed.Person{{Name: "Alice"}}}))
+	require.NoError(t, err)
+	assert.True(t, d.Bookmark)
+	assert.Equal(t, "Summarize %s briefly", d.Prompt)
+	assert.Equal(t, []string{"alice", "all"}, d.Matched)
+}
+
+func TestEngine_InvalidRules(t *testing.T) {
+	for _, r := range []*config.Rule{
+		{Name: "regexp", Title: "(", Actions: []string{ActionSkip}},
+		{Name: "action", Actions: []string{"delete"}},
+		{Name: "prompt", Actions: []string{ActionPrompt}},
+	} {
+		engine := New([]*config.Rule{r})
+		require.Error(t, engine.Err(), r.Name)
+		assert.Contains(t, engine.Err().Error(), r.Name)
+
+		_, err := engine.Evaluate(&Item{Title: "x"})
+		assert.Error(t, err, r.Name)
+	}
+}
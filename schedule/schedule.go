@@ -0,0 +1,296 @@
+// Package schedule computes when a feed is due to be polled again, from how often it
+// posts and from the hints of its publisher.
+package schedule
+
+import (
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mmcdole/gofeed"
+	"github.com/mmcdole/gofeed/atom"
+	"github.com/mmcdole/gofeed/json"
+	"github.com/mmcdole/gofeed/rss"
+	"github.com/mopemope/quicknews/config"
+	"github.com/mopemope/quicknews/ent"
+)
+
+// Bounds of the interval when neither the configuration nor the feed sets them.
+const (
+	DefaultMinInterval = 15 * time.Minute
+	DefaultMaxInterval = 24 * time.Hour
+)
+
+// ttlKey is the key of the RSS ttl in the Custom map of a parsed feed.
+const ttlKey = "ttl"
+
+// HubKey is the key of the WebSub hub in the Custom map of a parsed feed.
+const HubKey = "hub"
+
+// recentItems is the number of latest items the posting frequency is measured on.
+const recentItems = 10
+
+// Hints is what is known about a feed after polling it.
+type Hints struct {
+	Published    []time.Time   // Publication times of the items
+	TTL          time.Duration // RSS ttl
+	UpdatePeriod time.Duration // sy:updatePeriod divided by sy:updateFrequency
+	MaxAge       time.Duration // Cache-Control max-age of the response
+	RetryAfter   time.Duration // Retry-After of the response
+	Failures     int           // Failed polls in a row
+}
+
+// NewHints collects the hints of a poll. parsed is nil and failures positive when the poll
+// failed; header is nil when no response was received.
+func NewHints(parsed *gofeed.Feed, header http.Header, failures int, now time.Time) *Hints {
+	h := &Hints{Failures: failures}
+	if parsed != nil {
+		for _, item := range parsed.Items {
+			switch {
+			case item.PublishedParsed != nil:
+				h.Published = append(h.Published, *item.PublishedParsed)
+			case item.UpdatedParsed != nil:
+				h.Published = append(h.Published, *item.UpdatedParsed)
+			}
+		}
+		if minutes, err := strconv.Atoi(strings.TrimSpace(parsed.Custom[ttlKey])); err == nil && minutes > 0 {
+			h.TTL = time.Duration(minutes) * time.Minute
+		}
+		h.UpdatePeriod = updatePeriod(parsed)
+	}
+	if header != nil {
+		h.MaxAge = maxAge(header.Get("Cache-Control"))
+		h.RetryAfter = retryAfter(header.Get("Retry-After"), now)
+	}
+	return h
+}
+
+// updatePeriod returns the interval declared by the syndication module.
+func updatePeriod(parsed *gofeed.Feed) time.Duration {
+	sy, ok := parsed.Extensions["sy"]
+	if !ok {
+		return 0
+	}
+	value := func(name string) string {
+		if e := sy[name]; len(e) > 0 {
+			return strings.TrimSpace(e[0].Value)
+		}
+		return ""
+	}
+	var period time.Duration
+	switch strings.ToLower(value("updatePeriod")) {
+	case "hourly":
+		period = time.Hour
+	case "daily":
+		period = 24 * time.Hour
+	case "weekly":
+		period = 7 * 24 * time.Hour
+	case "monthly":
+		period = 30 * 24 * time.Hour
+	case "yearly":
+		period = 365 * 24 * time.Hour
+	default:
+		return 0
+	}
+	if frequency, err := strconv.Atoi(value("updateFrequency")); err == nil && frequency > 0 {
+		period /= time.Duration(frequency)
+	}
+	return period
+}
+
+func maxAge(cacheControl string) time.Duration {
+	for _, directive := range strings.Split(cacheControl, ",") {
+		name, value, _ := strings.Cut(strings.TrimSpace(directive), "=")
+		if strings.EqualFold(name, "max-age") {
+			if seconds, err := strconv.Atoi(strings.Trim(value, `"`)); err == nil && seconds > 0 {
+				return time.Duration(seconds) * time.Second
+			}
+		}
+	}
+	return 0
+}
+
+// retryAfter parses a Retry-After value in seconds or as an HTTP date.
+func retryAfter(value string, now time.Time) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	if at, err := http.ParseTime(value); err == nil && at.After(now) {
+		return at.Sub(now)
+	}
+	return 0
+}
+
+// Policy bounds the polling interval of a feed.
+type Policy struct {
+	Min time.Duration
+	Max time.Duration
+}
+
+// NewPolicy returns the bounds of a feed: its own when set, otherwise the configured ones.
+// config may be nil.
+func NewPolicy(config *config.Polling, feed *ent.Feed) Policy {
+	p := Policy{Min: DefaultMinInterval, Max: DefaultMaxInterval}
+	if config != nil {
+		if config.MinInterval > 0 {
+			p.Min = config.MinInterval
+		}
+		if config.MaxInterval > 0 {
+			p.Max = config.MaxInterval
+		}
+	}
+	if feed != nil {
+		if feed.MinInterval > 0 {
+			p.Min = time.Duration(feed.MinInterval) * time.Minute
+		}
+		if feed.MaxInterval > 0 {
+			p.Max = time.Duration(feed.MaxInterval) * time.Minute
+		}
+	}
+	p.Max = max(p.Max, p.Min)
+	return p
+}
+
+// Interval returns how long to wait before polling the feed again.
+//
+// A feed is polled about twice per posting interval, measured on its latest items, and
+// less often the longer it has not posted. The ttl, sy:updatePeriod and Cache-Control
+// hints of the publisher are lower bounds. Failed polls back off exponentially from the
+// minimum interval. A Retry-After is always honored, even above the maximum.
+func (p Policy) Interval(h *Hints, now time.Time) time.Duration {
+	var interval time.Duration
+	if h.Failures > 0 {
+		interval = p.Max
+		if h.Failures < 32 {
+			interval = min(p.Min<<(h.Failures-1), p.Max)
+		}
+	} else {
+		interval = max(observed(h.Published, now)/2, h.TTL, h.UpdatePeriod, h.MaxAge)
+		interval = min(max(interval, p.Min), p.Max)
+	}
+	return max(interval, h.RetryAfter)
+}
+
+// observed returns the average interval between the latest items, or the time since the
+// newest item when it is longer. 0 when fewer than two items are dated.
+func observed(published []time.Time, now time.Time) time.Duration {
+	if len(published) < 2 {
+		return 0
+	}
+	times := slices.Clone(published)
+	slices.SortFunc(times, func(a, b time.Time) int { return b.Compare(a) })
+	times = times[:min(len(times), recentItems)]
+
+	newest, oldest := times[0], times[len(times)-1]
+	average := newest.Sub(oldest) / time.Duration(len(times)-1)
+	return max(average, now.Sub(newest))
+}
+
+// Next returns the time the feed is due again.
+func (p Policy) Next(h *Hints, now time.Time) time.Time {
+	return now.Add(p.Interval(h, now))
+}
+
+// Due reports whether the feed is due to be polled.
+func Due(feed *ent.Feed, now time.Time) bool {
+	return feed.NextPollAt == nil || !feed.NextPollAt.After(now)
+}
+
+// NewParser returns a feed parser that keeps the RSS ttl of the feeds it parses for
+// NewHints, and the WebSub hub they advertise in Custom[HubKey]. It also reads the length
+// of JSON Feed attachments from their size in bytes, as the enclosures of the other
+// formats, instead of their duration.
+func NewParser() *gofeed.Parser {
+	parser := gofeed.NewParser()
+	parser.RSSTranslator = &rssTranslator{}
+	parser.AtomTranslator = &atomTranslator{}
+	parser.JSONTranslator = &attachmentTranslator{}
+	return parser
+}
+
+type rssTranslator struct {
+	gofeed.DefaultRSSTranslator
+}
+
+func (t *rssTranslator) Translate(feed interface{}) (*gofeed.Feed, error) {
+	result, err := t.DefaultRSSTranslator.Translate(feed)
+	if err != nil {
+		return nil, err
+	}
+	rssFeed, ok := feed.(*rss.Feed)
+	if !ok {
+		return result, nil
+	}
+	setCustom(result, ttlKey, rssFeed.TTL)
+	for _, prefix := range []string{"atom", "atom10", "atom03"} {
+		for _, link := range rssFeed.Extensions[prefix]["link"] {
+			if link.Attrs["rel"] == "hub" && result.Custom[HubKey] == "" {
+				setCustom(result, HubKey, link.Attrs["href"])
+			}
+		}
+	}
+	return result, nil
+}
+
+type atomTranslator struct {
+	gofeed.DefaultAtomTranslator
+}
+
+func (t *atomTranslator) Translate(feed interface{}) (*gofeed.Feed, error) {
+	result, err := t.DefaultAtomTranslator.Translate(feed)
+	if err != nil {
+		return nil, err
+	}
+	if atomFeed, ok := feed.(*atom.Feed); ok {
+		for _, link := range atomFeed.Links {
+			if link.Rel == "hub" {
+				setCustom(result, HubKey, link.Href)
+				break
+			}
+		}
+	}
+	return result, nil
+}
+
+func setCustom(feed *gofeed.Feed, key, value string) {
+	if value == "" {
+		return
+	}
+	if feed.Custom == nil {
+		feed.Custom = map[string]string{}
+	}
+	feed.Custom[key] = value
+}
+
+type attachmentTranslator struct {
+	gofeed.DefaultJSONTranslator
+}
+
+func (t *attachmentTranslator) Translate(feed interface{}) (*gofeed.Feed, error) {
+	result, err := t.DefaultJSONTranslator.Translate(feed)
+	if err != nil {
+		return nil, err
+	}
+	jsonFeed, ok := feed.(*json.Feed)
+	if !ok || len(jsonFeed.Items) != len(result.Items) {
+		return result, nil
+	}
+	for i, item := range jsonFeed.Items {
+		if item.Attachments == nil || len(*item.Attachments) != len(result.Items[i].Enclosures) {
+			continue
+		}
+		for j, attachment := range *item.Attachments {
+			length := ""
+			if attachment.SizeInBytes > 0 {
+				length = strconv.FormatInt(attachment.SizeInBytes, 10)
+			}
+			result.Items[i].Enclosures[j].Length = length
+		}
+	}
+	return result, nil
+}
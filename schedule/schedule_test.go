@@ -0,0 +1,119 @@
+package schedule
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mopemope/quicknews/config"
+	"github.com/mopemope/quicknews/ent"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var now = time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+func hourly(n int) []time.Time {
+	times := make([]time.Time, n)
+	for i := range times {
+		times[i] = now.Add(-time.Duration(i) * time.Hour)
+	}
+	return times
+}
+
+func TestPolicy_Interval(t *testing.T) {
+	p := Policy{Min: 15 * time.Minute, Max: 24 * time.Hour}
+
+	tests := []struct {
+		name  string
+		hints *Hints
+		want  time.Duration
+	}{
+		{"hourly posts", &Hints{Published: hourly(10)}, 30 * time.Minute},
+		{"no dated items", &Hints{}, 15 * time.Minute},
+		{"dormant feed", &Hints{Published: []time.Time{now.Add(-30 * 24 * time.Hour), now.Add(-31 * 24 * time.Hour)}}, 24 * time.Hour},
+		{"ttl", &Hints{Published: hourly(10), TTL: 2 * time.Hour}, 2 * time.Hour},
+		{"update period", &Hints{Published: hourly(10), UpdatePeriod: 6 * time.Hour}, 6 * time.Hour},
+		{"cache max-age", &Hints{Published: hourly(10), MaxAge: 45 * time.Minute}, 45 * time.Minute},
+		{"first failure", &Hints{Failures: 1}, 15 * time.Minute},
+		{"backoff", &Hints{Failures: 3}, time.Hour},
+		{"backoff capped", &Hints{Failures: 100}, 24 * time.Hour},
+		{"retry after above max", &Hints{Failures: 1, RetryAfter: 48 * time.Hour}, 48 * time.Hour},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, p.Interval(tt.hints, now))
+		})
+	}
+}
+
+func TestNewPolicy(t *testing.T) {
+	p := NewPolicy(nil, nil)
+	assert.Equal(t, Policy{Min: DefaultMinInterval, Max: DefaultMaxInterval}, p)
+
+	p = NewPolicy(&config.Polling{MinInterval: time.Hour, MaxInterval: 12 * time.Hour}, &ent.Feed{MaxInterval: 7 * 24 * 60})
+	assert.Equal(t, Policy{Min: time.Hour, Max: 7 * 24 * time.Hour}, p)
+
+	p = NewPolicy(nil, &ent.Feed{MinInterval: 48 * 60})
+	assert.Equal(t, 48*time.Hour, p.Max, "max is never below min")
+}
+
+func TestNewHints(t *testing.T) {
+	const body = `<?xml version="1.0"?>
+<rss version="2.0" xmlns:sy="http://purl.org/rss/1.0/modules/syndication/">
+<channel>
+  <title>Example</title>
+  <ttl>90</ttl>
+  <sy:updatePeriod>daily</sy:updatePeriod>
+  <sy:updateFrequency>4</sy:updateFrequency>
+  <item><title>a</title><pubDate>Sun, 01 Jun 2025 10:00:00 GMT</pubDate></item>
+  <item><title>b</title><pubDate>Sun, 01 Jun 2025 08:00:00 GMT</pubDate></item>
+</channel>
+</rss>`
+	parsed, err := NewParser().Parse(strings.NewReader(body))
+	require.NoError(t, err)
+
+	header := http.Header{}
+	header.Set("Cache-Control", "public, max-age=600")
+	header.Set("Retry-After", now.Add(time.Hour).Format(http.TimeFormat))
+
+	h := NewHints(parsed, header, 0, now)
+	assert.Len(t, h.Published, 2)
+	assert.Equal(t, 90*time.Minute, h.TTL)
+	assert.Equal(t, 6*time.Hour, h.UpdatePeriod)
+	assert.Equal(t, 10*time.Minute, h.MaxAge)
+	assert.Equal(t, time.Hour, h.RetryAfter)
+
+	header.Set("Retry-After", "120")
+	assert.Equal(t, 2*time.Minute, NewHints(nil, header, 1, now).RetryAfter)
+}
+
+func TestNewParser_JSONFeedAttachments(t *testing.T) {
+	const body = `{
+  "version": "https://jsonfeed.org/version/1.1",
+  "title": "Podcast",
+  "items": [{
+    "id": "1",
+    "url": "https://example.com/1",
+    "title": "Episode 1",
+    "attachments": [
+      {"url": "https://example.com/1.mp3", "mime_type": "audio/mpeg", "size_in_bytes": 2048, "duration_in_seconds": 60},
+      {"url": "https://example.com/1.m4a", "mime_type": "audio/mp4", "duration_in_seconds": 60}
+    ]
+  }]
+}`
+	parsed, err := NewParser().Parse(strings.NewReader(body))
+	require.NoError(t, err)
+	require.Len(t, parsed.Items, 1)
+	require.Len(t, parsed.Items[0].Enclosures, 2)
+	assert.Equal(t, "2048", parsed.Items[0].Enclosures[0].Length)
+	assert.Equal(t, "", parsed.Items[0].Enclosures[1].Length)
+}
+
+func TestDue(t *testing.T) {
+	later := now.Add(time.Minute)
+	assert.True(t, Due(&ent.Feed{}, now))
+	assert.True(t, Due(&ent.Feed{NextPollAt: &now}, now))
+	assert.False(t, Due(&ent.Feed{NextPollAt: &later}, now))
+}
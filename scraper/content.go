@@ -0,0 +1,96 @@
+package scraper
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/gocolly/colly/v2"
+)
+
+// maxContentRunes bounds the text of a page sent for summarization.
+const maxContentRunes = 30000
+
+// Content is the readable text of a web page.
+type Content struct {
+	Title string
+	Text  string
+}
+
+// ExtractContent fetches a page and returns its title and the text of its main content:
+// the article or main element when there is one, otherwise the body, without scripts,
+// navigation, headers and footers.
+func ExtractContent(ctx context.Context, pageURL string, opts ...Option) (*Content, error) {
+	if _, err := url.ParseRequestURI(pageURL); err != nil {
+		return nil, fmt.Errorf("invalid URL %s: %w", pageURL, err)
+	}
+
+	c := newOptions(opts).collector()
+	c.SetRequestTimeout(time.Minute)
+	c.Context = ctx
+
+	content := &Content{}
+	var visitError error
+
+	c.OnHTML("html", func(e *colly.HTMLElement) {
+		content.Title = strings.TrimSpace(e.DOM.Find("head title").First().Text())
+		content.Text = mainText(e.DOM)
+	})
+
+	c.OnError(func(r *colly.Response, err error) {
+		visitError = fmt.Errorf("request to %s failed: status %d, error: %w", r.Request.URL, r.StatusCode, err)
+	})
+
+	if err := c.Visit(pageURL); err != nil {
+		if visitError != nil {
+			return nil, visitError
+		}
+		return nil, fmt.Errorf("failed to visit %s: %w", pageURL, err)
+	}
+	if visitError != nil {
+		return nil, visitError
+	}
+	if content.Text == "" {
+		return nil, fmt.Errorf("could not find any text on %s", pageURL)
+	}
+	return content, nil
+}
+
+func mainText(doc *goquery.Selection) string {
+	root := doc.Find("article").First()
+	if root.Length() == 0 {
+		root = doc.Find("main").First()
+	}
+	if root.Length() == 0 {
+		root = doc.Find("body")
+	}
+	root = root.Clone()
+	root.Find("script, style, noscript, nav, header, footer, aside, form").Remove()
+
+	var lines []string
+	root.Find("h1, h2, h3, h4, h5, h6, p, li, pre, blockquote, td").Each(func(_ int, s *goquery.Selection) {
+		// Nested blocks are collected on their own.
+		if s.Find("p, li, pre, blockquote").Length() > 0 {
+			return
+		}
+		if line := collapseSpaces(s.Text()); line != "" {
+			lines = append(lines, line)
+		}
+	})
+	text := strings.Join(lines, "\n")
+	if text == "" {
+		text = collapseSpaces(root.Text())
+	}
+
+	if runes := []rune(text); len(runes) > maxContentRunes {
+		text = string(runes[:maxContentRunes])
+	}
+	return text
+}
+
+func collapseSpaces(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
@@ -0,0 +1,70 @@
+package scraper
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mopemope/quicknews/config"
+	"github.com/mopemope/quicknews/httpclient"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtractContent(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "text/html")
+		_, _ = fmt.Fprint(w, `<html><head><title>Internal post</title><script>var x;</script></head>
+<body><nav><ul><li>Home</li></ul></nav>
+<article><h1>Release notes</h1><p>The new   version
+is out.</p><ul><li>Faster builds</li></ul></article>
+<footer><p>Copyright</p></footer></body></html>`)
+	}))
+	defer server.Close()
+
+	_, err := ExtractContent(context.Background(), server.URL)
+	assert.Error(t, err)
+
+	cfg := &config.Config{Feeds: []*config.FeedHTTP{{URL: server.URL, Token: "token"}}}
+	settings, err := httpclient.New(cfg, server.URL)
+	require.NoError(t, err)
+	content, err := ExtractContent(context.Background(), server.URL, WithHTTP(settings))
+	require.NoError(t, err)
+	assert.Equal(t, "Internal post", content.Title)
+	assert.Equal(t, "Release notes\nThe new version is out.\nFaster builds", content.Text)
+}
+
+func TestExtractContent_RobotsTxt(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/robots.txt" {
+			_, _ = fmt.Fprint(w, "User-agent: *\nDisallow: /private/\n")
+			return
+		}
+		w.Header().Set("Content-Type", "text/html")
+		_, _ = fmt.Fprint(w, `<html><head><title>Page</title></head><body><p>Text</p></body></html>`)
+	}))
+	defer server.Close()
+
+	cfg := &config.Config{Crawl: &config.Crawl{RequestsPerSecond: 100, Burst: 10}}
+	settings, err := httpclient.New(cfg, server.URL)
+	require.NoError(t, err)
+
+	_, err = ExtractContent(context.Background(), server.URL+"/private/page", WithHTTP(settings))
+	assert.Error(t, err)
+
+	content, err := ExtractContent(context.Background(), server.URL+"/public/page", WithHTTP(settings))
+	require.NoError(t, err)
+	assert.Equal(t, "Text", content.Text)
+
+	cfg.Crawl.IgnoreRobotsTxt = true
+	settings, err = httpclient.New(cfg, server.URL)
+	require.NoError(t, err)
+	_, err = ExtractContent(context.Background(), server.URL+"/private/page", WithHTTP(settings))
+	assert.NoError(t, err)
+}
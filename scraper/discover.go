@@ -0,0 +1,129 @@
+package scraper
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gocolly/colly/v2"
+	"github.com/mmcdole/gofeed"
+)
+
+// feedTypes are the MIME types of feeds advertised with <link rel="alternate">.
+var feedTypes = []string{
+	"application/rss+xml",
+	"application/atom+xml",
+	"application/feed+json",
+	"application/json",
+	"application/rdf+xml",
+	"application/xml",
+	"text/xml",
+}
+
+// commonFeedPaths are probed when a page does not advertise its feeds.
+var commonFeedPaths = []string{
+	"/feed",
+	"/rss.xml",
+	"/atom.xml",
+	"/feed.xml",
+	"/index.xml",
+	"/rss",
+	"/feed.json",
+}
+
+// Feed is a feed discovered from a web page.
+type Feed struct {
+	URL   string
+	Title string
+	Type  string // MIME type, empty for feeds found by probing
+}
+
+// DiscoverFeeds finds the feeds of a web page: the RSS, Atom and JSON feeds it links with
+// <link rel="alternate">, or, when it has none, the feeds found at common paths of the
+// site.
+func DiscoverFeeds(ctx context.Context, pageURL string, opts ...Option) ([]*Feed, error) {
+	if _, err := url.ParseRequestURI(pageURL); err != nil {
+		return nil, fmt.Errorf("invalid URL %s: %w", pageURL, err)
+	}
+
+	o := newOptions(opts)
+	c := o.collector()
+	c.SetRequestTimeout(30 * time.Second)
+
+	var feeds []*Feed
+	var visitError error
+	var base *url.URL
+
+	c.OnResponse(func(r *colly.Response) {
+		// The URL after redirects
+		base = r.Request.URL
+	})
+
+	c.OnHTML(`link[rel~="alternate"][href]`, func(e *colly.HTMLElement) {
+		mediaType := strings.ToLower(strings.TrimSpace(strings.Split(e.Attr("type"), ";")[0]))
+		if !slices.Contains(feedTypes, mediaType) {
+			return
+		}
+		link := e.Request.AbsoluteURL(e.Attr("href"))
+		if link == "" || slices.ContainsFunc(feeds, func(f *Feed) bool { return f.URL == link }) {
+			return
+		}
+		feeds = append(feeds, &Feed{URL: link, Title: strings.TrimSpace(e.Attr("title")), Type: mediaType})
+	})
+
+	c.OnError(func(r *colly.Response, err error) {
+		visitError = fmt.Errorf("request to %s failed: status %d, error: %w", r.Request.URL, r.StatusCode, err)
+	})
+
+	if err := c.Visit(pageURL); err != nil {
+		if visitError != nil {
+			return nil, visitError
+		}
+		return nil, fmt.Errorf("failed to visit %s: %w", pageURL, err)
+	}
+	if visitError != nil {
+		return nil, visitError
+	}
+	if len(feeds) > 0 {
+		return feeds, nil
+	}
+
+	if base == nil {
+		base, _ = url.Parse(pageURL)
+	}
+	return probeFeeds(ctx, o, base), nil
+}
+
+// probeFeeds returns the feeds found at the common paths of the site of the page.
+func probeFeeds(ctx context.Context, o *options, page *url.URL) []*Feed {
+	parser := gofeed.NewParser()
+	parser.Client = o.client()
+	var feeds []*Feed
+	seen := make(map[string]bool)
+	for _, p := range commonFeedPaths {
+		candidate := (&url.URL{Scheme: page.Scheme, Host: page.Host, Path: p}).String()
+		reqCtx, cancel := context.WithTimeout(ctx, 15*time.Second)
+		parsed, err := parser.ParseURLWithContext(candidate, reqCtx)
+		cancel()
+		if err != nil {
+			continue
+		}
+		// /feed and /rss are often the same feed.
+		key := parsed.FeedLink
+		if key == "" {
+			key = parsed.Title
+			if len(parsed.Items) > 0 {
+				key += "\n" + parsed.Items[0].Link
+			}
+		}
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		feeds = append(feeds, &Feed{URL: candidate, Title: parsed.Title})
+	}
+	return feeds
+}
@@ -0,0 +1,64 @@
+package scraper
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testRSS = `<?xml version="1.0"?>
+<rss version="2.0"><channel><title>Example Blog</title><link>https://example.com</link>
+<item><title>Post</title><link>https://example.com/post</link></item>
+</channel></rss>`
+
+func TestDiscoverFeeds_AlternateLinks(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		_, _ = fmt.Fprint(w, `<html><head><title>Blog</title>
+<link rel="alternate" type="application/rss+xml" title="RSS" href="/rss.xml">
+<link rel="alternate" type="application/atom+xml; charset=utf-8" title="Atom" href="https://feeds.example.com/atom">
+<link rel="alternate" type="application/rss+xml" href="/rss.xml">
+<link rel="alternate" hreflang="en" href="/en/">
+<link rel="stylesheet" type="text/css" href="/style.css">
+</head><body></body></html>`)
+	}))
+	defer server.Close()
+
+	feeds, err := DiscoverFeeds(context.Background(), server.URL+"/blog/")
+	require.NoError(t, err)
+	require.Len(t, feeds, 2)
+	assert.Equal(t, &Feed{URL: server.URL + "/rss.xml", Title: "RSS", Type: "application/rss+xml"}, feeds[0])
+	assert.Equal(t, &Feed{URL: "https://feeds.example.com/atom", Title: "Atom", Type: "application/atom+xml"}, feeds[1])
+}
+
+func TestDiscoverFeeds_CommonPaths(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/feed", "/rss.xml":
+			w.Header().Set("Content-Type", "application/rss+xml")
+			_, _ = fmt.Fprint(w, testRSS)
+		case "/":
+			w.Header().Set("Content-Type", "text/html")
+			_, _ = fmt.Fprint(w, `<html><head><title>Blog</title></head><body></body></html>`)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	feeds, err := DiscoverFeeds(context.Background(), server.URL+"/")
+	require.NoError(t, err)
+	require.Len(t, feeds, 1, "the same feed at two paths is found once")
+	assert.Equal(t, server.URL+"/feed", feeds[0].URL)
+	assert.Equal(t, "Example Blog", feeds[0].Title)
+}
+
+func TestDiscoverFeeds_InvalidURL(t *testing.T) {
+	_, err := DiscoverFeeds(context.Background(), "not-a-url")
+	assert.Error(t, err)
+}
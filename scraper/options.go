@@ -0,0 +1,57 @@
+package scraper
+
+import (
+	"net/http"
+
+	"github.com/gocolly/colly/v2"
+	"github.com/mopemope/quicknews/httpclient"
+)
+
+type options struct {
+	transport http.RoundTripper
+	robotsTxt bool
+	userAgent string
+}
+
+// Option configures the requests of the scraper.
+type Option func(*options)
+
+// WithHTTP sends the requests with the credentials, headers, proxy and rate limit of the
+// settings, and only visits the pages robots.txt allows when the settings require it.
+func WithHTTP(s *httpclient.Settings) Option {
+	return func(o *options) {
+		o.transport = s.Transport()
+		o.robotsTxt = s.RespectRobotsTxt()
+		o.userAgent = s.UserAgent()
+	}
+}
+
+func newOptions(opts []Option) *options {
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// collector returns a collector using the transport of the options.
+func (o *options) collector(collectorOpts ...colly.CollectorOption) *colly.Collector {
+	c := colly.NewCollector(collectorOpts...)
+	if o.transport != nil {
+		c.WithTransport(o.transport)
+	}
+	// robots.txt rules are matched against the user agent of the collector.
+	if o.userAgent != "" {
+		c.UserAgent = o.userAgent
+	}
+	c.IgnoreRobotsTxt = !o.robotsTxt
+	return c
+}
+
+// client returns an HTTP client using the transport of the options.
+func (o *options) client() *http.Client {
+	if o.transport == nil {
+		return http.DefaultClient
+	}
+	return &http.Client{Transport: o.transport}
+}
@@ -8,14 +8,14 @@ import (
 )
 
 // GetTitle fetches the HTML title from the given URL.
-func GetTitle(targetURL string) (string, error) {
+func GetTitle(targetURL string, opts ...Option) (string, error) {
 	// Validate the URL
 	_, err := url.ParseRequestURI(targetURL)
 	if err != nil {
 		return "", fmt.Errorf("invalid URL %s: %w", targetURL, err)
 	}
 
-	c := colly.NewCollector(
+	c := newOptions(opts).collector(
 		// Allow visiting only the domain of the target URL
 		colly.AllowedDomains(), // TODO: Consider if specific domains should be allowed instead
 	)
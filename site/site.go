@@ -0,0 +1,291 @@
+package site
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"embed"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"log/slog"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/mopemope/quicknews/ent"
+	"github.com/mopemope/quicknews/storage"
+)
+
+//go:embed templates/*.html
+var templateFS embed.FS
+
+//go:embed static/*
+var staticFS embed.FS
+
+// Options configures the generated site.
+type Options struct {
+	Title     string // Site title
+	AudioPath string // Directory of the summary audio files; empty disables the audio player
+	Prefix    string // Key prefix of every file in the storage, e.g. "site/"
+}
+
+// entry is a summary rendered on the site.
+type entry struct {
+	ID         string   `json:"-"`
+	Title      string   `json:"title"`
+	URL        string   `json:"url"`
+	Feed       string   `json:"feed"`
+	Date       string   `json:"date"`
+	Summary    string   `json:"summary"`
+	Page       string   `json:"page"`
+	FeedPage   string   `json:"-"`
+	DayPage    string   `json:"-"`
+	Audio      string   `json:"-"`
+	Paragraphs []string `json:"-"`
+
+	audioFile string
+}
+
+// group is a list of entries shown on an index page.
+type group struct {
+	Name    string
+	Page    string
+	Entries []*entry
+}
+
+// Result reports what Generate wrote.
+type Result struct {
+	Pages int
+	Audio int
+}
+
+// Generator renders summaries into a static HTML site and writes it to a storage.
+type Generator struct {
+	store storage.Storage
+	opts  *Options
+	tmpl  *template.Template
+	now   time.Time
+}
+
+func NewGenerator(store storage.Storage, opts *Options) (*Generator, error) {
+	tmpl, err := template.New("site").Funcs(template.FuncMap{
+		"withRoot": func(root string, entries []*entry) any {
+			return struct {
+				Root    string
+				Entries []*entry
+			}{root, entries}
+		},
+	}).ParseFS(templateFS, "templates/*.html")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse site templates")
+	}
+	return &Generator{
+		store: store,
+		opts:  opts,
+		tmpl:  tmpl,
+		now:   time.Now(),
+	}, nil
+}
+
+// Generate writes the index, per-day and per-feed pages, an article page per summary,
+// the search index and the audio files of the summaries.
+func (g *Generator) Generate(ctx context.Context, sums []*ent.Summary) (*Result, error) {
+	entries := make([]*entry, 0, len(sums))
+	for _, sum := range sums {
+		entries = append(entries, newEntry(sum))
+	}
+	slices.SortStableFunc(entries, func(a, b *entry) int {
+		return cmp.Or(cmp.Compare(b.Date, a.Date), cmp.Compare(a.Feed, b.Feed))
+	})
+
+	days := groupBy(entries, func(e *entry) (string, string) { return e.Date, e.DayPage })
+	feeds := groupBy(entries, func(e *entry) (string, string) { return e.Feed, e.FeedPage })
+	slices.SortStableFunc(feeds, func(a, b *group) int { return cmp.Compare(a.Name, b.Name) })
+
+	result := &Result{}
+	audio, err := g.writeAudio(ctx, entries)
+	if err != nil {
+		return nil, err
+	}
+	result.Audio = audio
+
+	base := map[string]any{
+		"Title":     g.opts.Title,
+		"Generated": g.now.Format("2006-01-02 15:04"),
+	}
+	page := func(root, title string, data map[string]any) map[string]any {
+		m := map[string]any{"Root": root, "PageTitle": title}
+		for k, v := range base {
+			m[k] = v
+		}
+		for k, v := range data {
+			m[k] = v
+		}
+		return m
+	}
+
+	if err := g.render(ctx, "index.html", "index.html", page("", g.opts.Title, map[string]any{
+		"Days":  days,
+		"Feeds": feeds,
+	})); err != nil {
+		return nil, err
+	}
+	result.Pages++
+
+	for _, day := range days {
+		groups := groupBy(day.Entries, func(e *entry) (string, string) { return e.Feed, "" })
+		if err := g.render(ctx, day.Page, "list.html", page("../", day.Name, map[string]any{"Groups": groups})); err != nil {
+			return nil, err
+		}
+		result.Pages++
+	}
+	for _, f := range feeds {
+		groups := groupBy(f.Entries, func(e *entry) (string, string) { return e.Date, "" })
+		if err := g.render(ctx, f.Page, "list.html", page("../", f.Name, map[string]any{"Groups": groups})); err != nil {
+			return nil, err
+		}
+		result.Pages++
+	}
+	for _, e := range entries {
+		if err := g.render(ctx, e.Page, "article.html", page("../", e.Title, map[string]any{"Entry": e})); err != nil {
+			return nil, err
+		}
+		result.Pages++
+	}
+
+	index, err := json.Marshal(entries)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal search index")
+	}
+	if err := g.store.Upload(ctx, g.opts.Prefix+"search.json", bytes.NewReader(index), "application/json"); err != nil {
+		return nil, err
+	}
+
+	for _, name := range []string{"style.css", "search.js"} {
+		data, err := staticFS.ReadFile("static/" + name)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read %s", name)
+		}
+		contentType := "text/css"
+		if strings.HasSuffix(name, ".js") {
+			contentType = "text/javascript"
+		}
+		if err := g.store.Upload(ctx, g.opts.Prefix+name, bytes.NewReader(data), contentType); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (g *Generator) render(ctx context.Context, key, name string, data any) error {
+	var buf bytes.Buffer
+	if err := g.tmpl.ExecuteTemplate(&buf, name, data); err != nil {
+		return errors.Wrapf(err, "failed to render %s", key)
+	}
+	return g.store.Upload(ctx, g.opts.Prefix+key, &buf, "text/html; charset=utf-8")
+}
+
+// writeAudio copies the audio files of the entries that are not stored yet with the same size.
+func (g *Generator) writeAudio(ctx context.Context, entries []*entry) (int, error) {
+	if g.opts.AudioPath == "" {
+		return 0, nil
+	}
+	existing, err := g.store.List(ctx, g.opts.Prefix+"audio/")
+	if err != nil {
+		return 0, err
+	}
+	sizes := make(map[string]int64, len(existing))
+	for _, obj := range existing {
+		sizes[obj.Key] = obj.Size
+	}
+
+	count := 0
+	for _, e := range entries {
+		if e.audioFile == "" {
+			continue
+		}
+		src := filepath.Join(g.opts.AudioPath, e.audioFile)
+		info, err := os.Stat(src)
+		if err != nil {
+			slog.Warn("Skip missing audio file", "path", src, "error", err)
+			continue
+		}
+		e.Audio = path.Join("audio", e.audioFile)
+		key := g.opts.Prefix + e.Audio
+		if size, ok := sizes[key]; ok && size == info.Size() {
+			continue
+		}
+		if err := g.uploadFile(ctx, key, src); err != nil {
+			return count, err
+		}
+		count++
+	}
+	return count, nil
+}
+
+func (g *Generator) uploadFile(ctx context.Context, key, src string) error {
+	f, err := os.Open(src)
+	if err != nil {
+		return errors.Wrapf(err, "failed to open %s", src)
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+	return g.store.Upload(ctx, key, f, "audio/mpeg")
+}
+
+func newEntry(sum *ent.Summary) *entry {
+	published := sum.CreatedAt
+	if sum.Edges.Article != nil && !sum.Edges.Article.PublishedAt.IsZero() {
+		published = sum.Edges.Article.PublishedAt
+	}
+	date := published.Local().Format("2006-01-02")
+
+	feedName, feedID := "Other", "other"
+	if sum.Edges.Feed != nil {
+		feedName, feedID = sum.Edges.Feed.Title, sum.Edges.Feed.ID.String()
+	}
+
+	var paragraphs []string
+	for _, p := range strings.Split(sum.Summary, "\n") {
+		if p = strings.TrimSpace(p); p != "" {
+			paragraphs = append(paragraphs, p)
+		}
+	}
+
+	return &entry{
+		ID:         sum.ID.String(),
+		Title:      sum.Title,
+		URL:        sum.URL,
+		Feed:       feedName,
+		Date:       date,
+		Summary:    sum.Summary,
+		Page:       fmt.Sprintf("articles/%s.html", sum.ID),
+		FeedPage:   fmt.Sprintf("feeds/%s.html", feedID),
+		DayPage:    fmt.Sprintf("days/%s.html", date),
+		Paragraphs: paragraphs,
+		audioFile:  sum.AudioFile,
+	}
+}
+
+// groupBy groups the entries by key, keeping the order of first appearance.
+func groupBy(entries []*entry, key func(*entry) (name, page string)) []*group {
+	var groups []*group
+	index := map[string]*group{}
+	for _, e := range entries {
+		name, page := key(e)
+		g, ok := index[name]
+		if !ok {
+			g = &group{Name: name, Page: page}
+			index[name] = g
+			groups = append(groups, g)
+		}
+		g.Entries = append(g.Entries, e)
+	}
+	return groups
+}
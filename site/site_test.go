@@ -0,0 +1,79 @@
+package site
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mopemope/quicknews/ent"
+	"github.com/mopemope/quicknews/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerator_Generate(t *testing.T) {
+	ctx := context.Background()
+	audioPath := t.TempDir()
+	out := t.TempDir()
+
+	feed := &ent.Feed{ID: uuid.New(), Title: "Example <Feed>"}
+	published := time.Date(2024, 1, 2, 12, 0, 0, 0, time.Local)
+	sums := make([]*ent.Summary, 2)
+	for i := range sums {
+		sum := &ent.Summary{
+			ID:        uuid.New(),
+			URL:       "https://example.com/" + string(rune('a'+i)),
+			Title:     "Article " + string(rune('A'+i)),
+			Summary:   "first paragraph\n\nsecond & last",
+			CreatedAt: published,
+		}
+		sum.Edges.Feed = feed
+		sum.Edges.Article = &ent.Article{PublishedAt: published}
+		sums[i] = sum
+	}
+	sums[0].AudioFile = sums[0].ID.String() + ".mp3"
+	require.NoError(t, os.WriteFile(filepath.Join(audioPath, sums[0].AudioFile), []byte("mp3"), 0644))
+
+	store, err := storage.NewLocalStorage(out)
+	require.NoError(t, err)
+	gen, err := NewGenerator(store, &Options{Title: "quicknews daily", AudioPath: audioPath})
+	require.NoError(t, err)
+
+	result, err := gen.Generate(ctx, sums)
+	require.NoError(t, err)
+	// index, one day, one feed and two articles
+	assert.Equal(t, 5, result.Pages)
+	assert.Equal(t, 1, result.Audio)
+
+	index, err := os.ReadFile(filepath.Join(out, "index.html"))
+	require.NoError(t, err)
+	assert.Contains(t, string(index), `href="days/2024-01-02.html"`)
+	assert.Contains(t, string(index), "Example &lt;Feed&gt;")
+
+	article, err := os.ReadFile(filepath.Join(out, "articles", sums[0].ID.String()+".html"))
+	require.NoError(t, err)
+	assert.Contains(t, string(article), `<audio controls preload="none" src="../audio/`+sums[0].AudioFile+`">`)
+	assert.Contains(t, string(article), `<a href="https://example.com/a" rel="noopener">`)
+	assert.Contains(t, string(article), "<p>second &amp; last</p>")
+	assert.Contains(t, string(article), `href="../feeds/`+feed.ID.String()+`.html"`)
+
+	assert.FileExists(t, filepath.Join(out, "days", "2024-01-02.html"))
+	assert.FileExists(t, filepath.Join(out, "audio", sums[0].AudioFile))
+	assert.FileExists(t, filepath.Join(out, "search.js"))
+
+	data, err := os.ReadFile(filepath.Join(out, "search.json"))
+	require.NoError(t, err)
+	var entries []map[string]string
+	require.NoError(t, json.Unmarshal(data, &entries))
+	require.Len(t, entries, 2)
+	assert.Equal(t, "articles/"+sums[0].ID.String()+".html", entries[0]["page"])
+
+	// Audio files already stored are not copied again.
+	result, err = gen.Generate(ctx, sums)
+	require.NoError(t, err)
+	assert.Equal(t, 0, result.Audio)
+}
@@ -0,0 +1,33 @@
+// Client-side search over search.json: every term must appear in the title, feed or summary.
+(function () {
+  const input = document.getElementById("search");
+  const results = document.getElementById("results");
+  let index = null;
+
+  function render(terms) {
+    results.replaceChildren();
+    if (terms.length === 0) {
+      return;
+    }
+    const hits = index.filter((e) => terms.every((t) => e.text.includes(t))).slice(0, 50);
+    for (const e of hits) {
+      const li = document.createElement("li");
+      const a = document.createElement("a");
+      a.href = e.page;
+      a.textContent = e.title;
+      const meta = document.createElement("span");
+      meta.className = "meta";
+      meta.textContent = " " + e.feed + " / " + e.date;
+      li.append(a, meta);
+      results.append(li);
+    }
+  }
+
+  input.addEventListener("input", async () => {
+    if (index === null) {
+      const res = await fetch("search.json");
+      index = (await res.json()).map((e) => ({ ...e, text: (e.title + " " + e.feed + " " + e.summary).toLowerCase() }));
+    }
+    render(input.value.toLowerCase().split(/\s+/).filter((t) => t !== ""));
+  });
+})();
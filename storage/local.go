@@ -0,0 +1,99 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+)
+
+// LocalStorage stores files in a local directory, e.g. to preview a publish without uploading.
+type LocalStorage struct {
+	dir string
+}
+
+// NewLocalStorage creates a LocalStorage writing into dir, creating it if necessary.
+func NewLocalStorage(dir string) (*LocalStorage, error) {
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return nil, errors.Wrapf(err, "failed to create directory %s", dir)
+	}
+	return &LocalStorage{dir: dir}, nil
+}
+
+// Upload writes data to the file named key in the directory.
+func (l *LocalStorage) Upload(ctx context.Context, key string, reader io.Reader, contentType string) error {
+	path := filepath.Join(l.dir, filepath.FromSlash(key))
+	fmt.Println("Writing to", path)
+
+	if err := os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+		return errors.Wrapf(err, "failed to create directory for %s", path)
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		return errors.Wrapf(err, "failed to create %s", path)
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+	if _, err := io.Copy(f, reader); err != nil {
+		return errors.Wrapf(err, "failed to write %s", path)
+	}
+	return nil
+}
+
+// Download reads the file named key in the directory.
+func (l *LocalStorage) Download(ctx context.Context, key string) ([]byte, error) {
+	data, err := os.ReadFile(filepath.Join(l.dir, filepath.FromSlash(key)))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read %s", key)
+	}
+	return data, nil
+}
+
+// List returns the files in the directory whose key starts with prefix.
+func (l *LocalStorage) List(ctx context.Context, prefix string) ([]Object, error) {
+	var objects []Object
+	err := filepath.WalkDir(l.dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		rel, err := filepath.Rel(l.dir, path)
+		if err != nil {
+			return err
+		}
+		key := filepath.ToSlash(rel)
+		if !strings.HasPrefix(key, prefix) {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		objects = append(objects, Object{Key: key, Size: info.Size(), LastModified: info.ModTime()})
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list %s", l.dir)
+	}
+	return objects, nil
+}
+
+// Delete removes the file named key from the directory.
+func (l *LocalStorage) Delete(ctx context.Context, key string) error {
+	fmt.Println("Deleting", filepath.Join(l.dir, filepath.FromSlash(key)))
+	if err := os.Remove(filepath.Join(l.dir, filepath.FromSlash(key))); err != nil {
+		return errors.Wrapf(err, "failed to delete %s", key)
+	}
+	return nil
+}
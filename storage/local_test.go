@@ -0,0 +1,50 @@
+package storage
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalStorage(t *testing.T) {
+	ctx := context.Background()
+	dir := filepath.Join(t.TempDir(), "out")
+
+	local, err := NewLocalStorage(dir)
+	require.NoError(t, err)
+
+	_, err = local.Download(ctx, "rss.xml")
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	require.NoError(t, local.Upload(ctx, "rss.xml", strings.NewReader("<rss/>"), "application/rss+xml"))
+	data, err := local.Download(ctx, "rss.xml")
+	require.NoError(t, err)
+	assert.Equal(t, "<rss/>", string(data))
+}
+
+func TestLocalStorage_ListDelete(t *testing.T) {
+	ctx := context.Background()
+	local, err := NewLocalStorage(t.TempDir())
+	require.NoError(t, err)
+
+	require.NoError(t, local.Upload(ctx, "a.mp3", strings.NewReader("aaa"), "audio/mpeg"))
+	require.NoError(t, local.Upload(ctx, "site/index.html", strings.NewReader("<html/>"), "text/html"))
+
+	objects, err := local.List(ctx, "")
+	require.NoError(t, err)
+	assert.Len(t, objects, 2)
+
+	objects, err = local.List(ctx, "site/")
+	require.NoError(t, err)
+	require.Len(t, objects, 1)
+	assert.Equal(t, "site/index.html", objects[0].Key)
+	assert.Equal(t, int64(7), objects[0].Size)
+
+	require.NoError(t, local.Delete(ctx, "a.mp3"))
+	_, err = local.Download(ctx, "a.mp3")
+	assert.ErrorIs(t, err, ErrNotFound)
+}
@@ -9,6 +9,7 @@ import (
 	awsconfig "github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/credentials"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/cockroachdb/errors"
 	"github.com/mopemope/quicknews/config"
 )
@@ -64,3 +65,64 @@ func (r *R2Storage) Upload(ctx context.Context, key string, reader io.Reader, co
 	}
 	return nil
 }
+
+// Download returns the content of the object stored under key in the R2 bucket.
+func (r *R2Storage) Download(ctx context.Context, key string) ([]byte, error) {
+	out, err := r.client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(r.bucketName),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		var noSuchKey *types.NoSuchKey
+		if errors.As(err, &noSuchKey) {
+			return nil, ErrNotFound
+		}
+		return nil, errors.Wrapf(err, "failed to download object %q from R2 bucket %q", key, r.bucketName)
+	}
+	defer func() {
+		_ = out.Body.Close()
+	}()
+
+	data, err := io.ReadAll(out.Body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read object %q", key)
+	}
+	return data, nil
+}
+
+// List returns the objects of the R2 bucket whose key starts with prefix.
+func (r *R2Storage) List(ctx context.Context, prefix string) ([]Object, error) {
+	var objects []Object
+	paginator := s3.NewListObjectsV2Paginator(r.client, &s3.ListObjectsV2Input{
+		Bucket: aws.String(r.bucketName),
+		Prefix: aws.String(prefix),
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to list objects in R2 bucket %q", r.bucketName)
+		}
+		for _, obj := range page.Contents {
+			objects = append(objects, Object{
+				Key:          aws.ToString(obj.Key),
+				Size:         aws.ToInt64(obj.Size),
+				LastModified: aws.ToTime(obj.LastModified),
+			})
+		}
+	}
+	return objects, nil
+}
+
+// Delete removes the object stored under key from the R2 bucket.
+func (r *R2Storage) Delete(ctx context.Context, key string) error {
+	fmt.Println("Deleting from R2 bucket:", r.bucketName, "with key:", key)
+
+	_, err := r.client.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(r.bucketName),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to delete object %q from R2 bucket %q", key, r.bucketName)
+	}
+	return nil
+}
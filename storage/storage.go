@@ -0,0 +1,31 @@
+package storage
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+// ErrNotFound is returned when the requested object does not exist.
+var ErrNotFound = errors.New("object not found")
+
+// Object describes a stored file.
+type Object struct {
+	Key          string
+	Size         int64
+	LastModified time.Time
+}
+
+// Storage is a destination for published files.
+type Storage interface {
+	// Upload stores the data read from reader under key.
+	Upload(ctx context.Context, key string, reader io.Reader, contentType string) error
+	// Download returns the content stored under key, or ErrNotFound.
+	Download(ctx context.Context, key string) ([]byte, error)
+	// List returns the objects whose key starts with prefix.
+	List(ctx context.Context, prefix string) ([]Object, error)
+	// Delete removes the object stored under key.
+	Delete(ctx context.Context, key string) error
+}
@@ -0,0 +1,107 @@
+package transcript
+
+import (
+	"bytes"
+	"encoding/json"
+	"mime"
+	"regexp"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/cockroachdb/errors"
+)
+
+// cueTag matches the voice, class and timestamp tags of WebVTT cues.
+var cueTag = regexp.MustCompile(`<[^>]*>`)
+
+// Text turns a transcript of the given MIME type into plain text: WebVTT and SRT captions,
+// the JSON format of the podcast namespace, HTML, or plain text for any other type.
+func Text(data []byte, typ string) (string, error) {
+	switch mediaType(typ) {
+	case "text/vtt", "application/x-subrip", "application/srt":
+		return captionText(string(data)), nil
+	case "application/json":
+		return jsonText(data)
+	case "text/html":
+		doc, err := goquery.NewDocumentFromReader(bytes.NewReader(data))
+		if err != nil {
+			return "", errors.Wrap(err, "failed to parse transcript")
+		}
+		return strings.TrimSpace(doc.Find("body").Text()), nil
+	default:
+		return strings.TrimSpace(string(data)), nil
+	}
+}
+
+func mediaType(typ string) string {
+	if t, _, err := mime.ParseMediaType(typ); err == nil {
+		return t
+	}
+	return strings.ToLower(strings.TrimSpace(typ))
+}
+
+// captionText returns the text of the cues of WebVTT or SRT captions, dropping their
+// numbers and timings. Lines repeated by roll-up captions are kept once.
+func captionText(s string) string {
+	s = strings.ReplaceAll(s, "\r\n", "\n")
+	var lines []string
+	for _, block := range strings.Split(s, "\n\n") {
+		blockLines := strings.Split(strings.TrimSpace(block), "\n")
+		timing := -1
+		for i, line := range blockLines {
+			if strings.Contains(line, "-->") {
+				timing = i
+				break
+			}
+		}
+		// Blocks without timing are headers, notes and styles.
+		if timing < 0 {
+			continue
+		}
+		for _, line := range blockLines[timing+1:] {
+			line = strings.TrimSpace(cueTag.ReplaceAllString(line, ""))
+			if line == "" || (len(lines) > 0 && lines[len(lines)-1] == line) {
+				continue
+			}
+			lines = append(lines, line)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// jsonTranscript is the JSON transcript format of the podcast namespace.
+type jsonTranscript struct {
+	Segments []struct {
+		Speaker string `json:"speaker"`
+		Body    string `json:"body"`
+	} `json:"segments"`
+}
+
+// jsonText joins the segments of a JSON transcript, starting a paragraph with the name of
+// the speaker whenever the speaker changes.
+func jsonText(data []byte) (string, error) {
+	var t jsonTranscript
+	if err := json.Unmarshal(data, &t); err != nil {
+		return "", errors.Wrap(err, "failed to parse transcript")
+	}
+	var b strings.Builder
+	speaker := ""
+	for _, seg := range t.Segments {
+		body := strings.TrimSpace(seg.Body)
+		if body == "" {
+			continue
+		}
+		switch {
+		case seg.Speaker != "" && seg.Speaker != speaker:
+			if b.Len() > 0 {
+				b.WriteString("\n\n")
+			}
+			b.WriteString(seg.Speaker + ": ")
+			speaker = seg.Speaker
+		case b.Len() > 0:
+			b.WriteString(" ")
+		}
+		b.WriteString(body)
+	}
+	return b.String(), nil
+}
@@ -0,0 +1,216 @@
+// Package transcript gets the transcript of podcast and video episodes, from the
+// transcript published with the episode or from a speech-to-text command run on the media.
+package transcript
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/mmcdole/gofeed"
+	"github.com/mopemope/quicknews/config"
+	"github.com/mopemope/quicknews/ent"
+	"github.com/mopemope/quicknews/ent/schema"
+	"github.com/mopemope/quicknews/httpclient"
+)
+
+// Ref is a transcript published with an episode in a podcast:transcript tag.
+type Ref struct {
+	URL      string
+	Type     string
+	Language string
+}
+
+// preferred lists the transcript types from the easiest to turn into text.
+var preferred = []string{"text/plain", "text/vtt", "application/x-subrip", "application/srt", "application/json", "text/html"}
+
+// Transcriber gets the transcripts of the episodes of a feed.
+type Transcriber struct {
+	config   *config.Transcript // nil disables transcripts
+	settings *httpclient.Settings
+}
+
+func New(config *config.Transcript, settings *httpclient.Settings) *Transcriber {
+	return &Transcriber{config: config, settings: settings}
+}
+
+// Get returns the transcript of the article when it is an audio or video episode, from the
+// transcripts published with the item or else from the speech-to-text command. It returns
+// an empty string when the article is not an episode or no transcript is available.
+func (t *Transcriber) Get(ctx context.Context, item *gofeed.Item, article *ent.Article) (string, error) {
+	if t.config == nil {
+		return "", nil
+	}
+	media := Media(article)
+	if media == nil {
+		return "", nil
+	}
+	ctx, cancel := context.WithTimeout(ctx, t.config.Timeout)
+	defer cancel()
+
+	var errs error
+	for _, ref := range Published(item) {
+		text, err := t.Fetch(ctx, ref)
+		if err != nil {
+			slog.Warn("Failed to fetch transcript", "url", ref.URL, "error", err)
+			errs = errors.CombineErrors(errs, err)
+			continue
+		}
+		if text != "" {
+			return text, nil
+		}
+	}
+	if t.config.Command == "" {
+		return "", errs
+	}
+	return t.Transcribe(ctx, media)
+}
+
+// Media returns the first audio or video enclosure of the article, or nil.
+func Media(article *ent.Article) *schema.Enclosure {
+	for i, enc := range article.Enclosures {
+		typ := enc.Type
+		if typ == "" {
+			if u, err := url.Parse(enc.URL); err == nil {
+				typ = mime.TypeByExtension(path.Ext(u.Path))
+			}
+		}
+		if strings.HasPrefix(typ, "audio/") || strings.HasPrefix(typ, "video/") {
+			return &article.Enclosures[i]
+		}
+	}
+	return nil
+}
+
+// Published returns the transcripts published with the item, the easiest to turn into
+// text first.
+func Published(item *gofeed.Item) []Ref {
+	var refs []Ref
+	for _, ext := range item.Extensions["podcast"]["transcript"] {
+		if ext.Attrs["url"] == "" {
+			continue
+		}
+		refs = append(refs, Ref{URL: ext.Attrs["url"], Type: ext.Attrs["type"], Language: ext.Attrs["language"]})
+	}
+	slices.SortStableFunc(refs, func(a, b Ref) int {
+		return rank(a.Type) - rank(b.Type)
+	})
+	return refs
+}
+
+func rank(typ string) int {
+	if i := slices.Index(preferred, mediaType(typ)); i >= 0 {
+		return i
+	}
+	return len(preferred)
+}
+
+// Fetch downloads a published transcript and returns its text.
+func (t *Transcriber) Fetch(ctx context.Context, ref Ref) (string, error) {
+	resp, err := t.get(ctx, ref.URL)
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to read transcript")
+	}
+	typ := ref.Type
+	if typ == "" {
+		typ = resp.Header.Get("Content-Type")
+	}
+	return Text(data, typ)
+}
+
+// Transcribe downloads the media and runs the speech-to-text command on it. The command
+// prints the transcript.
+func (t *Transcriber) Transcribe(ctx context.Context, media *schema.Enclosure) (string, error) {
+	file, err := t.download(ctx, media)
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		_ = os.Remove(file)
+	}()
+
+	slog.Info("Transcribing media", "url", media.URL)
+	command := strings.ReplaceAll(t.config.Command, "{file}", shellQuote(file))
+	cmd := exec.CommandContext(ctx, "sh", "-c", command)
+	var stderr strings.Builder
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return "", errors.Wrapf(err, "transcript command failed: %s", strings.TrimSpace(stderr.String()))
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+// download saves the media to a temporary file, refusing files larger than max_size_mb.
+func (t *Transcriber) download(ctx context.Context, media *schema.Enclosure) (string, error) {
+	maxSize := t.config.MaxSizeMB << 20
+	if media.Length > maxSize {
+		return "", errors.Newf("media is larger than %d MB", t.config.MaxSizeMB)
+	}
+	resp, err := t.get(ctx, media.URL)
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	ext := ""
+	if u, err := url.Parse(media.URL); err == nil {
+		ext = path.Ext(u.Path)
+	}
+	f, err := os.CreateTemp("", "quicknews-media-*"+ext)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to create temporary file")
+	}
+	n, err := io.Copy(f, io.LimitReader(resp.Body, maxSize+1))
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil && n > maxSize {
+		err = errors.Newf("media is larger than %d MB", t.config.MaxSizeMB)
+	}
+	if err != nil {
+		_ = os.Remove(f.Name())
+		return "", errors.Wrap(err, "failed to download media")
+	}
+	return f.Name(), nil
+}
+
+func (t *Transcriber) get(ctx context.Context, target string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create request")
+	}
+	// The timeout of the configuration applies through the context.
+	resp, err := t.settings.Client(0).Do(req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get %s", target)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		_ = resp.Body.Close()
+		return nil, errors.Newf("failed to get %s: %s", target, resp.Status)
+	}
+	return resp, nil
+}
+
+// shellQuote quotes a string for sh.
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
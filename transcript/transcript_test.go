@@ -0,0 +1,113 @@
+package transcript
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/mmcdole/gofeed"
+	ext "github.com/mmcdole/gofeed/extensions"
+	"github.com/mopemope/quicknews/config"
+	"github.com/mopemope/quicknews/ent"
+	"github.com/mopemope/quicknews/ent/schema"
+	"github.com/mopemope/quicknews/httpclient"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestText(t *testing.T) {
+	vtt := "WEBVTT\n\nNOTE a comment\n\n1\n00:00:00.000 --> 00:00:02.000\n<v Alice>Hello and welcome.\n\n2\n00:00:02.000 --> 00:00:04.000\n<v Alice>Hello and welcome.\nToday we talk about Go.\n"
+	text, err := Text([]byte(vtt), "text/vtt; charset=utf-8")
+	require.NoError(t, err)
+	assert.Equal(t, "Hello and welcome.\nToday we talk about Go.", text)
+
+	srt := "1\r\n00:00:00,000 --> 00:00:02,000\r\nFirst line\r\n\r\n2\r\n00:00:02,000 --> 00:00:04,000\r\nSecond line\r\n"
+	text, err = Text([]byte(srt), "application/x-subrip")
+	require.NoError(t, err)
+	assert.Equal(t, "First line\nSecond line", text)
+
+	js := `{"version":"1.0.0","segments":[
+	  {"speaker":"Alice","startTime":0,"endTime":1,"body":"Hi."},
+	  {"speaker":"Alice","startTime":1,"endTime":2,"body":"Welcome."},
+	  {"speaker":"Bob","startTime":2,"endTime":3,"body":"Thanks."}]}`
+	text, err = Text([]byte(js), "application/json")
+	require.NoError(t, err)
+	assert.Equal(t, "Alice: Hi. Welcome.\n\nBob: Thanks.", text)
+
+	text, err = Text([]byte("<html><body><p>Spoken words</p></body></html>"), "text/html")
+	require.NoError(t, err)
+	assert.Equal(t, "Spoken words", text)
+}
+
+func TestMedia(t *testing.T) {
+	a := &ent.Article{Enclosures: []schema.Enclosure{
+		{URL: "https://example.com/cover.jpg", Type: "image/jpeg"},
+		{URL: "https://example.com/episode.mp3?token=1"},
+	}}
+	media := Media(a)
+	require.NotNil(t, media)
+	assert.Equal(t, "https://example.com/episode.mp3?token=1", media.URL)
+
+	assert.Nil(t, Media(&ent.Article{}))
+}
+
+func TestPublished(t *testing.T) {
+	item := &gofeed.Item{Extensions: ext.Extensions{"podcast": {"transcript": {
+		{Attrs: map[string]string{"url": "https://example.com/t.json", "type": "application/json"}},
+		{Attrs: map[string]string{"url": "https://example.com/t.vtt", "type": "text/vtt", "language": "en"}},
+		{Attrs: map[string]string{"type": "text/plain"}},
+	}}}}
+	assert.Equal(t, []Ref{
+		{URL: "https://example.com/t.vtt", Type: "text/vtt", Language: "en"},
+		{URL: "https://example.com/t.json", Type: "application/json"},
+	}, Published(item))
+}
+
+func newTranscriber(t *testing.T, cfg *config.Transcript) *Transcriber {
+	settings, err := httpclient.New(&config.Config{}, "https://example.com/feed")
+	require.NoError(t, err)
+	return New(cfg, settings)
+}
+
+func TestTranscriber_Get(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/transcript.txt":
+			_, _ = w.Write([]byte("Published transcript\n"))
+		case "/episode.mp3":
+			_, _ = w.Write([]byte("spoken audio"))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	article := &ent.Article{Enclosures: []schema.Enclosure{{URL: server.URL + "/episode.mp3", Type: "audio/mpeg"}}}
+	published := &gofeed.Item{Extensions: ext.Extensions{"podcast": {"transcript": {
+		{Attrs: map[string]string{"url": server.URL + "/transcript.txt", "type": "text/plain"}},
+	}}}}
+	cfg := &config.Transcript{Command: "tr a-z A-Z < {file}", Timeout: time.Minute, MaxSizeMB: 1}
+
+	text, err := newTranscriber(t, cfg).Get(ctx, published, article)
+	require.NoError(t, err)
+	assert.Equal(t, "Published transcript", text)
+
+	text, err = newTranscriber(t, cfg).Get(ctx, &gofeed.Item{}, article)
+	require.NoError(t, err)
+	assert.Equal(t, "SPOKEN AUDIO", text, "the command transcribes the downloaded media")
+
+	text, err = newTranscriber(t, nil).Get(ctx, published, article)
+	require.NoError(t, err)
+	assert.Empty(t, text, "transcripts are disabled without [transcript]")
+
+	text, err = newTranscriber(t, cfg).Get(ctx, published, &ent.Article{})
+	require.NoError(t, err)
+	assert.Empty(t, text, "articles without media have no transcript")
+
+	large := &ent.Article{Enclosures: []schema.Enclosure{{URL: server.URL + "/episode.mp3", Type: "audio/mpeg", Length: 2 << 20}}}
+	_, err = newTranscriber(t, cfg).Get(ctx, &gofeed.Item{}, large)
+	assert.Error(t, err)
+}
@@ -0,0 +1,118 @@
+package tts
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"os"
+	"time"
+	"unicode/utf16"
+
+	"github.com/cockroachdb/errors"
+	"github.com/dmulholl/mp3lib"
+)
+
+// Chapter is a named section of an episode.
+type Chapter struct {
+	Title string
+	Start time.Duration
+	End   time.Duration
+}
+
+// MP3Duration returns the playback duration of an MP3 file by counting its frames.
+func MP3Duration(path string) (time.Duration, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to open audio file")
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+
+	var d time.Duration
+	isFirstFrame := true
+	for {
+		frame := mp3lib.NextFrame(f)
+		if frame == nil {
+			break
+		}
+		if isFirstFrame {
+			isFirstFrame = false
+			if mp3lib.IsXingHeader(frame) || mp3lib.IsVbriHeader(frame) {
+				continue
+			}
+		}
+		d += time.Duration(frame.SampleCount) * time.Second / time.Duration(frame.SamplingRate)
+	}
+	return d, nil
+}
+
+// WriteChapters prepends an ID3v2.3 tag with CTOC and CHAP frames to the MP3 file,
+// which podcast players display as chapter marks.
+func WriteChapters(path string, chapters []Chapter) error {
+	if len(chapters) == 0 {
+		return nil
+	}
+	if len(chapters) > 255 {
+		return errors.New("too many chapters")
+	}
+	audio, err := os.ReadFile(path)
+	if err != nil {
+		return errors.Wrap(err, "failed to read audio file")
+	}
+
+	var frames bytes.Buffer
+
+	// Table of contents: top-level and ordered.
+	var toc bytes.Buffer
+	toc.WriteString("toc\x00")
+	toc.WriteByte(0x03)
+	toc.WriteByte(byte(len(chapters)))
+	for i := range chapters {
+		toc.WriteString(fmt.Sprintf("chp%d\x00", i))
+	}
+	writeID3Frame(&frames, "CTOC", toc.Bytes())
+
+	for i, ch := range chapters {
+		var chap bytes.Buffer
+		chap.WriteString(fmt.Sprintf("chp%d\x00", i))
+		_ = binary.Write(&chap, binary.BigEndian, uint32(ch.Start.Milliseconds()))
+		_ = binary.Write(&chap, binary.BigEndian, uint32(ch.End.Milliseconds()))
+		// Byte offsets are unused.
+		_ = binary.Write(&chap, binary.BigEndian, uint32(0xffffffff))
+		_ = binary.Write(&chap, binary.BigEndian, uint32(0xffffffff))
+		writeID3Frame(&chap, "TIT2", id3Text(ch.Title))
+		writeID3Frame(&frames, "CHAP", chap.Bytes())
+	}
+
+	size := frames.Len()
+	header := []byte{'I', 'D', '3', 3, 0, 0,
+		byte(size >> 21 & 0x7f), byte(size >> 14 & 0x7f), byte(size >> 7 & 0x7f), byte(size & 0x7f)}
+
+	out := make([]byte, 0, len(header)+size+len(audio))
+	out = append(out, header...)
+	out = append(out, frames.Bytes()...)
+	out = append(out, audio...)
+	if err := os.WriteFile(path, out, 0o644); err != nil {
+		return errors.Wrap(err, "failed to write audio file")
+	}
+	return nil
+}
+
+func writeID3Frame(buf *bytes.Buffer, id string, body []byte) {
+	buf.WriteString(id)
+	_ = binary.Write(buf, binary.BigEndian, uint32(len(body)))
+	buf.Write([]byte{0, 0})
+	buf.Write(body)
+}
+
+// id3Text encodes a text frame body as UTF-16 with a byte order mark.
+func id3Text(s string) []byte {
+	var buf bytes.Buffer
+	buf.Write([]byte{0x01, 0xff, 0xfe})
+	for _, u := range utf16.Encode([]rune(s)) {
+		buf.Write([]byte{byte(u), byte(u >> 8)})
+	}
+	buf.Write([]byte{0, 0})
+	return buf.Bytes()
+}
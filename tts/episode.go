@@ -0,0 +1,261 @@
+package tts
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"math"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/dmulholl/mp3lib"
+)
+
+const (
+	// SeparatorSilence inserts generated silence between clips.
+	SeparatorSilence = "silence"
+	// SeparatorChime inserts a short generated tone between clips (requires ffmpeg).
+	SeparatorChime = "chime"
+)
+
+// EpisodeOptions configures the framing MergeEpisode adds around summary clips.
+type EpisodeOptions struct {
+	IntroFile         string        // Audio played before everything else
+	OutroFile         string        // Audio played after the last clip
+	Header            []byte        // Synthesized spoken header, placed after the intro
+	Separator         string        // SeparatorSilence, SeparatorChime or the path of an audio file
+	SeparatorDuration time.Duration // Length of a generated silence or chime
+}
+
+// EpisodeLayout describes the timing of a merged episode.
+type EpisodeLayout struct {
+	ClipStarts []time.Duration // Start offset of each clip
+	ClipEnds   []time.Duration // End offset of each clip
+	Duration   time.Duration   // Total length of the episode
+}
+
+// MergeEpisode merges the clips into a single MP3 file, adding the intro, spoken header,
+// separators and outro described by opts. Framing segments are converted to the format
+// of the first clip with ffmpeg when they differ; segments that cannot be converted are
+// skipped with a warning.
+func MergeEpisode(outpath string, clips []string, opts *EpisodeOptions) (*EpisodeLayout, error) {
+	if opts == nil {
+		opts = &EpisodeOptions{}
+	}
+	if len(clips) == 0 {
+		return nil, errors.New("no clips to merge")
+	}
+
+	ref, err := firstAudioFrame(clips[0])
+	if err != nil {
+		return nil, err
+	}
+
+	workDir, err := os.MkdirTemp("", "quicknews-episode-")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create work directory")
+	}
+	defer func() {
+		if err := os.RemoveAll(workDir); err != nil {
+			slog.Warn("Failed to remove work directory", "path", workDir, "error", err)
+		}
+	}()
+
+	inpaths := make([]string, 0, len(clips)*2+3)
+	if opts.IntroFile != "" {
+		if p, err := conformSegment(opts.IntroFile, ref, filepath.Join(workDir, "intro.mp3")); err != nil {
+			slog.Warn("Skip intro", "file", opts.IntroFile, "error", err)
+		} else {
+			inpaths = append(inpaths, p)
+		}
+	}
+	if len(opts.Header) > 0 {
+		src := filepath.Join(workDir, "header.src")
+		if err := os.WriteFile(src, opts.Header, 0644); err != nil {
+			return nil, errors.Wrap(err, "failed to write header audio")
+		}
+		if p, err := conformSegment(src, ref, filepath.Join(workDir, "header.mp3")); err != nil {
+			slog.Warn("Skip spoken header", "error", err)
+		} else {
+			inpaths = append(inpaths, p)
+		}
+	}
+
+	separator, err := separatorFile(opts, ref, workDir)
+	if err != nil {
+		slog.Warn("Skip separators", "separator", opts.Separator, "error", err)
+	}
+	clipIndexes := make([]int, len(clips))
+	for i, clip := range clips {
+		if i > 0 && separator != "" {
+			inpaths = append(inpaths, separator)
+		}
+		clipIndexes[i] = len(inpaths)
+		inpaths = append(inpaths, clip)
+	}
+
+	if opts.OutroFile != "" {
+		if p, err := conformSegment(opts.OutroFile, ref, filepath.Join(workDir, "outro.mp3")); err != nil {
+			slog.Warn("Skip outro", "file", opts.OutroFile, "error", err)
+		} else {
+			inpaths = append(inpaths, p)
+		}
+	}
+
+	if err := MergeMP3(outpath, inpaths); err != nil {
+		return nil, err
+	}
+
+	// Compute the timing of each clip from the frame counts of the merged inputs.
+	starts := make([]time.Duration, len(inpaths))
+	ends := make([]time.Duration, len(inpaths))
+	var offset time.Duration
+	for i, p := range inpaths {
+		d, err := MP3Duration(p)
+		if err != nil {
+			return nil, err
+		}
+		starts[i] = offset
+		offset += d
+		ends[i] = offset
+	}
+	layout := &EpisodeLayout{Duration: offset}
+	for _, idx := range clipIndexes {
+		layout.ClipStarts = append(layout.ClipStarts, starts[idx])
+		layout.ClipEnds = append(layout.ClipEnds, ends[idx])
+	}
+	return layout, nil
+}
+
+// WriteSilenceMP3 writes d of silence as MP3 frames matching the format of ref.
+func WriteSilenceMP3(path string, ref *mp3lib.MP3Frame, d time.Duration) error {
+	header := make([]byte, 4)
+	copy(header, ref.RawBytes[:4])
+	header[1] |= 0x01  // no CRC
+	header[2] &^= 0x02 // no padding
+	length := (ref.SampleCount / 8) * ref.BitRate / ref.SamplingRate
+
+	frames := int(math.Ceil(d.Seconds() * float64(ref.SamplingRate) / float64(ref.SampleCount)))
+	data := make([]byte, 0, frames*length)
+	for range frames {
+		// An all-zero side info and main data section decodes to silence.
+		frame := make([]byte, length)
+		copy(frame, header)
+		data = append(data, frame...)
+	}
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return errors.Wrap(err, "failed to write silence")
+	}
+	return nil
+}
+
+func separatorFile(opts *EpisodeOptions, ref *mp3lib.MP3Frame, workDir string) (string, error) {
+	dst := filepath.Join(workDir, "separator.mp3")
+	switch opts.Separator {
+	case "":
+		return "", nil
+	case SeparatorSilence:
+		if err := WriteSilenceMP3(dst, ref, opts.SeparatorDuration); err != nil {
+			return "", err
+		}
+		return dst, nil
+	case SeparatorChime:
+		if !hasFFmpeg() {
+			slog.Warn("ffmpeg is not available, using silence instead of chime")
+			if err := WriteSilenceMP3(dst, ref, opts.SeparatorDuration); err != nil {
+				return "", err
+			}
+			return dst, nil
+		}
+		seconds := opts.SeparatorDuration.Seconds()
+		args := []string{
+			"-f", "lavfi",
+			"-i", fmt.Sprintf("sine=frequency=880:sample_rate=%d:duration=%.2f", ref.SamplingRate, seconds),
+			"-af", fmt.Sprintf("afade=t=out:st=0:d=%.2f,volume=0.4", seconds),
+		}
+		if err := runFFmpegConvert(args, ref, dst); err != nil {
+			return "", err
+		}
+		return dst, nil
+	default:
+		return conformSegment(opts.Separator, ref, dst)
+	}
+}
+
+// conformSegment returns a path to src in the same MP3 format as ref, converting it
+// into dst with ffmpeg when necessary.
+func conformSegment(src string, ref *mp3lib.MP3Frame, dst string) (string, error) {
+	if frame, err := firstAudioFrame(src); err == nil && sameFormat(frame, ref) {
+		return src, nil
+	}
+	if !hasFFmpeg() {
+		return "", errors.New("audio format differs from the episode and ffmpeg is not available")
+	}
+	if err := runFFmpegConvert([]string{"-i", src}, ref, dst); err != nil {
+		return "", err
+	}
+	return dst, nil
+}
+
+func runFFmpegConvert(input []string, ref *mp3lib.MP3Frame, dst string) error {
+	channels := "2"
+	if ref.ChannelMode == mp3lib.Mono {
+		channels = "1"
+	}
+	args := append([]string{"-hide_banner", "-loglevel", "error", "-y"}, input...)
+	args = append(args,
+		"-ar", strconv.Itoa(ref.SamplingRate),
+		"-ac", channels,
+		"-b:a", fmt.Sprintf("%dk", ref.BitRate/1000),
+		"-f", "mp3", dst)
+	if out, err := exec.Command(FFmpegBin, args...).CombinedOutput(); err != nil {
+		return errors.Wrapf(err, "failed to run ffmpeg: %s", out)
+	}
+	return nil
+}
+
+func sameFormat(a, b *mp3lib.MP3Frame) bool {
+	return a.MPEGVersion == b.MPEGVersion &&
+		a.MPEGLayer == b.MPEGLayer &&
+		a.SamplingRate == b.SamplingRate &&
+		(a.ChannelMode == mp3lib.Mono) == (b.ChannelMode == mp3lib.Mono)
+}
+
+// firstAudioFrame returns the first MP3 frame of the file that is not a VBR header.
+func firstAudioFrame(path string) (*mp3lib.MP3Frame, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open audio file")
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+
+	header := make([]byte, 12)
+	if _, err := f.Read(header); err == nil && isWav(header) {
+		return nil, errors.Newf("%s is not an MP3 file", path)
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return nil, errors.Wrap(err, "failed to seek audio file")
+	}
+
+	for {
+		frame := mp3lib.NextFrame(f)
+		if frame == nil {
+			return nil, errors.Newf("no MP3 frames found in %s", path)
+		}
+		if mp3lib.IsXingHeader(frame) || mp3lib.IsVbriHeader(frame) {
+			continue
+		}
+		return frame, nil
+	}
+}
+
+func hasFFmpeg() bool {
+	_, err := exec.LookPath(FFmpegBin)
+	return err == nil
+}
@@ -0,0 +1,120 @@
+package tts
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/dmulholl/mp3lib"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func countFrames(t *testing.T, path string) int {
+	t.Helper()
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer func() { _ = f.Close() }()
+
+	count := 0
+	for mp3lib.NextFrame(f) != nil {
+		count++
+	}
+	return count
+}
+
+func TestMergeEpisode_Separator(t *testing.T) {
+	dir := t.TempDir()
+
+	// MPEG2 Layer III, 64kbps, 24kHz, mono: 576 samples (24ms) per frame.
+	ref := &mp3lib.MP3Frame{
+		MPEGVersion:  mp3lib.MPEGVersion2,
+		MPEGLayer:    mp3lib.MPEGLayerIII,
+		ChannelMode:  mp3lib.Mono,
+		BitRate:      64000,
+		SamplingRate: 24000,
+		SampleCount:  576,
+		RawBytes:     []byte{0xff, 0xf3, 0x84, 0xc4},
+	}
+
+	clips := make([]string, 3)
+	for i := range clips {
+		clips[i] = filepath.Join(dir, "clip"+string(rune('a'+i))+".mp3")
+		require.NoError(t, WriteSilenceMP3(clips[i], ref, 240*time.Millisecond))
+		require.Equal(t, 10, countFrames(t, clips[i]))
+	}
+
+	output := filepath.Join(dir, "episode.mp3")
+	layout, err := MergeEpisode(output, clips, &EpisodeOptions{
+		Separator:         SeparatorSilence,
+		SeparatorDuration: 120 * time.Millisecond,
+	})
+	require.NoError(t, err)
+
+	// three clips of 10 frames and two separators of 5 frames
+	assert.Equal(t, 40, countFrames(t, output))
+	assert.Equal(t, 960*time.Millisecond, layout.Duration)
+	assert.Equal(t, []time.Duration{0, 360 * time.Millisecond, 720 * time.Millisecond}, layout.ClipStarts)
+	assert.Equal(t, 600*time.Millisecond, layout.ClipEnds[1])
+}
+
+func TestMergeEpisode_SkipsUnconvertibleIntro(t *testing.T) {
+	orig := FFmpegBin
+	FFmpegBin = filepath.Join(t.TempDir(), "no-ffmpeg")
+	defer func() { FFmpegBin = orig }()
+
+	dir := t.TempDir()
+	ref := &mp3lib.MP3Frame{
+		MPEGVersion:  mp3lib.MPEGVersion2,
+		MPEGLayer:    mp3lib.MPEGLayerIII,
+		ChannelMode:  mp3lib.Mono,
+		BitRate:      64000,
+		SamplingRate: 24000,
+		SampleCount:  576,
+		RawBytes:     []byte{0xff, 0xf3, 0x84, 0xc4},
+	}
+	clip := filepath.Join(dir, "clip.mp3")
+	require.NoError(t, WriteSilenceMP3(clip, ref, 240*time.Millisecond))
+
+	intro := filepath.Join(dir, "intro.wav")
+	writeSineWav(t, intro, 0.5)
+
+	output := filepath.Join(dir, "episode.mp3")
+	_, err := MergeEpisode(output, []string{clip}, &EpisodeOptions{IntroFile: intro})
+	require.NoError(t, err)
+	assert.Equal(t, 10, countFrames(t, output))
+}
+
+func TestWriteChapters(t *testing.T) {
+	dir := t.TempDir()
+	ref := &mp3lib.MP3Frame{
+		MPEGVersion:  mp3lib.MPEGVersion2,
+		MPEGLayer:    mp3lib.MPEGLayerIII,
+		ChannelMode:  mp3lib.Mono,
+		BitRate:      64000,
+		SamplingRate: 24000,
+		SampleCount:  576,
+		RawBytes:     []byte{0xff, 0xf3, 0x84, 0xc4},
+	}
+	path := filepath.Join(dir, "episode.mp3")
+	require.NoError(t, WriteSilenceMP3(path, ref, 480*time.Millisecond))
+
+	err := WriteChapters(path, []Chapter{
+		{Title: "Hacker News", Start: 0, End: 240 * time.Millisecond},
+		{Title: "はてなブックマーク", Start: 240 * time.Millisecond, End: 480 * time.Millisecond},
+	})
+	require.NoError(t, err)
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "ID3", string(data[:3]))
+	assert.Contains(t, string(data), "CTOC")
+	assert.Contains(t, string(data), "CHAP")
+
+	// The audio frames are left untouched.
+	assert.Equal(t, 20, countFrames(t, path))
+	d, err := MP3Duration(path)
+	require.NoError(t, err)
+	assert.Equal(t, 480*time.Millisecond, d)
+}
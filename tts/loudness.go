@@ -0,0 +1,434 @@
+package tts
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"log/slog"
+	"math"
+	"os"
+	"os/exec"
+	"strconv"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/dmulholl/mp3lib"
+	"github.com/gopxl/beep/v2"
+	"github.com/gopxl/beep/v2/effects"
+	"github.com/gopxl/beep/v2/mp3"
+	"github.com/gopxl/beep/v2/wav"
+	"github.com/mopemope/quicknews/config"
+)
+
+const (
+	// absoluteGateLUFS and relativeGateLU are the gating thresholds of ITU-R BS.1770-4.
+	absoluteGateLUFS = -70.0
+	relativeGateLU   = -10.0
+	// mp3GainStepDB is the amount of gain a single global_gain step represents.
+	mp3GainStepDB = 1.5
+)
+
+// LoudnessStats holds the result of a loudness measurement.
+type LoudnessStats struct {
+	Integrated float64 // Integrated loudness in LUFS (-Inf for silence)
+	Peak       float64 // Sample peak in dBFS
+}
+
+// NormalizeLoudness rewrites the audio file at path so that its integrated loudness
+// matches the configured target. ffmpeg's loudnorm filter is used when FFmpegBin is
+// available, otherwise the gain is analysed and applied in pure Go.
+func NormalizeLoudness(path string, cfg *config.Loudness) error {
+	if cfg == nil {
+		return nil
+	}
+	if hasFFmpeg() {
+		return normalizeWithFFmpeg(path, cfg)
+	}
+	return normalizeNative(path, cfg)
+}
+
+// MeasureLoudness measures the integrated loudness and sample peak of MP3 or WAV data.
+func MeasureLoudness(data []byte) (*LoudnessStats, error) {
+	streamer, format, err := decodeAudio(data)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = streamer.Close()
+	}()
+
+	channels := min(max(format.NumChannels, 1), 2)
+	filters := make([]*kWeighting, channels)
+	for ch := range filters {
+		filters[ch] = newKWeighting(float64(format.SampleRate))
+	}
+
+	// Accumulate the energy in 100ms segments; a 400ms gating block is four consecutive segments.
+	step := format.SampleRate.N(100 * time.Millisecond)
+	segments := make([]float64, 0)
+	var energy, peak float64
+	var count int
+
+	buf := make([][2]float64, 4096)
+	for {
+		n, ok := streamer.Stream(buf)
+		for i := range n {
+			for ch := range channels {
+				x := buf[i][ch]
+				peak = math.Max(peak, math.Abs(x))
+				y := filters[ch].process(x)
+				energy += y * y
+			}
+			count++
+			if count == step {
+				segments = append(segments, energy)
+				energy, count = 0, 0
+			}
+		}
+		if !ok {
+			break
+		}
+	}
+	if err := streamer.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to decode audio data")
+	}
+
+	powers := make([]float64, 0, len(segments))
+	for i := 0; i+4 <= len(segments); i++ {
+		sum := segments[i] + segments[i+1] + segments[i+2] + segments[i+3]
+		powers = append(powers, sum/float64(4*step))
+	}
+
+	return &LoudnessStats{
+		Integrated: gatedLoudness(powers),
+		Peak:       20 * math.Log10(peak),
+	}, nil
+}
+
+func normalizeWithFFmpeg(path string, cfg *config.Loudness) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return errors.Wrap(err, "failed to read audio file")
+	}
+	streamer, format, err := decodeAudio(data)
+	if err != nil {
+		return err
+	}
+	_ = streamer.Close()
+
+	outFormat := "mp3"
+	if isWav(data) {
+		outFormat = "wav"
+	}
+	filter := fmt.Sprintf("loudnorm=I=%.1f:TP=%.1f:LRA=%.1f", cfg.TargetLUFS, cfg.TruePeak, cfg.LRA)
+	tmp := path + ".loudnorm"
+	// loudnorm upsamples internally, so keep the original sample rate to allow frame-level merging.
+	cmd := exec.Command(FFmpegBin,
+		"-hide_banner", "-loglevel", "error", "-y",
+		"-i", path,
+		"-af", filter,
+		"-ar", strconv.Itoa(int(format.SampleRate)),
+		"-f", outFormat, tmp)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		_ = os.Remove(tmp)
+		return errors.Wrapf(err, "failed to run ffmpeg loudnorm: %s", out)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return errors.Wrap(err, "failed to replace audio file")
+	}
+	slog.Debug("Normalized loudness with ffmpeg", "path", path, "filter", filter)
+	return nil
+}
+
+func normalizeNative(path string, cfg *config.Loudness) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return errors.Wrap(err, "failed to read audio file")
+	}
+	stats, err := MeasureLoudness(data)
+	if err != nil {
+		return err
+	}
+	if math.IsInf(stats.Integrated, -1) {
+		slog.Debug("Skip loudness normalization of silent audio", "path", path)
+		return nil
+	}
+
+	gain := cfg.TargetLUFS - stats.Integrated
+	if headroom := cfg.TruePeak - stats.Peak; gain > headroom {
+		gain = headroom
+	}
+	slog.Debug("Normalizing loudness", "path", path, "integrated", stats.Integrated, "peak", stats.Peak, "gain", gain)
+
+	if isWav(data) {
+		return applyWavGain(path, data, gain)
+	}
+	return applyMP3Gain(path, data, gain)
+}
+
+// applyWavGain scales the PCM samples of a WAV file by gain dB.
+func applyWavGain(path string, data []byte, gain float64) error {
+	streamer, format, err := wav.Decode(bytes.NewReader(data))
+	if err != nil {
+		return errors.Wrap(err, "failed to decode wave data")
+	}
+	defer func() {
+		_ = streamer.Close()
+	}()
+
+	tmp := path + ".loudnorm"
+	out, err := os.Create(tmp)
+	if err != nil {
+		return errors.Wrap(err, "failed to create temporary file")
+	}
+	amplified := &effects.Gain{Streamer: streamer, Gain: math.Pow(10, gain/20) - 1}
+	if err := wav.Encode(out, amplified, format); err != nil {
+		_ = out.Close()
+		_ = os.Remove(tmp)
+		return errors.Wrap(err, "failed to encode wave data")
+	}
+	if err := out.Close(); err != nil {
+		return errors.Wrap(err, "failed to close temporary file")
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return errors.Wrap(err, "failed to replace audio file")
+	}
+	return nil
+}
+
+// applyMP3Gain changes the loudness of an MP3 file without re-encoding by adjusting the
+// global_gain field of every Layer III granule, in steps of 1.5 dB.
+func applyMP3Gain(path string, data []byte, gain float64) error {
+	steps := int(math.Floor(gain/mp3GainStepDB + 0.5))
+	if steps == 0 {
+		return nil
+	}
+
+	var buf bytes.Buffer
+	reader := bytes.NewReader(data)
+	for {
+		obj := mp3lib.NextObject(reader)
+		if obj == nil {
+			break
+		}
+		switch obj := obj.(type) {
+		case *mp3lib.MP3Frame:
+			if !mp3lib.IsXingHeader(obj) && !mp3lib.IsVbriHeader(obj) {
+				adjustGlobalGain(obj, steps)
+			}
+			buf.Write(obj.RawBytes)
+		case *mp3lib.ID3v1Tag:
+			buf.Write(obj.RawBytes)
+		case *mp3lib.ID3v2Tag:
+			buf.Write(obj.RawBytes)
+		}
+	}
+	if err := os.WriteFile(path, buf.Bytes(), 0o644); err != nil {
+		return errors.Wrap(err, "failed to write audio file")
+	}
+	return nil
+}
+
+// adjustGlobalGain adds steps to the global_gain of each granule and channel in the
+// frame's side information, recomputing the CRC when the frame is protected.
+func adjustGlobalGain(frame *mp3lib.MP3Frame, steps int) {
+	if frame.MPEGLayer != mp3lib.MPEGLayerIII {
+		return
+	}
+	raw := frame.RawBytes
+	offset := 4
+	if frame.CrcProtection {
+		offset += 2
+	}
+	channels := 2
+	if frame.ChannelMode == mp3lib.Mono {
+		channels = 1
+	}
+
+	var pos, granules, entryBits, sideInfoSize int
+	if frame.MPEGVersion == mp3lib.MPEGVersion1 {
+		// main_data_begin, private_bits and scfsi
+		privateBits := 3
+		if channels == 1 {
+			privateBits = 5
+		}
+		pos = 9 + privateBits + 4*channels
+		granules, entryBits = 2, 59
+		sideInfoSize = 32
+		if channels == 1 {
+			sideInfoSize = 17
+		}
+	} else {
+		pos = 8 + channels
+		granules, entryBits = 1, 63
+		sideInfoSize = 17
+		if channels == 1 {
+			sideInfoSize = 9
+		}
+	}
+	if len(raw) < offset+sideInfoSize {
+		return
+	}
+
+	for range granules {
+		for range channels {
+			// global_gain follows part2_3_length (12 bits) and big_values (9 bits).
+			bit := offset*8 + pos + 12 + 9
+			value := int(readBits(raw, bit, 8)) + steps
+			value = min(max(value, 0), 255)
+			writeBits(raw, bit, 8, uint32(value))
+			pos += entryBits
+		}
+	}
+
+	if frame.CrcProtection {
+		protected := append([]byte{raw[2], raw[3]}, raw[offset:offset+sideInfoSize]...)
+		crc := crc16(protected)
+		raw[4], raw[5] = byte(crc>>8), byte(crc)
+	}
+}
+
+func readBits(data []byte, pos, n int) uint32 {
+	var v uint32
+	for i := range n {
+		b := data[(pos+i)/8] >> (7 - uint((pos+i)%8)) & 1
+		v = v<<1 | uint32(b)
+	}
+	return v
+}
+
+func writeBits(data []byte, pos, n int, v uint32) {
+	for i := range n {
+		idx, shift := (pos+i)/8, 7-uint((pos+i)%8)
+		bit := byte(v>>(uint(n-1-i))) & 1
+		data[idx] = data[idx]&^(1<<shift) | bit<<shift
+	}
+}
+
+// crc16 computes the CRC-16 (polynomial 0x8005) used by MPEG audio frames.
+func crc16(data []byte) uint16 {
+	crc := uint16(0xffff)
+	for _, b := range data {
+		for i := 7; i >= 0; i-- {
+			bit := (b>>uint(i))&1 == 1
+			top := crc&0x8000 != 0
+			crc <<= 1
+			if top != bit {
+				crc ^= 0x8005
+			}
+		}
+	}
+	return crc
+}
+
+func isWav(data []byte) bool {
+	return len(data) >= 12 && string(data[:4]) == "RIFF" && string(data[8:12]) == "WAVE"
+}
+
+func decodeAudio(data []byte) (beep.StreamSeekCloser, beep.Format, error) {
+	if isWav(data) {
+		s, format, err := wav.Decode(bytes.NewReader(data))
+		if err != nil {
+			return nil, format, errors.Wrap(err, "failed to decode wave data")
+		}
+		return s, format, nil
+	}
+	s, format, err := mp3.Decode(io.NopCloser(bytes.NewReader(data)))
+	if err != nil {
+		return nil, format, errors.Wrap(err, "failed to decode mp3 data")
+	}
+	return s, format, nil
+}
+
+func gatedLoudness(powers []float64) float64 {
+	gated := make([]float64, 0, len(powers))
+	for _, p := range powers {
+		if powerToLUFS(p) > absoluteGateLUFS {
+			gated = append(gated, p)
+		}
+	}
+	if len(gated) == 0 {
+		return math.Inf(-1)
+	}
+
+	threshold := powerToLUFS(mean(gated)) + relativeGateLU
+	var sum float64
+	var n int
+	for _, p := range gated {
+		if powerToLUFS(p) > threshold {
+			sum += p
+			n++
+		}
+	}
+	if n == 0 {
+		return math.Inf(-1)
+	}
+	return powerToLUFS(sum / float64(n))
+}
+
+func powerToLUFS(p float64) float64 {
+	return -0.691 + 10*math.Log10(p)
+}
+
+func mean(values []float64) float64 {
+	var sum float64
+	for _, v := range values {
+		sum += v
+	}
+	return sum / float64(len(values))
+}
+
+// biquad is a second order IIR filter in transposed direct form II.
+type biquad struct {
+	b0, b1, b2, a1, a2 float64
+	z1, z2             float64
+}
+
+func (f *biquad) process(x float64) float64 {
+	y := f.b0*x + f.z1
+	f.z1 = f.b1*x - f.a1*y + f.z2
+	f.z2 = f.b2*x - f.a2*y
+	return y
+}
+
+// kWeighting is the BS.1770 pre-filter: a high shelf followed by a high pass filter.
+type kWeighting struct {
+	shelf    biquad
+	highpass biquad
+}
+
+func newKWeighting(sampleRate float64) *kWeighting {
+	// High shelf: +4dB above ~1.5kHz.
+	a := math.Pow(10, 4.0/40)
+	w0 := 2 * math.Pi * 1500 / sampleRate
+	alpha := math.Sin(w0) / (2 * (1 / math.Sqrt2))
+	cosw := math.Cos(w0)
+	sqrtA := math.Sqrt(a)
+	a0 := (a + 1) - (a-1)*cosw + 2*sqrtA*alpha
+	shelf := biquad{
+		b0: a * ((a + 1) + (a-1)*cosw + 2*sqrtA*alpha) / a0,
+		b1: -2 * a * ((a - 1) + (a+1)*cosw) / a0,
+		b2: a * ((a + 1) + (a-1)*cosw - 2*sqrtA*alpha) / a0,
+		a1: 2 * ((a - 1) - (a+1)*cosw) / a0,
+		a2: ((a + 1) - (a-1)*cosw - 2*sqrtA*alpha) / a0,
+	}
+
+	// High pass: ~38Hz, Q=0.5.
+	w0 = 2 * math.Pi * 38 / sampleRate
+	alpha = math.Sin(w0) / (2 * 0.5)
+	cosw = math.Cos(w0)
+	a0 = 1 + alpha
+	highpass := biquad{
+		b0: (1 + cosw) / 2 / a0,
+		b1: -(1 + cosw) / a0,
+		b2: (1 + cosw) / 2 / a0,
+		a1: -2 * cosw / a0,
+		a2: (1 - alpha) / a0,
+	}
+
+	return &kWeighting{shelf: shelf, highpass: highpass}
+}
+
+func (k *kWeighting) process(x float64) float64 {
+	return k.highpass.process(k.shelf.process(x))
+}
@@ -0,0 +1,98 @@
+package tts
+
+import (
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/dmulholl/mp3lib"
+	"github.com/gopxl/beep/v2"
+	"github.com/gopxl/beep/v2/wav"
+	"github.com/mopemope/quicknews/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeSineWav(t *testing.T, path string, amplitude float64) {
+	t.Helper()
+	format := beep.Format{SampleRate: 48000, NumChannels: 1, Precision: 2}
+	total := format.SampleRate.N(3 * time.Second)
+	pos := 0
+	sine := beep.StreamerFunc(func(samples [][2]float64) (int, bool) {
+		if pos >= total {
+			return 0, false
+		}
+		n := min(len(samples), total-pos)
+		for i := range n {
+			v := amplitude * math.Sin(2*math.Pi*1000*float64(pos+i)/48000)
+			samples[i] = [2]float64{v, v}
+		}
+		pos += n
+		return n, true
+	})
+
+	f, err := os.Create(path)
+	require.NoError(t, err)
+	require.NoError(t, wav.Encode(f, sine, format))
+	require.NoError(t, f.Close())
+}
+
+func TestMeasureLoudness_Sine(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sine.wav")
+	writeSineWav(t, path, 1.0)
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	stats, err := MeasureLoudness(data)
+	require.NoError(t, err)
+	// BS.1770: a 0 dBFS 1kHz sine on a single channel reads -3.01 LUFS.
+	assert.InDelta(t, -3.01, stats.Integrated, 0.1)
+	assert.InDelta(t, 0.0, stats.Peak, 0.1)
+}
+
+func TestNormalizeLoudness_NativeWav(t *testing.T) {
+	orig := FFmpegBin
+	FFmpegBin = filepath.Join(t.TempDir(), "no-ffmpeg")
+	defer func() { FFmpegBin = orig }()
+
+	path := filepath.Join(t.TempDir(), "sine.wav")
+	writeSineWav(t, path, 0.05)
+
+	cfg := &config.Loudness{TargetLUFS: -16, TruePeak: -1.5}
+	require.NoError(t, NormalizeLoudness(path, cfg))
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	stats, err := MeasureLoudness(data)
+	require.NoError(t, err)
+	assert.InDelta(t, -16, stats.Integrated, 0.2)
+}
+
+func TestAdjustGlobalGain(t *testing.T) {
+	// MPEG1 Layer III, 128kbps, 44.1kHz, mono, no CRC.
+	raw := make([]byte, 417)
+	copy(raw, []byte{0xff, 0xfb, 0x90, 0xc4})
+	frame := &mp3lib.MP3Frame{
+		MPEGVersion: mp3lib.MPEGVersion1,
+		MPEGLayer:   mp3lib.MPEGLayerIII,
+		ChannelMode: mp3lib.Mono,
+		RawBytes:    raw,
+	}
+
+	// Side info for mono: 9 + 5 + 4 bits, then 59 bits per granule.
+	first := 4*8 + 18 + 21
+	second := first + 59
+	writeBits(raw, first, 8, 100)
+	writeBits(raw, second, 8, 254)
+
+	adjustGlobalGain(frame, 3)
+	assert.Equal(t, uint32(103), readBits(raw, first, 8))
+	assert.Equal(t, uint32(255), readBits(raw, second, 8))
+
+	adjustGlobalGain(frame, -5)
+	assert.Equal(t, uint32(98), readBits(raw, first, 8))
+	assert.Equal(t, uint32(250), readBits(raw, second, 8))
+}
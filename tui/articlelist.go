@@ -1,9 +1,11 @@
 package tui
 
 import (
+	"cmp"
 	"context"
 	"fmt"
 	"log/slog"
+	"slices"
 	"time"
 
 	"github.com/charmbracelet/bubbles/list"
@@ -12,6 +14,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/mopemope/quicknews/config"
 	"github.com/mopemope/quicknews/ent"
+	"github.com/mopemope/quicknews/exporter"
 	"github.com/mopemope/quicknews/models/article"
 	"github.com/mopemope/quicknews/models/feed"
 	"github.com/mopemope/quicknews/models/summary"
@@ -40,6 +43,7 @@ type articleItem struct {
 	link         string
 	summaryTitle string
 	summaryCount int
+	priority     int
 	isBookmark   bool
 }
 
@@ -56,6 +60,9 @@ func (i articleItem) Title() string {
 		title = fmt.Sprintf("%s (%s)", title, i.publishedAt.Local().Format("2006-01-02 15:04"))
 		stitle = fmt.Sprintf("%s [%d] (%s)", stitle, i.summaryCount, i.publishedAt.Local().Format("2006-01-02 15:04"))
 	}
+	if i.priority > 0 {
+		stitle = "★ " + stitle
+	}
 	return fmt.Sprintf("%s\n%s", stitle, title)
 }
 
@@ -72,7 +79,7 @@ func newArticleListModel(client *ent.Client, config *config.Config) articleListM
 	return articleListModel{
 		feedRepos:     feed.NewRepository(client),
 		repos:         article.NewRepository(client),
-		summaryRepos:  summary.NewRepository(client),
+		summaryRepos:  exporter.WithExport(summary.NewRepository(client), exporter.New(config)),
 		list:          l,
 		confirmDialog: components.NewConfirmationDialog(),
 		config:        config,
@@ -115,10 +122,11 @@ func (m *articleListModel) fetchArticlesCmd() tea.Cmd {
 				publishedAtPtr = &a.PublishedAt
 			}
 			summaryTitle := a.Title
-			count := 0
+			count, priority := 0, 0
 			if a.Edges.Summary != nil {
 				summaryTitle = a.Edges.Summary.Title
 				count = len([]rune(a.Edges.Summary.Summary))
+				priority = a.Edges.Summary.Priority
 			}
 			items[i] = articleItem{
 				id:           a.ID,
@@ -127,9 +135,14 @@ func (m *articleListModel) fetchArticlesCmd() tea.Cmd {
 				link:         a.URL,
 				summaryTitle: summaryTitle,
 				summaryCount: count,
+				priority:     priority,
 				isBookmark:   a.Edges.Feed != nil && a.Edges.Feed.IsBookmark,
 			}
 		}
+		// Articles prioritized by rules come first.
+		slices.SortStableFunc(items, func(a, b list.Item) int {
+			return cmp.Compare(b.(articleItem).priority, a.(articleItem).priority)
+		})
 		return items // Return fetched items as message
 	}
 }
@@ -28,13 +28,30 @@ type feedItem struct {
 	url         string
 	isBookmark  bool
 	unreadCount int // 未読記事数を保持するフィールドを追加
+	failures    int
+	lastError   string
+	disabled    bool
 }
 
 func (i feedItem) Title() string {
 	// 未読記事数をタイトルに含めて表示
-	return fmt.Sprintf("%s (%d)", i.title, i.unreadCount)
+	title := fmt.Sprintf("%s (%d)", i.title, i.unreadCount)
+	switch {
+	case i.disabled:
+		title = "⊘ " + title
+	case i.failures > 0:
+		title = fmt.Sprintf("⚠ %s [%d failures]", title, i.failures)
+	}
+	return title
+}
+
+func (i feedItem) Description() string {
+	// Show the error of broken feeds instead of the URL
+	if i.failures > 0 && i.lastError != "" {
+		return i.lastError
+	}
+	return i.url
 }
-func (i feedItem) Description() string { return i.url } // Show URL in description for now
 func (i feedItem) FilterValue() string { return i.title }
 
 func newFeedListModel(client *ent.Client) feedListModel {
@@ -71,6 +88,9 @@ func (m *feedListModel) fetchFeedsCmd() tea.Msg {
 			url:         f.URL,
 			isBookmark:  f.IsBookmark,
 			unreadCount: unreadCount, // 未読記事数をセット
+			failures:    f.ConsecutiveFailures,
+			lastError:   f.LastError,
+			disabled:    f.Disabled,
 		}
 	}
 	return items // Return fetched items as message
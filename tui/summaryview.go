@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"strings"
 
 	"github.com/charmbracelet/bubbles/viewport"
 	tea "github.com/charmbracelet/bubbletea"
@@ -11,6 +12,7 @@ import (
 	"github.com/cockroachdb/errors"
 	"github.com/mopemope/quicknews/config"
 	"github.com/mopemope/quicknews/ent"
+	"github.com/mopemope/quicknews/exporter"
 	"github.com/mopemope/quicknews/models/article"
 	"github.com/mopemope/quicknews/models/bookmark"
 	"github.com/mopemope/quicknews/models/summary"
@@ -39,7 +41,7 @@ func newSummaryViewModel(client *ent.Client, config *config.Config) summaryViewM
 	bookmarkRepos, _ := bookmark.NewRepository(context.Background(), client, config)
 	return summaryViewModel{
 		viewport:      vp,
-		summaryRepos:  summary.NewRepository(client),
+		summaryRepos:  exporter.WithExport(summary.NewRepository(client), exporter.New(config)),
 		articleRepos:  article.NewRepository(client), // Initialize ArticleRepository
 		confirmDialog: components.NewConfirmationDialog(),
 		config:        config,
@@ -66,6 +68,9 @@ func (m *summaryViewModel) SetContent(article *ent.Article, width, height int) t
 		}
 
 		content = fmt.Sprintf("\n%s", summaryText)
+		if media := mediaView(article); media != "" {
+			content = fmt.Sprintf("\n%s\n%s", media, summaryText)
+		}
 	}
 
 	m.viewport.SetContent(content)
@@ -74,6 +79,53 @@ func (m *summaryViewModel) SetContent(article *ent.Article, width, height int) t
 	return nil
 }
 
+// mediaView renders the author, categories, image and enclosures of the article, or an
+// empty string when the feed gave none of them.
+func mediaView(article *ent.Article) string {
+	var b strings.Builder
+	if article.Author != "" {
+		fmt.Fprintf(&b, "Author: %s\n", article.Author)
+	}
+	if len(article.Categories) > 0 {
+		fmt.Fprintf(&b, "Categories: %s\n", strings.Join(article.Categories, ", "))
+	}
+	if article.ImageURL != "" {
+		fmt.Fprintf(&b, "Image: %s\n", article.ImageURL)
+	}
+	for _, enc := range article.Enclosures {
+		var info []string
+		if enc.Type != "" {
+			info = append(info, enc.Type)
+		}
+		if enc.Length > 0 {
+			info = append(info, formatSize(enc.Length))
+		}
+		if len(info) > 0 {
+			fmt.Fprintf(&b, "Media: %s (%s)\n", enc.URL, strings.Join(info, ", "))
+		} else {
+			fmt.Fprintf(&b, "Media: %s\n", enc.URL)
+		}
+	}
+	if b.Len() == 0 {
+		return ""
+	}
+	return mediaStyle.Render(strings.TrimSuffix(b.String(), "\n"))
+}
+
+// formatSize formats a number of bytes for display.
+func formatSize(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := int64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
+
 func (m summaryViewModel) Init() tea.Cmd {
 	slog.Debug("SummaryView model Init called")
 	return nil // Content is set via SetContent
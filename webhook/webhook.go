@@ -0,0 +1,300 @@
+// Package webhook posts summary events to the configured HTTP endpoints.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/mopemope/quicknews/clock"
+	"github.com/mopemope/quicknews/config"
+	"github.com/mopemope/quicknews/ent"
+	"github.com/mopemope/quicknews/ent/schema"
+)
+
+// Event is the kind of change a webhook is fired for.
+type Event string
+
+const (
+	EventCreated    Event = "created"    // A summary of a feed article was created
+	EventBookmarked Event = "bookmarked" // A bookmarked page was summarized
+	EventPublished  Event = "published"  // A podcast episode was published
+)
+
+// SignatureHeader carries the hex encoded HMAC-SHA256 of the body, prefixed with "sha256=".
+const SignatureHeader = "X-Quicknews-Signature"
+
+// EventHeader carries the event name.
+const EventHeader = "X-Quicknews-Event"
+
+// Payload is the JSON body of a webhook and the data of a body template.
+type Payload struct {
+	Event     Event     `json:"event"`
+	Timestamp time.Time `json:"timestamp"`
+	Feed      *Feed     `json:"feed,omitempty"`
+	Article   *Article  `json:"article,omitempty"`
+	Summary   *Summary  `json:"summary,omitempty"`
+	Episode   *Episode  `json:"episode,omitempty"`
+	AudioURL  string    `json:"audio_url,omitempty"`
+
+	audioFile string // Linked below the audio_base_url of each webhook
+}
+
+type Feed struct {
+	ID    string `json:"id"`
+	Title string `json:"title"`
+	URL   string `json:"url"`
+	Link  string `json:"link"`
+}
+
+type Article struct {
+	Title       string             `json:"title"`
+	URL         string             `json:"url"`
+	PublishedAt time.Time          `json:"published_at"`
+	Author      string             `json:"author,omitempty"`
+	Categories  []string           `json:"categories,omitempty"`
+	ImageURL    string             `json:"image_url,omitempty"`
+	Enclosures  []schema.Enclosure `json:"enclosures,omitempty"`
+}
+
+type Summary struct {
+	ID       string   `json:"id"`
+	Title    string   `json:"title"`
+	URL      string   `json:"url"`
+	Summary  string   `json:"summary"`
+	Tags     []string `json:"tags,omitempty"`
+	Priority int      `json:"priority,omitempty"`
+}
+
+// Episode is a published podcast episode with the summaries it is made of.
+type Episode struct {
+	Title     string     `json:"title"`
+	URL       string     `json:"url"`
+	Summaries []*Summary `json:"summaries"`
+}
+
+// NewSummaryPayload builds the payload of a summary, with its feed and article when the
+// edges are loaded.
+func NewSummaryPayload(event Event, sum *ent.Summary) *Payload {
+	p := &Payload{
+		Event:     event,
+		Timestamp: clock.Now(),
+		Summary:   newSummary(sum),
+		audioFile: sum.AudioFile,
+	}
+	if f := sum.Edges.Feed; f != nil {
+		p.Feed = newFeed(f)
+	}
+	if a := sum.Edges.Article; a != nil {
+		p.Article = &Article{
+			Title:       a.Title,
+			URL:         a.URL,
+			PublishedAt: a.PublishedAt,
+			Author:      a.Author,
+			Categories:  a.Categories,
+			ImageURL:    a.ImageURL,
+			Enclosures:  a.Enclosures,
+		}
+	}
+	return p
+}
+
+// NewEpisodePayload builds the payload of a published episode. feed is nil for an
+// episode combining several feeds.
+func NewEpisodePayload(feed *ent.Feed, title, url string, sums []*ent.Summary) *Payload {
+	episode := &Episode{Title: title, URL: url, Summaries: make([]*Summary, len(sums))}
+	for i, sum := range sums {
+		episode.Summaries[i] = newSummary(sum)
+	}
+	p := &Payload{
+		Event:     EventPublished,
+		Timestamp: clock.Now(),
+		Episode:   episode,
+		AudioURL:  url,
+	}
+	if feed != nil {
+		p.Feed = newFeed(feed)
+	}
+	return p
+}
+
+func newFeed(f *ent.Feed) *Feed {
+	return &Feed{ID: f.ID.String(), Title: f.Title, URL: f.URL, Link: f.Link}
+}
+
+func newSummary(sum *ent.Summary) *Summary {
+	return &Summary{
+		ID:       sum.ID.String(),
+		Title:    sum.Title,
+		URL:      sum.URL,
+		Summary:  sum.Summary,
+		Tags:     sum.Tags,
+		Priority: sum.Priority,
+	}
+}
+
+// hook is a configured webhook with its parsed body template.
+type hook struct {
+	config *config.Webhook
+	tmpl   *template.Template
+	err    error
+}
+
+// Dispatcher delivers payloads to the webhooks subscribed to their event.
+type Dispatcher struct {
+	hooks   []*hook
+	client  *http.Client
+	backoff time.Duration // Wait before the first retry, doubled for each further retry
+}
+
+func New(config *config.Config) *Dispatcher {
+	d := &Dispatcher{
+		client:  &http.Client{Timeout: 30 * time.Second},
+		backoff: time.Second,
+	}
+	for _, c := range config.Webhooks {
+		h := &hook{config: c}
+		if c.Template != "" {
+			h.tmpl, h.err = template.New(c.Name).Funcs(template.FuncMap{
+				"json": toJSON,
+			}).Parse(c.Template)
+			if h.err != nil {
+				h.err = errors.Wrapf(h.err, "failed to parse template of webhook %s", c.Name)
+			}
+		}
+		d.hooks = append(d.hooks, h)
+	}
+	return d
+}
+
+// Summary fires the event of a summary with its feed and article edges loaded.
+func (d *Dispatcher) Summary(ctx context.Context, event Event, sum *ent.Summary) error {
+	if len(d.hooks) == 0 {
+		return nil
+	}
+	return d.Dispatch(ctx, NewSummaryPayload(event, sum))
+}
+
+// Dispatch posts the payload to every webhook subscribed to its event, even when one of
+// them fails, and returns the combined errors.
+func (d *Dispatcher) Dispatch(ctx context.Context, payload *Payload) error {
+	var errs error
+	for _, h := range d.hooks {
+		if len(h.config.Events) > 0 && !slices.Contains(h.config.Events, string(payload.Event)) {
+			continue
+		}
+		if err := d.deliver(ctx, h, payload); err != nil {
+			errs = errors.CombineErrors(errs, errors.Wrapf(err, "failed to deliver webhook %s", h.config.Name))
+		}
+	}
+	return errs
+}
+
+func (d *Dispatcher) deliver(ctx context.Context, h *hook, payload *Payload) error {
+	if h.err != nil {
+		return h.err
+	}
+	p := *payload
+	if p.AudioURL == "" && p.audioFile != "" && h.config.AudioBaseURL != "" {
+		p.AudioURL = strings.TrimSuffix(h.config.AudioBaseURL, "/") + "/" + p.audioFile
+	}
+	body, err := h.body(&p)
+	if err != nil {
+		return err
+	}
+
+	wait := d.backoff
+	for attempt := 0; ; attempt++ {
+		retry, err := d.post(ctx, h.config, p.Event, body)
+		if err == nil {
+			return nil
+		}
+		if !retry || attempt >= h.config.MaxRetries {
+			return err
+		}
+		slog.Warn("Retrying webhook", "name", h.config.Name, "attempt", attempt+1, "error", err)
+		select {
+		case <-ctx.Done():
+			return errors.CombineErrors(err, ctx.Err())
+		case <-time.After(wait):
+		}
+		wait *= 2
+	}
+}
+
+// body renders the template of the webhook, or the JSON payload when it has none.
+func (h *hook) body(p *Payload) ([]byte, error) {
+	if h.tmpl == nil {
+		body, err := json.Marshal(p)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to marshal payload")
+		}
+		return body, nil
+	}
+	var buf bytes.Buffer
+	if err := h.tmpl.Execute(&buf, p); err != nil {
+		return nil, errors.Wrapf(err, "failed to render template of webhook %s", h.config.Name)
+	}
+	return buf.Bytes(), nil
+}
+
+// post sends the body once and reports whether a failure is worth retrying.
+func (d *Dispatcher) post(ctx context.Context, c *config.Webhook, event Event, body []byte) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.URL, bytes.NewReader(body))
+	if err != nil {
+		return false, errors.Wrap(err, "failed to create request")
+	}
+	req.Header.Set("Content-Type", c.ContentType)
+	req.Header.Set("User-Agent", "quicknews")
+	req.Header.Set(EventHeader, string(event))
+	for k, v := range c.Headers {
+		req.Header.Set(k, v)
+	}
+	if c.Secret != "" {
+		req.Header.Set(SignatureHeader, Sign(c.Secret, body))
+	}
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return true, errors.Wrap(err, "failed to send request")
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return false, nil
+	}
+	retry := resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500
+	return retry, errors.Newf("unexpected status %s", resp.Status)
+}
+
+// Sign returns the value of the signature header of a body.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// toJSON returns v as JSON, for embedding values in JSON body templates.
+func toJSON(v any) (string, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(buf.String(), "\n"), nil
+}
@@ -0,0 +1,151 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mopemope/quicknews/config"
+	"github.com/mopemope/quicknews/ent"
+	"github.com/mopemope/quicknews/ent/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type request struct {
+	header http.Header
+	body   []byte
+}
+
+// recorder is an endpoint answering with the given status codes in turn, then 200.
+func recorder(t *testing.T, statuses ...int) (*httptest.Server, func() []request) {
+	t.Helper()
+	var mu sync.Mutex
+	var requests []request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		mu.Lock()
+		defer mu.Unlock()
+		requests = append(requests, request{header: r.Header.Clone(), body: body})
+		if len(requests) <= len(statuses) {
+			w.WriteHeader(statuses[len(requests)-1])
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server, func() []request {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]request(nil), requests...)
+	}
+}
+
+func testSummary() *ent.Summary {
+	sum := &ent.Summary{
+		ID:        uuid.New(),
+		Title:     "Summary title",
+		URL:       "https://example.com/article",
+		Summary:   "A \"quoted\" summary.",
+		AudioFile: "audio.mp3",
+	}
+	sum.Edges.Feed = &ent.Feed{ID: uuid.New(), Title: "Example", URL: "https://example.com/feed"}
+	sum.Edges.Article = &ent.Article{
+		Title:      "Article title",
+		URL:        "https://example.com/article",
+		Enclosures: []schema.Enclosure{{URL: "https://example.com/episode.mp3", Type: "audio/mpeg"}},
+	}
+	return sum
+}
+
+func TestDispatcher_JSONPayload(t *testing.T) {
+	server, requests := recorder(t)
+	d := New(&config.Config{Webhooks: []*config.Webhook{{
+		Name:         "json",
+		URL:          server.URL,
+		Secret:       "s3cret",
+		ContentType:  "application/json",
+		AudioBaseURL: "https://cdn.example.com/audio/",
+	}}})
+
+	require.NoError(t, d.Summary(context.Background(), EventCreated, testSummary()))
+
+	reqs := requests()
+	require.Len(t, reqs, 1)
+	assert.Equal(t, "created", reqs[0].header.Get(EventHeader))
+	assert.Equal(t, Sign("s3cret", reqs[0].body), reqs[0].header.Get(SignatureHeader))
+
+	var p Payload
+	require.NoError(t, json.Unmarshal(reqs[0].body, &p))
+	assert.Equal(t, EventCreated, p.Event)
+	assert.Equal(t, "Example", p.Feed.Title)
+	assert.Equal(t, "Article title", p.Article.Title)
+	assert.Equal(t, "https://example.com/episode.mp3", p.Article.Enclosures[0].URL)
+	assert.Equal(t, "Summary title", p.Summary.Title)
+	assert.Equal(t, "https://cdn.example.com/audio/audio.mp3", p.AudioURL)
+}
+
+func TestDispatcher_Template(t *testing.T) {
+	server, requests := recorder(t)
+	d := New(&config.Config{Webhooks: []*config.Webhook{{
+		Name:        "slack",
+		URL:         server.URL,
+		Template:    `{"text": {{json (printf "%s\n%s" .Summary.Title .Summary.Summary)}}}`,
+		ContentType: "application/json",
+	}}})
+
+	require.NoError(t, d.Summary(context.Background(), EventBookmarked, testSummary()))
+
+	reqs := requests()
+	require.Len(t, reqs, 1)
+	assert.Empty(t, reqs[0].header.Get(SignatureHeader))
+	var body map[string]string
+	require.NoError(t, json.Unmarshal(reqs[0].body, &body))
+	assert.Equal(t, "Summary title\nA \"quoted\" summary.", body["text"])
+}
+
+func TestDispatcher_Events(t *testing.T) {
+	server, requests := recorder(t)
+	d := New(&config.Config{Webhooks: []*config.Webhook{{
+		Name:   "published-only",
+		URL:    server.URL,
+		Events: []string{"published"},
+	}}})
+
+	require.NoError(t, d.Summary(context.Background(), EventCreated, testSummary()))
+	assert.Empty(t, requests())
+
+	payload := NewEpisodePayload(nil, "Daily", "https://example.com/daily.mp3", []*ent.Summary{testSummary()})
+	require.NoError(t, d.Dispatch(context.Background(), payload))
+	reqs := requests()
+	require.Len(t, reqs, 1)
+	var p Payload
+	require.NoError(t, json.Unmarshal(reqs[0].body, &p))
+	assert.Equal(t, "https://example.com/daily.mp3", p.AudioURL)
+	assert.Len(t, p.Episode.Summaries, 1)
+	assert.Nil(t, p.Feed)
+}
+
+func TestDispatcher_Retry(t *testing.T) {
+	server, requests := recorder(t, http.StatusServiceUnavailable, http.StatusInternalServerError)
+	d := New(&config.Config{Webhooks: []*config.Webhook{{Name: "flaky", URL: server.URL, MaxRetries: 3}}})
+	d.backoff = time.Millisecond
+
+	require.NoError(t, d.Summary(context.Background(), EventCreated, testSummary()))
+	assert.Len(t, requests(), 3)
+}
+
+func TestDispatcher_NoRetryOnClientError(t *testing.T) {
+	server, requests := recorder(t, http.StatusBadRequest)
+	d := New(&config.Config{Webhooks: []*config.Webhook{{Name: "strict", URL: server.URL, MaxRetries: 3}}})
+	d.backoff = time.Millisecond
+
+	err := d.Summary(context.Background(), EventCreated, testSummary())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "strict")
+	assert.Len(t, requests(), 1)
+}
@@ -0,0 +1,399 @@
+// Package websub subscribes to the WebSub (PubSubHubbub) hubs advertised by feeds and
+// receives the content they push, so that new items arrive without waiting for a poll.
+package websub
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"hash"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/google/uuid"
+	"github.com/mmcdole/gofeed"
+	"github.com/mopemope/quicknews/clock"
+	"github.com/mopemope/quicknews/config"
+	"github.com/mopemope/quicknews/ent"
+	"github.com/mopemope/quicknews/httpclient"
+	"github.com/mopemope/quicknews/models/feed"
+	"github.com/mopemope/quicknews/schedule"
+)
+
+// States of a subscription.
+const (
+	StatePending    = "pending"    // Requested, waiting for the hub to verify it
+	StateSubscribed = "subscribed" // Verified, the hub pushes new content
+	StateDenied     = "denied"     // Refused by the hub
+)
+
+const (
+	// renewInterval is how often the subscriptions are checked.
+	renewInterval = 10 * time.Minute
+	// renewBefore is how long before its lease expires a subscription is renewed.
+	renewBefore = time.Hour
+	// pendingTimeout is how long a request waits for its verification before it is sent again.
+	pendingTimeout = time.Hour
+	// deniedRetry is how long after a denial the subscription is requested again.
+	deniedRetry = 24 * time.Hour
+	// maxContentSize is the size of the largest pushed content accepted.
+	maxContentSize = 10 << 20
+)
+
+// Handler processes the content pushed for a feed. It is called while answering the hub, so
+// it queues the items rather than processing them.
+type Handler func(ctx context.Context, feed *ent.Feed, parsed *gofeed.Feed)
+
+// Subscriber keeps the feeds that advertise a hub subscribed, verifies the subscriptions
+// and hands the pushed content to its handler. It is an http.Handler serving the callbacks.
+type Subscriber struct {
+	feedRepos feed.FeedRepository
+	config    *config.Config
+	handler   Handler
+}
+
+func NewSubscriber(feedRepos feed.FeedRepository, config *config.Config, handler Handler) *Subscriber {
+	return &Subscriber{
+		feedRepos: feedRepos,
+		config:    config,
+		handler:   handler,
+	}
+}
+
+// Discover returns the hub and topic advertised by a feed, from the Link headers of its
+// response or else from the links of the document. The topic is empty when the feed does
+// not name itself; the feed URL is used then.
+func Discover(parsed *gofeed.Feed, header http.Header) (string, string) {
+	var hub, topic string
+	for _, value := range header.Values("Link") {
+		for _, link := range strings.Split(value, ",") {
+			href, rels, ok := parseLink(link)
+			if !ok {
+				continue
+			}
+			for _, rel := range rels {
+				switch {
+				case rel == "hub" && hub == "":
+					hub = href
+				case rel == "self" && topic == "":
+					topic = href
+				}
+			}
+		}
+	}
+	if hub == "" && parsed != nil {
+		hub = parsed.Custom[schedule.HubKey]
+	}
+	if topic == "" && parsed != nil {
+		topic = parsed.FeedLink
+	}
+	if hub == "" {
+		return "", ""
+	}
+	return hub, topic
+}
+
+// parseLink parses a link of a Link header: <href>; rel="hub self".
+func parseLink(link string) (string, []string, bool) {
+	parts := strings.Split(link, ";")
+	href := strings.TrimSpace(parts[0])
+	if !strings.HasPrefix(href, "<") || !strings.HasSuffix(href, ">") {
+		return "", nil, false
+	}
+	for _, param := range parts[1:] {
+		key, value, ok := strings.Cut(strings.TrimSpace(param), "=")
+		if ok && strings.EqualFold(strings.TrimSpace(key), "rel") {
+			return href[1 : len(href)-1], strings.Fields(strings.ToLower(strings.Trim(value, `"`))), true
+		}
+	}
+	return "", nil, false
+}
+
+// Subscribed reports whether the hub of the feed pushes its new content.
+func Subscribed(f *ent.Feed, now time.Time) bool {
+	return f.WebsubState == StateSubscribed && f.WebsubExpiresAt != nil && f.WebsubExpiresAt.After(now)
+}
+
+// topic returns the topic URL of the feed at its hub.
+func topic(f *ent.Feed) string {
+	if f.TopicURL != "" {
+		return f.TopicURL
+	}
+	return f.URL
+}
+
+// Run serves the callbacks and keeps the subscriptions renewed until the context is done.
+func (s *Subscriber) Run(ctx context.Context) error {
+	cfg := s.config.WebSub
+	if cfg == nil || cfg.CallbackURL == "" {
+		return errors.New("websub callback_url is not set")
+	}
+	server := &http.Server{Addr: cfg.Listen, Handler: s, ReadHeaderTimeout: 10 * time.Second}
+	go func() {
+		<-ctx.Done()
+		_ = server.Shutdown(context.Background())
+	}()
+	go func() {
+		ticker := time.NewTicker(renewInterval)
+		defer ticker.Stop()
+		for {
+			if err := s.Renew(ctx); err != nil {
+				slog.Error("Failed to renew WebSub subscriptions", "error", err)
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	slog.Info("Listening for WebSub callbacks", "addr", cfg.Listen, "callback", cfg.CallbackURL)
+	if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return errors.Wrap(err, "websub server failed")
+	}
+	return nil
+}
+
+// Renew requests the subscriptions of the feeds advertising a hub that are not subscribed,
+// whose lease expires soon or whose request was not verified in time, and unsubscribes
+// the disabled feeds.
+func (s *Subscriber) Renew(ctx context.Context) error {
+	feeds, err := s.feedRepos.All(ctx)
+	if err != nil {
+		return err
+	}
+	now := clock.Now()
+	var errs error
+	for _, f := range feeds {
+		if f.HubURL == "" || f.IsBookmark {
+			continue
+		}
+		if f.Disabled {
+			if f.WebsubState == StatePending || f.WebsubState == StateSubscribed {
+				errs = errors.CombineErrors(errs, s.Unsubscribe(ctx, f))
+			}
+			continue
+		}
+		due := f.WebsubExpiresAt == nil || !f.WebsubExpiresAt.After(now)
+		if f.WebsubState == StateSubscribed && f.WebsubExpiresAt != nil {
+			due = !f.WebsubExpiresAt.After(now.Add(renewBefore))
+		}
+		if due {
+			errs = errors.CombineErrors(errs, s.Subscribe(ctx, f))
+		}
+	}
+	return errs
+}
+
+// Subscribe asks the hub of the feed to push its content to the callback. A new
+// subscription is pending until the hub verifies it; a renewed one stays subscribed.
+func (s *Subscriber) Subscribe(ctx context.Context, f *ent.Feed) error {
+	secret := f.WebsubSecret
+	if secret == "" {
+		buf := make([]byte, 32)
+		if _, err := rand.Read(buf); err != nil {
+			return errors.Wrap(err, "failed to generate secret")
+		}
+		secret = hex.EncodeToString(buf)
+	}
+	form := url.Values{"hub.secret": {secret}}
+	if lease := s.config.WebSub.Lease; lease > 0 {
+		form.Set("hub.lease_seconds", strconv.Itoa(int(lease.Seconds())))
+	}
+	if err := s.request(ctx, f, "subscribe", form); err != nil {
+		return err
+	}
+	slog.Info("Requested WebSub subscription", "title", f.Title, "hub", f.HubURL)
+	now := clock.Now()
+	if Subscribed(f, now) {
+		return s.feedRepos.SetWebSub(ctx, f.ID, StateSubscribed, secret, f.WebsubExpiresAt)
+	}
+	retry := now.Add(pendingTimeout)
+	return s.feedRepos.SetWebSub(ctx, f.ID, StatePending, secret, &retry)
+}
+
+// Unsubscribe asks the hub of the feed to stop pushing its content.
+func (s *Subscriber) Unsubscribe(ctx context.Context, f *ent.Feed) error {
+	if err := s.request(ctx, f, "unsubscribe", url.Values{}); err != nil {
+		return err
+	}
+	slog.Info("Requested WebSub unsubscription", "title", f.Title, "hub", f.HubURL)
+	return s.feedRepos.SetWebSub(ctx, f.ID, "", "", nil)
+}
+
+func (s *Subscriber) request(ctx context.Context, f *ent.Feed, mode string, form url.Values) error {
+	form.Set("hub.mode", mode)
+	form.Set("hub.topic", topic(f))
+	form.Set("hub.callback", s.callback(f))
+
+	settings, err := httpclient.New(s.config, f.HubURL)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, f.HubURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return errors.Wrap(err, "failed to create request")
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	resp, err := settings.Client(time.Minute).Do(req)
+	if err != nil {
+		return errors.Wrapf(err, "failed to %s to %s", mode, f.HubURL)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return errors.Newf("failed to %s to %s: %s %s", mode, f.HubURL, resp.Status, strings.TrimSpace(string(body)))
+	}
+	return nil
+}
+
+// callback returns the callback URL of the feed.
+func (s *Subscriber) callback(f *ent.Feed) string {
+	return strings.TrimSuffix(s.config.WebSub.CallbackURL, "/") + "/" + f.ID.String()
+}
+
+// ServeHTTP answers the verification requests of the hubs and receives their content at
+// the callback URL of each feed.
+func (s *Subscriber) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:])
+	if err != nil {
+		http.NotFound(w, r)
+		return
+	}
+	f, err := s.feedRepos.GetByID(r.Context(), id)
+	if err != nil {
+		// Gone tells the hub to drop the subscription of a removed feed.
+		http.Error(w, "unknown feed", http.StatusGone)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		s.verify(w, r, f)
+	case http.MethodPost:
+		s.receive(w, r, f)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+// verify confirms the subscriptions the subscriber requested and records denials.
+func (s *Subscriber) verify(w http.ResponseWriter, r *http.Request, f *ent.Feed) {
+	ctx := r.Context()
+	q := r.URL.Query()
+	mode := q.Get("hub.mode")
+	if q.Get("hub.topic") != topic(f) {
+		http.NotFound(w, r)
+		return
+	}
+
+	switch mode {
+	case "subscribe":
+		if f.Disabled || f.WebsubState == "" {
+			http.NotFound(w, r)
+			return
+		}
+		lease, err := strconv.Atoi(q.Get("hub.lease_seconds"))
+		if err != nil || lease <= 0 {
+			http.Error(w, "invalid lease", http.StatusBadRequest)
+			return
+		}
+		expires := clock.Now().Add(time.Duration(lease) * time.Second)
+		if err := s.feedRepos.SetWebSub(ctx, f.ID, StateSubscribed, f.WebsubSecret, &expires); err != nil {
+			slog.Error("Failed to record WebSub subscription", "title", f.Title, "error", err)
+			http.Error(w, "internal error", http.StatusInternalServerError)
+			return
+		}
+		slog.Info("WebSub subscription verified", "title", f.Title, "hub", f.HubURL, "expires", expires)
+	case "unsubscribe":
+		if !f.Disabled && f.WebsubState != "" {
+			http.NotFound(w, r)
+			return
+		}
+	case "denied":
+		// Only a subscription requested by the subscriber can be denied
+		if f.WebsubState != StatePending && f.WebsubState != StateSubscribed {
+			http.NotFound(w, r)
+			return
+		}
+		retry := clock.Now().Add(deniedRetry)
+		if err := s.feedRepos.SetWebSub(ctx, f.ID, StateDenied, f.WebsubSecret, &retry); err != nil {
+			slog.Error("Failed to record WebSub denial", "title", f.Title, "error", err)
+		}
+		slog.Warn("WebSub subscription denied", "title", f.Title, "hub", f.HubURL, "reason", q.Get("hub.reason"))
+		w.WriteHeader(http.StatusOK)
+		return
+	default:
+		http.Error(w, "invalid mode", http.StatusBadRequest)
+		return
+	}
+	_, _ = io.WriteString(w, q.Get("hub.challenge"))
+}
+
+// receive accepts the content pushed by the hub and hands it to the handler. Content
+// failing the signature check is acknowledged but ignored.
+func (s *Subscriber) receive(w http.ResponseWriter, r *http.Request, f *ent.Feed) {
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxContentSize))
+	if err != nil {
+		http.Error(w, "failed to read content", http.StatusBadRequest)
+		return
+	}
+	w.WriteHeader(http.StatusAccepted)
+
+	if f.Disabled || f.WebsubState != StateSubscribed {
+		return
+	}
+	if f.WebsubSecret != "" && !Verify(f.WebsubSecret, r.Header.Get("X-Hub-Signature"), body) {
+		slog.Warn("Ignored WebSub content with an invalid signature", "title", f.Title)
+		return
+	}
+	parsed, err := schedule.NewParser().Parse(bytes.NewReader(body))
+	if err != nil {
+		slog.Warn("Failed to parse WebSub content", "title", f.Title, "error", err)
+		return
+	}
+	slog.Info("Received WebSub content", "title", f.Title, "items", len(parsed.Items))
+	s.handler(r.Context(), f, parsed)
+}
+
+// Verify checks the X-Hub-Signature of pushed content: method=hex HMAC of the body.
+func Verify(secret, signature string, body []byte) bool {
+	method, digest, ok := strings.Cut(signature, "=")
+	if !ok {
+		return false
+	}
+	var h func() hash.Hash
+	switch strings.ToLower(method) {
+	case "sha1":
+		h = sha1.New
+	case "sha256":
+		h = sha256.New
+	case "sha384":
+		h = sha512.New384
+	case "sha512":
+		h = sha512.New
+	default:
+		return false
+	}
+	expected, err := hex.DecodeString(digest)
+	if err != nil {
+		return false
+	}
+	mac := hmac.New(h, []byte(secret))
+	mac.Write(body)
+	return hmac.Equal(mac.Sum(nil), expected)
+}
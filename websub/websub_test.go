@@ -0,0 +1,231 @@
+package websub
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/mmcdole/gofeed"
+	"github.com/mopemope/quicknews/config"
+	"github.com/mopemope/quicknews/ent"
+	"github.com/mopemope/quicknews/ent/enttest"
+	"github.com/mopemope/quicknews/models/feed"
+	"github.com/mopemope/quicknews/schedule"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const atomContent = `<?xml version="1.0" encoding="utf-8"?>
+<feed xmlns="http://www.w3.org/2005/Atom">
+  <title>Example</title>
+  <id>https://example.com/feed</id>
+  <updated>2025-06-01T10:00:00Z</updated>
+  <link rel="hub" href="https://hub.example.com/"/>
+  <link rel="self" href="https://example.com/feed.atom"/>
+  <entry>
+    <title>Pushed entry</title>
+    <id>https://example.com/pushed</id>
+    <link href="https://example.com/pushed"/>
+    <updated>2025-06-01T10:00:00Z</updated>
+  </entry>
+</feed>`
+
+func TestDiscover(t *testing.T) {
+	parsed, err := schedule.NewParser().Parse(strings.NewReader(atomContent))
+	require.NoError(t, err)
+	hub, topic := Discover(parsed, http.Header{})
+	assert.Equal(t, "https://hub.example.com/", hub)
+	assert.Equal(t, "https://example.com/feed.atom", topic)
+
+	rss := `<?xml version="1.0"?>
+<rss version="2.0" xmlns:atom="http://www.w3.org/2005/Atom">
+<channel>
+  <title>Example</title>
+  <atom:link rel="hub" href="https://pubsubhubbub.appspot.com/"/>
+  <atom:link rel="self" href="https://example.com/rss"/>
+</channel>
+</rss>`
+	parsed, err = schedule.NewParser().Parse(strings.NewReader(rss))
+	require.NoError(t, err)
+	hub, topic = Discover(parsed, http.Header{})
+	assert.Equal(t, "https://pubsubhubbub.appspot.com/", hub)
+	assert.Equal(t, "https://example.com/rss", topic)
+
+	header := http.Header{}
+	header.Add("Link", `<https://example.com/style.css>; rel="stylesheet", <https://hub.example.org/>; rel="hub"`)
+	header.Add("Link", `<https://example.com/topic>; rel=self`)
+	hub, topic = Discover(parsed, header)
+	assert.Equal(t, "https://hub.example.org/", hub, "the Link header comes first")
+	assert.Equal(t, "https://example.com/topic", topic)
+
+	hub, topic = Discover(&gofeed.Feed{FeedLink: "https://example.com/rss"}, http.Header{})
+	assert.Empty(t, hub)
+	assert.Empty(t, topic)
+}
+
+func TestVerify(t *testing.T) {
+	body := []byte("content")
+	mac := hmac.New(sha256.New, []byte("s3cret"))
+	mac.Write(body)
+	signature := "sha256=" + hex.EncodeToString(mac.Sum(nil))
+
+	assert.True(t, Verify("s3cret", signature, body))
+	assert.False(t, Verify("other", signature, body))
+	assert.False(t, Verify("s3cret", signature, []byte("tampered")))
+	assert.False(t, Verify("s3cret", "md5=abcd", body))
+	assert.False(t, Verify("s3cret", "", body))
+}
+
+func TestSubscriber(t *testing.T) {
+	client := enttest.Open(t, dialect.SQLite, "file:websub?mode=memory&cache=shared&_fk=1")
+	defer func() { _ = client.Close() }()
+	ctx := context.Background()
+	repo := feed.NewRepository(client)
+
+	var mu sync.Mutex
+	var requests []url.Values
+	hub := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		mu.Lock()
+		requests = append(requests, r.PostForm)
+		mu.Unlock()
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer hub.Close()
+
+	require.NoError(t, repo.Save(ctx, &feed.FeedInput{URL: "https://example.com/feed", Title: "Example"}, false))
+	f, err := repo.GetByURL(ctx, "https://example.com/feed")
+	require.NoError(t, err)
+	require.NoError(t, repo.SetHub(ctx, f.ID, hub.URL, "https://example.com/feed.atom"))
+
+	pushed := make(chan *gofeed.Feed, 1)
+	cfg := &config.Config{WebSub: &config.WebSub{Lease: 48 * time.Hour}}
+	subscriber := NewSubscriber(repo, cfg, func(ctx context.Context, f *ent.Feed, parsed *gofeed.Feed) {
+		pushed <- parsed
+	})
+	callback := httptest.NewServer(subscriber)
+	defer callback.Close()
+	cfg.WebSub.CallbackURL = callback.URL + "/websub/"
+
+	// Subscription request
+	require.NoError(t, subscriber.Renew(ctx))
+	require.Len(t, requests, 1)
+	form := requests[0]
+	assert.Equal(t, "subscribe", form.Get("hub.mode"))
+	assert.Equal(t, "https://example.com/feed.atom", form.Get("hub.topic"))
+	assert.Equal(t, callback.URL+"/websub/"+f.ID.String(), form.Get("hub.callback"))
+	assert.Equal(t, "172800", form.Get("hub.lease_seconds"))
+	secret := form.Get("hub.secret")
+	require.NotEmpty(t, secret)
+
+	f, err = repo.GetByID(ctx, f.ID)
+	require.NoError(t, err)
+	assert.Equal(t, StatePending, f.WebsubState)
+
+	// A pending subscription is not requested again right away
+	require.NoError(t, subscriber.Renew(ctx))
+	assert.Len(t, requests, 1)
+
+	// Verification of another topic fails
+	resp, err := http.Get(form.Get("hub.callback") + "?hub.mode=subscribe&hub.topic=https://example.com/other&hub.challenge=abc&hub.lease_seconds=3600")
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	// Verification
+	resp, err = http.Get(form.Get("hub.callback") + "?" + url.Values{
+		"hub.mode":          {"subscribe"},
+		"hub.topic":         {"https://example.com/feed.atom"},
+		"hub.challenge":     {"challenge-123"},
+		"hub.lease_seconds": {"3600"},
+	}.Encode())
+	require.NoError(t, err)
+	data, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "challenge-123", string(data))
+
+	f, err = repo.GetByID(ctx, f.ID)
+	require.NoError(t, err)
+	assert.Equal(t, StateSubscribed, f.WebsubState)
+	assert.True(t, Subscribed(f, time.Now()))
+	assert.WithinDuration(t, time.Now().Add(time.Hour), *f.WebsubExpiresAt, time.Minute)
+
+	// The lease expires within the renewal margin, so the subscription is renewed
+	require.NoError(t, subscriber.Renew(ctx))
+	require.Len(t, requests, 2)
+	assert.Equal(t, secret, requests[1].Get("hub.secret"), "the secret is kept on renewal")
+
+	// Content with an invalid signature is acknowledged and ignored
+	post := func(signature string) int {
+		req, err := http.NewRequest(http.MethodPost, form.Get("hub.callback"), strings.NewReader(atomContent))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/atom+xml")
+		req.Header.Set("X-Hub-Signature", signature)
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		_ = resp.Body.Close()
+		return resp.StatusCode
+	}
+	assert.Equal(t, http.StatusAccepted, post("sha256=00"))
+	select {
+	case <-pushed:
+		t.Fatal("content with an invalid signature was processed")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	// Signed content is handed to the handler
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(atomContent))
+	assert.Equal(t, http.StatusAccepted, post("sha256="+hex.EncodeToString(mac.Sum(nil))))
+	select {
+	case parsed := <-pushed:
+		require.Len(t, parsed.Items, 1)
+		assert.Equal(t, "Pushed entry", parsed.Items[0].Title)
+	case <-time.After(5 * time.Second):
+		t.Fatal("pushed content was not processed")
+	}
+
+	// Denial of another topic fails
+	resp, err = http.Get(form.Get("hub.callback") + "?hub.mode=denied&hub.topic=https://example.com/other&hub.reason=spam")
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	f, err = repo.GetByID(ctx, f.ID)
+	require.NoError(t, err)
+	assert.Equal(t, StateSubscribed, f.WebsubState)
+
+	// Denial
+	resp, err = http.Get(form.Get("hub.callback") + "?hub.mode=denied&hub.topic=https://example.com/feed.atom&hub.reason=spam")
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	f, err = repo.GetByID(ctx, f.ID)
+	require.NoError(t, err)
+	assert.Equal(t, StateDenied, f.WebsubState)
+	assert.False(t, Subscribed(f, time.Now()))
+
+	// A subscription that was not requested cannot be denied
+	resp, err = http.Get(form.Get("hub.callback") + "?hub.mode=denied&hub.topic=https://example.com/feed.atom")
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	// Unknown feeds are gone
+	resp, err = http.Get(callback.URL + "/websub/00000000-0000-0000-0000-000000000000")
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+	assert.Equal(t, http.StatusGone, resp.StatusCode)
+}